@@ -3,12 +3,14 @@ package middleware
 import (
 	// "fmt"
 
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 
 	jWT "api-gateway/api/handlers/tokens"
 	"api-gateway/config"
+	"api-gateway/pkg/denylist"
 
 	"github.com/casbin/casbin/v2"
 	"github.com/dgrijalva/jwt-go"
@@ -16,41 +18,67 @@ import (
 	"github.com/spf13/cast"
 )
 
+// ErrTokenRevoked is returned for access tokens found in the denylist
+var ErrTokenRevoked = errors.New("token revoked")
+
+// ErrNotAccessToken is returned for valid tokens of another type, refresh
+// tokens are signed with the same key but only work at /v1/token/refresh
+var ErrNotAccessToken = errors.New("not an access token")
+
+// keys of the gin context values set for authorized requests
+const (
+	UserIdKey = "user_id"
+	RoleKey   = "role"
+)
+
 type JWTRoleAuth struct {
-	enforcer   *casbin.Enforcer
+	enforcer   *casbin.SyncedEnforcer
 	cfg        config.Config
 	jwtHandler jWT.JWTHandler
+	denylist   denylist.Denylist
 }
 
-func NewAuthorizer(e *casbin.Enforcer, jwtHandler jWT.JWTHandler, cfg config.Config) gin.HandlerFunc {
+func NewAuthorizer(e *casbin.SyncedEnforcer, jwtHandler jWT.JWTHandler, cfg config.Config, dl denylist.Denylist) gin.HandlerFunc {
 	a := &JWTRoleAuth{
 		enforcer:   e,
 		cfg:        cfg,
 		jwtHandler: jwtHandler,
+		denylist:   dl,
 	}
 
 	return func(c *gin.Context) {
-		allow, err := a.CheckPermission(c.Request)
+		role, sub, err := a.GetRole(c.Request)
+		var allow bool
+		if err != nil {
+			log.Println("error get role", err)
+		} else {
+			allow, err = a.CheckPermission(c.Request, role, sub)
+		}
+
 		if err != nil {
 			v, _ := err.(*jwt.ValidationError)
-			if v.Errors == jwt.ValidationErrorExpired {
+			if errors.Is(err, ErrTokenRevoked) {
+				a.RequireLogin(c)
+			} else if errors.Is(err, ErrNotAccessToken) {
+				a.RequireAccessToken(c)
+			} else if v != nil && v.Errors == jwt.ValidationErrorExpired {
 				a.RequireRefresh(c)
 			} else {
 				a.RequirePermission(c)
 			}
 		} else if !allow {
 			a.RequirePermission(c)
+		} else {
+			if a.isAdmin(role, sub) {
+				role = "admin"
+			}
+			c.Set(UserIdKey, sub)
+			c.Set(RoleKey, role)
 		}
 	}
 }
 
-func (a *JWTRoleAuth) CheckPermission(r *http.Request) (bool, error) {
-	user, err := a.GetRole(r)
-	if err != nil {
-		log.Println("error get role", err)
-		return false, err
-	}
-
+func (a *JWTRoleAuth) CheckPermission(r *http.Request, user, sub string) (bool, error) {
 	method := r.Method
 	path := r.URL.Path
 
@@ -59,29 +87,49 @@ func (a *JWTRoleAuth) CheckPermission(r *http.Request) (bool, error) {
 		fmt.Println("failed to check permission: ", err)
 		return false, err
 	}
+	// roles granted to the user through "g" rules
+	if !allowed && sub != "" {
+		allowed, err = a.enforcer.Enforce(sub, path, method)
+		if err != nil {
+			fmt.Println("failed to check permission: ", err)
+			return false, err
+		}
+	}
 
 	return allowed, nil
 }
 
-func (a *JWTRoleAuth) GetRole(r *http.Request) (string, error) {
-	var (
-		role   string
-		claims jwt.MapClaims
-		err    error
-	)
+// GetRole returns the role from the token claims and the id of the user the
+// token was issued to, sub is empty for unauthorized requests
+func (a *JWTRoleAuth) GetRole(r *http.Request) (role string, sub string, err error) {
+	var claims jwt.MapClaims
 
 	jwtToken := r.Header.Get("Authorization")
-	
+
 	if jwtToken == "" {
-		return "unauthorized", nil
+		return "unauthorized", "", nil
 	}
 
-	a.jwtHandler.Token = jwtToken
-	
-	claims, err = a.jwtHandler.ExtractClaims()
+	// a copy, the authorizer serves concurrent requests
+	h := a.jwtHandler
+	h.Token = jwtToken
+
+	claims, err = h.ExtractClaims()
 	if err != nil {
 		log.Println("error chack token", err)
-		return "", err
+		return "", "", err
+	}
+	if cast.ToString(claims["typ"]) != "access" {
+		return "", "", ErrNotAccessToken
+	}
+
+	revoked, err := a.denylist.Contains(r.Context(), cast.ToString(claims["jti"]))
+	if err != nil {
+		log.Println("error check denylist", err)
+		return "", "", err
+	}
+	if revoked {
+		return "", "", ErrTokenRevoked
 	}
 
 	if cast.ToString(claims["role"]) == "admin" {
@@ -96,7 +144,33 @@ func (a *JWTRoleAuth) GetRole(r *http.Request) (string, error) {
 
 	fmt.Println(role)
 
-	return role, nil
+	return role, cast.ToString(claims["sub"]), nil
+}
+
+// AdminOnly lets through admins only, either by the role in the token or by
+// an admin role granted to the user. NewAuthorizer must run before it.
+func AdminOnly() gin.HandlerFunc {
+	a := &JWTRoleAuth{}
+
+	return func(c *gin.Context) {
+		if c.GetString(RoleKey) != "admin" {
+			a.RequirePermission(c)
+		}
+	}
+}
+
+func (a *JWTRoleAuth) isAdmin(role, sub string) bool {
+	if role == "admin" {
+		return true
+	}
+	if sub == "" {
+		return false
+	}
+	isAdmin, err := a.enforcer.HasRoleForUser(sub, "admin")
+	if err != nil {
+		log.Println("error check admin role", err)
+	}
+	return isAdmin
 }
 
 func (a *JWTRoleAuth) RequireRefresh(c *gin.Context) {
@@ -106,6 +180,20 @@ func (a *JWTRoleAuth) RequireRefresh(c *gin.Context) {
 	c.AbortWithStatus(401)
 }
 
+func (a *JWTRoleAuth) RequireLogin(c *gin.Context) {
+	c.JSON(http.StatusUnauthorized, gin.H{
+		"error": "token revoked, log in again",
+	})
+	c.AbortWithStatus(401)
+}
+
+func (a *JWTRoleAuth) RequireAccessToken(c *gin.Context) {
+	c.JSON(http.StatusUnauthorized, gin.H{
+		"error": "access token required",
+	})
+	c.AbortWithStatus(401)
+}
+
 func (a *JWTRoleAuth) RequirePermission(c *gin.Context) {
 	c.JSON(http.StatusForbidden, gin.H{
 		"Error": "You have no access this page",
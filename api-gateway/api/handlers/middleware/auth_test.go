@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jWT "api-gateway/api/handlers/tokens"
+	"api-gateway/config"
+	"api-gateway/pkg/denylist"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/gin-gonic/gin"
+)
+
+const testSigningKey = "test-key"
+
+func newTestRouter(t *testing.T, dl denylist.Denylist) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	enforcer, err := casbin.NewSyncedEnforcer("../../../auth.conf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := enforcer.AddPolicy("user", "/v1/*", "GET"); err != nil {
+		t.Fatal(err)
+	}
+	// an admin by grouping, not by the role in the token
+	if _, err := enforcer.AddGroupingPolicy("admin-1", "admin"); err != nil {
+		t.Fatal(err)
+	}
+
+	router := gin.New()
+	router.Use(NewAuthorizer(enforcer, jWT.JWTHandler{SigninKey: testSigningKey}, config.Config{}, dl))
+	router.GET("/v1/users/:id", func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString(UserIdKey))
+	})
+	return router
+}
+
+func issueTokens(t *testing.T, userId string) (access, refresh string) {
+	t.Helper()
+	h := jWT.JWTHandler{
+		Sub:            userId,
+		Role:           "user",
+		SigninKey:      testSigningKey,
+		Timeot:         5,
+		RefreshId:      "refresh-1",
+		FamilyId:       "family-1",
+		RefreshTimeout: 60,
+	}
+	access, refresh, err := h.GenerateAuthJWT()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return access, refresh
+}
+
+func TestAuthorizerTokenTypes(t *testing.T) {
+	access, refresh := issueTokens(t, "user-1")
+	revoked, _ := issueTokens(t, "user-1")
+	adminAccess, adminRefresh := issueTokens(t, "admin-1")
+
+	dl := denylist.NewMemory()
+	h := jWT.JWTHandler{SigninKey: testSigningKey, Token: revoked}
+	claims, err := h.ExtractClaims()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dl.Add(context.Background(), claims["jti"].(string), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	router := newTestRouter(t, dl)
+
+	tests := []struct {
+		name  string
+		token string
+		want  int
+	}{
+		{"access token", access, http.StatusOK},
+		{"refresh token", refresh, http.StatusUnauthorized},
+		{"admin access token", adminAccess, http.StatusOK},
+		{"admin refresh token", adminRefresh, http.StatusUnauthorized},
+		{"revoked access token", revoked, http.StatusUnauthorized},
+		{"no token", "", http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v1/users/user-1", nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", tt.token)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			if w.Code != tt.want {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.want, w.Body)
+			}
+		})
+	}
+}
@@ -9,3 +9,8 @@ type Error struct {
 type StandardErrorModel struct {
 	Error Error `json:"error"`
 }
+
+// Status ...
+type Status struct {
+	Message string `json:"message"`
+}
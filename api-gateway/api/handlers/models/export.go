@@ -0,0 +1,15 @@
+package models
+
+// Export is a personal data export, download_url is set once it is done and
+// stays valid until download_url_expires_at
+type Export struct {
+	Id                   string `json:"id"`
+	Status               string `json:"status"`
+	Error                string `json:"error,omitempty"`
+	CreatedAt            string `json:"created_at"`
+	FinishedAt           string `json:"finished_at,omitempty"`
+	ExpiresAt            string `json:"expires_at,omitempty"`
+	Size                 int64  `json:"size,omitempty"`
+	DownloadUrl          string `json:"download_url,omitempty"`
+	DownloadUrlExpiresAt string `json:"download_url_expires_at,omitempty"`
+}
@@ -0,0 +1,31 @@
+package models
+
+type FollowUser struct {
+	Id       string `json:"id"`
+	Name     string `json:"name"`
+	LastName string `json:"last_name"`
+	UserName string `json:"user_name"`
+}
+
+// FollowList is one page, next_cursor is empty on the last page
+type FollowList struct {
+	Users      []FollowUser `json:"users"`
+	NextCursor string       `json:"next_cursor"`
+}
+
+type IsFollowing struct {
+	Following bool `json:"following"`
+}
+
+// Suggestion is a user to follow and the signals that ranked them
+type Suggestion struct {
+	User             FollowUser `json:"user"`
+	MutualFollows    int64      `json:"mutual_follows"`
+	SharedPosts      int64      `json:"shared_posts"`
+	SharedCategories int64      `json:"shared_categories"`
+	Score            int64      `json:"score"`
+}
+
+type Suggestions struct {
+	Suggestions []Suggestion `json:"suggestions"`
+}
@@ -0,0 +1,8 @@
+package models
+
+// ProfilePicture is where an uploaded picture and its thumbnails are stored,
+// thumbnails are keyed by their side in pixels
+type ProfilePicture struct {
+	Url        string            `json:"url"`
+	Thumbnails map[string]string `json:"thumbnails"`
+}
@@ -0,0 +1,19 @@
+package models
+
+// Policy allows a role (or a single user) to call a path with a method
+type Policy struct {
+	Sub string `json:"sub"`
+	Obj string `json:"obj"`
+	Act string `json:"act"`
+}
+
+// RoleAssignment grants a role to a user
+type RoleAssignment struct {
+	UserId string `json:"user_id"`
+	Role   string `json:"role"`
+}
+
+type Policies struct {
+	Policies []Policy         `json:"policies"`
+	Roles    []RoleAssignment `json:"roles"`
+}
@@ -27,3 +27,53 @@ type PostReq struct {
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
 }
+
+// TrendingPost is a post and its score at the last ranking
+type TrendingPost struct {
+	Id        string  `json:"id"`
+	Title     string  `json:"title"`
+	Content   string  `json:"content"`
+	Category  string  `json:"category"`
+	OwnerId   string  `json:"owner_id"`
+	Views     int64   `json:"views"`
+	Likes     int64   `json:"likes"`
+	Dislikes  int64   `json:"dislikes"`
+	CreatedAt string  `json:"created_at"`
+	Score     float64 `json:"score"`
+}
+
+// TrendingPosts is one page of the ranking, ranked_at is when it was computed
+type TrendingPosts struct {
+	Posts    []TrendingPost `json:"posts"`
+	RankedAt string         `json:"ranked_at"`
+}
+
+// TagPost is a post filed under a hashtag
+type TagPost struct {
+	Id        string `json:"id"`
+	Title     string `json:"title"`
+	Content   string `json:"content"`
+	Category  string `json:"category"`
+	OwnerId   string `json:"owner_id"`
+	Views     int64  `json:"views"`
+	Likes     int64  `json:"likes"`
+	Dislikes  int64  `json:"dislikes"`
+	CreatedAt string `json:"created_at"`
+}
+
+// TagPosts is one page, next_cursor is empty on the last page
+type TagPosts struct {
+	Posts      []TagPost `json:"posts"`
+	NextCursor string    `json:"next_cursor"`
+}
+
+// Tag is a hashtag and how many posts use it
+type Tag struct {
+	Name  string `json:"name"`
+	Posts int64  `json:"posts"`
+}
+
+// Tags are the hashtags matching a prefix, the most used first
+type Tags struct {
+	Tags []Tag `json:"tags"`
+}
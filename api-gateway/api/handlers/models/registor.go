@@ -34,6 +34,27 @@ func (rm *RegisterUser) Validate() error {
 	)
 }
 
+// ResetPassword ...
+type ResetPassword struct {
+	Email       string `json:"email"`
+	Code        string `json:"code"`
+	NewPassword string `json:"new_password"`
+}
+
+func (rp *ResetPassword) Validate() error {
+	return validation.ValidateStruct(
+		rp,
+		validation.Field(&rp.Email, validation.Required, is.Email),
+		validation.Field(&rp.Code, validation.Required),
+		validation.Field(
+			&rp.NewPassword,
+			validation.Required,
+			validation.Length(8, 30),
+			validation.Match(regexp.MustCompile("[a-z]|[A-Z][0-9]")),
+		),
+	)
+}
+
 
 
 // type RegisterModel struct {
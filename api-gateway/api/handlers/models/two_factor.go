@@ -0,0 +1,17 @@
+package models
+
+// MfaChallenge is returned by login instead of tokens when the account has
+// two-factor authentication on
+type MfaChallenge struct {
+	MfaRequired bool   `json:"mfa_required"`
+	Challenge   string `json:"challenge"`
+}
+
+type TotpEnrollment struct {
+	Secret string `json:"secret"`
+	Uri    string `json:"uri"`
+}
+
+type RecoveryCodes struct {
+	Codes []string `json:"codes"`
+}
@@ -41,6 +41,22 @@ type UserBYtokens struct {
 	ProfilePicture string `json:"profile_picture"`
 	RefreshToken   string `json:"refresh_token"`
 	AccessToken    string `json:"access_token"`
+	// the login brought back an account deleted within its restore window
+	Restored bool `json:"restored,omitempty"`
+}
+
+// EmailChangeStatus tells which addresses confirmed an email change, User is
+// set once both did and the email was changed
+type EmailChangeStatus struct {
+	OldConfirmed bool  `json:"old_confirmed"`
+	NewConfirmed bool  `json:"new_confirmed"`
+	Changed      bool  `json:"changed"`
+	User         *User `json:"user,omitempty"`
+}
+
+type Tokens struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
 }
 
 type UserByAccess struct {
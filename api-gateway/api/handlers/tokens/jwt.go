@@ -2,11 +2,11 @@ package tokens
 
 import (
 	// "api-gateway/pkg/logger"
-	"api-gateway/config"
 	"log"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
+	"github.com/google/uuid"
 )
 
 // JWTHandler ...
@@ -21,6 +21,11 @@ type JWTHandler struct {
 	Log       *log.Logger
 	Token     string
 	Timeot    int
+
+	// refresh token identity, stored by user-service for rotation
+	RefreshId      string
+	FamilyId       string
+	RefreshTimeout int
 }
 
 type CustomClaims struct {
@@ -44,11 +49,13 @@ func (jwtHandler *JWTHandler) GenerateAuthJWT() (access, refresh string, err err
 	refreshToken = jwt.New(jwt.SigningMethodHS256)
 	claims = accessToken.Claims.(jwt.MapClaims)
 	claims["sub"] = jwtHandler.Sub
+	claims["jti"] = uuid.NewString()
+	claims["typ"] = "access"
 	claims["exp"] = time.Now().Add(time.Minute * time.Duration(jwtHandler.Timeot)).Unix()
 	claims["iat"] = time.Now().Unix()
 	claims["role"] = jwtHandler.Role
 	claims["aud"] = jwtHandler.Aud
-	access, err = accessToken.SignedString([]byte(config.Load().SigningKey))
+	access, err = accessToken.SignedString([]byte(jwtHandler.SigninKey))
 	if err != nil {
 		log.Println("error generating access token", err)
 		return
@@ -56,6 +63,11 @@ func (jwtHandler *JWTHandler) GenerateAuthJWT() (access, refresh string, err err
 
 	rtClaims = refreshToken.Claims.(jwt.MapClaims)
 	rtClaims["sub"] = jwtHandler.Sub
+	rtClaims["jti"] = jwtHandler.RefreshId
+	rtClaims["fid"] = jwtHandler.FamilyId
+	rtClaims["typ"] = "refresh"
+	rtClaims["iat"] = time.Now().Unix()
+	rtClaims["exp"] = time.Now().Add(time.Minute * time.Duration(jwtHandler.RefreshTimeout)).Unix()
 	refresh, err = refreshToken.SignedString([]byte(jwtHandler.SigninKey))
 	if err != nil {
 		log.Println("error generating refresh token", err)
@@ -0,0 +1,127 @@
+package v1
+
+import (
+	"net/http"
+
+	"api-gateway/api/handlers/middleware"
+	"api-gateway/api/handlers/models"
+	pbu "api-gateway/protos/user-service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Block
+// @Summary Block
+// @Security ApiKeyAuth
+// @Description Api for blocking a user, it also removes the follows in both directions
+// @Tags Block
+// @Accept json
+// @Produce json
+// @Param id path string true "Id of the user to block"
+// @Success 200 {object} models.Status
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/block [post]
+func (h *handlerV1) Block(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().Block(ctx, &pbu.RelationReq{
+		UserId:   c.GetString(middleware.UserIdKey),
+		TargetId: c.Param("id"),
+	})
+	if err != nil {
+		h.relationError(c, err, "failed to block user")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Message: res.Message})
+}
+
+// Unblock
+// @Summary Unblock
+// @Security ApiKeyAuth
+// @Description Api for unblocking a user
+// @Tags Block
+// @Accept json
+// @Produce json
+// @Param id path string true "Id of the user to unblock"
+// @Success 200 {object} models.Status
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/block [delete]
+func (h *handlerV1) Unblock(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().Unblock(ctx, &pbu.RelationReq{
+		UserId:   c.GetString(middleware.UserIdKey),
+		TargetId: c.Param("id"),
+	})
+	if err != nil {
+		h.relationError(c, err, "failed to unblock user")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Message: res.Message})
+}
+
+// Mute
+// @Summary Mute
+// @Security ApiKeyAuth
+// @Description Api for muting a user, their posts and comments are hidden from your lists
+// @Tags Block
+// @Accept json
+// @Produce json
+// @Param id path string true "Id of the user to mute"
+// @Success 200 {object} models.Status
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/mute [post]
+func (h *handlerV1) Mute(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().Mute(ctx, &pbu.RelationReq{
+		UserId:   c.GetString(middleware.UserIdKey),
+		TargetId: c.Param("id"),
+	})
+	if err != nil {
+		h.relationError(c, err, "failed to mute user")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Message: res.Message})
+}
+
+// Unmute
+// @Summary Unmute
+// @Security ApiKeyAuth
+// @Description Api for unmuting a user
+// @Tags Block
+// @Accept json
+// @Produce json
+// @Param id path string true "Id of the user to unmute"
+// @Success 200 {object} models.Status
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/mute [delete]
+func (h *handlerV1) Unmute(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().Unmute(ctx, &pbu.RelationReq{
+		UserId:   c.GetString(middleware.UserIdKey),
+		TargetId: c.Param("id"),
+	})
+	if err != nil {
+		h.relationError(c, err, "failed to unmute user")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Message: res.Message})
+}
@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"net/http"
+
+	pbu "api-gateway/protos/user-service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestEmailChange
+// @Summary RequestEmailChange
+// @Security ApiKeyAuth
+// @Description Api for changing the email of an account, a confirmation code is sent to both the current and the new address. A new request replaces the pending one.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param id path string true "User id"
+// @Param new_email query string true "New email"
+// @Success 200 {object} models.Status
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/email [post]
+func (h *handlerV1) RequestEmailChange(c *gin.Context) {
+	newEmail := c.Query("new_email")
+	if newEmail == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "new_email is required",
+		})
+		return
+	}
+
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().RequestEmailChange(ctx, &pbu.EmailChangeReq{
+		UserId:   c.Param("id"),
+		NewEmail: newEmail,
+	})
+	if err != nil {
+		h.relationError(c, err, "failed to request email change")
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ConfirmEmailChange
+// @Summary ConfirmEmailChange
+// @Security ApiKeyAuth
+// @Description Api for confirming an email change with the code sent to either address. Once both are confirmed the email is changed and all sessions are signed out.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param id path string true "User id"
+// @Param code query string true "Code"
+// @Success 200 {object} models.EmailChangeStatus
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/email/confirm [post]
+func (h *handlerV1) ConfirmEmailChange(c *gin.Context) {
+	code := c.Query("code")
+	if code == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "code is required",
+		})
+		return
+	}
+
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().ConfirmEmailChange(ctx, &pbu.ConfirmEmailChangeReq{
+		UserId: c.Param("id"),
+		Code:   code,
+	})
+	if err != nil {
+		h.relationError(c, err, "failed to confirm email change")
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
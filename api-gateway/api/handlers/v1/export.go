@@ -0,0 +1,264 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"api-gateway/api/handlers/middleware"
+	"api-gateway/api/handlers/models"
+	"api-gateway/pkg/blobstore"
+	"api-gateway/pkg/export"
+	l "api-gateway/pkg/logger"
+	pbu "api-gateway/protos/user-service"
+
+	"shared/auth"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestExport
+// @Summary RequestExport
+// @Security ApiKeyAuth
+// @Description Api for exporting everything kept about a user: profile, follows, blocks, posts and comments as a ZIP of JSON files with an index. The export is built in the background, poll GET /v1/exports/{id} for its download link.
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param id path string true "User id"
+// @Success 202 {object} models.Export
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/export [post]
+func (h *handlerV1) RequestExport(c *gin.Context) {
+	userId := c.Param("id")
+	if !h.canAccessExport(c, userId) {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": "only the owner or an admin can export this user",
+		})
+		return
+	}
+
+	// one export at a time per user
+	job, err := h.exports.Running(userId)
+	if err == nil {
+		c.JSON(http.StatusAccepted, h.exportModel(job))
+		return
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to start export",
+		})
+		h.log.Error("failed to get running export", l.Error(err))
+		return
+	}
+
+	job, err = h.exports.Create(userId)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to start export",
+		})
+		h.log.Error("failed to create export", l.Error(err))
+		return
+	}
+
+	// the request is answered right away, so the job gets its own context
+	// that still carries the caller for user-service to check
+	ctx, cancel := context.WithTimeout(context.Background(), export.Timeout)
+	ctx = metadata.AppendToOutgoingContext(ctx,
+		auth.UserIdKey, c.GetString(middleware.UserIdKey),
+		auth.RoleKey, c.GetString(middleware.RoleKey),
+	)
+	go func() {
+		defer cancel()
+		h.runExport(ctx, job)
+	}()
+
+	c.JSON(http.StatusAccepted, h.exportModel(job))
+}
+
+func (h *handlerV1) runExport(ctx context.Context, job *export.Job) {
+	fail := func(reason string, err error) {
+		h.log.Error(reason, l.String("export_id", job.Id), l.Error(err))
+		if err := h.exports.Fail(job.Id, reason); err != nil {
+			h.log.Error("failed to mark export failed", l.Error(err))
+		}
+	}
+
+	stream, err := h.serviceManager.UserService().ExportUserData(ctx, &pbu.ExportUserDataReq{UserId: job.UserId})
+	if err != nil {
+		fail("failed to collect user data", err)
+		return
+	}
+	var files []export.File
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			fail("failed to collect user data", err)
+			return
+		}
+		files = export.AddChunk(files, chunk.Name, chunk.Content)
+	}
+	data, err := export.Archive(job.UserId, files)
+	if err != nil {
+		fail("failed to build archive", err)
+		return
+	}
+
+	// never into the picture store, that one may be public
+	key := fmt.Sprintf("exports/%s/%s.zip", job.UserId, job.Id)
+	if err := h.exportBlobs.Put(ctx, key, bytes.NewReader(data), int64(len(data)), "application/zip"); err != nil {
+		fail("failed to store archive", err)
+		return
+	}
+	ttl := time.Duration(h.cfg.ExportTTL) * time.Hour
+	if err := h.exports.Finish(job.Id, key, int64(len(data)), ttl); err != nil {
+		h.log.Error("failed to finish export", l.String("export_id", job.Id), l.Error(err))
+		if err := h.exportBlobs.Delete(ctx, key); err != nil {
+			h.log.Error("failed to delete archive", l.Error(err))
+		}
+	}
+}
+
+// GetExport
+// @Summary GetExport
+// @Security ApiKeyAuth
+// @Description Api for the status of a personal data export, a fresh download link is handed out every time it is asked for
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param id path string true "Export id"
+// @Success 200 {object} models.Export
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/exports/{id} [get]
+func (h *handlerV1) GetExport(c *gin.Context) {
+	id := c.Param("id")
+	if _, err := uuid.Parse(id); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "export not found",
+		})
+		return
+	}
+
+	job, err := h.exports.Get(id)
+	// other users' exports look the same as missing ones
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !h.canAccessExport(c, job.UserId)) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "export not found",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to get export",
+		})
+		h.log.Error("failed to get export", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, h.exportModel(job))
+}
+
+// DownloadExport
+// @Summary DownloadExport
+// @Description Api for downloading a personal data export through the signed link handed out by GET /v1/exports/{id}
+// @Tags User
+// @Produce application/zip
+// @Param id path string true "Export id"
+// @Param expires query string true "Expiry of the link"
+// @Param signature query string true "Signature of the link"
+// @Success 200 {file} file
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 410 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/exports/{id}/download [get]
+func (h *handlerV1) DownloadExport(c *gin.Context) {
+	id := c.Param("id")
+	err := export.Verify(h.cfg.SigningKey, id, c.Query("expires"), c.Query("signature"))
+	if errors.Is(err, export.ErrLinkExpired) {
+		c.JSON(http.StatusGone, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	job, err := h.exports.Downloadable(id)
+	if errors.Is(err, sql.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "export not found or expired",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to get export",
+		})
+		h.log.Error("failed to get export", l.Error(err))
+		return
+	}
+
+	archive, err := h.exportBlobs.Get(c.Request.Context(), job.BlobKey)
+	if errors.Is(err, blobstore.ErrNotFound) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "export not found or expired",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to read export",
+		})
+		h.log.Error("failed to read export", l.Error(err))
+		return
+	}
+	defer archive.Close()
+
+	c.DataFromReader(http.StatusOK, job.Size, "application/zip", archive, map[string]string{
+		"Content-Disposition": fmt.Sprintf(`attachment; filename="export-%s.zip"`, job.Id),
+		"Cache-Control":       "no-store",
+	})
+}
+
+func (h *handlerV1) canAccessExport(c *gin.Context, userId string) bool {
+	return c.GetString(middleware.UserIdKey) == userId || c.GetString(middleware.RoleKey) == "admin"
+}
+
+func (h *handlerV1) exportModel(job *export.Job) models.Export {
+	m := models.Export{
+		Id:        job.Id,
+		Status:    job.Status,
+		Error:     job.Error,
+		CreatedAt: job.CreatedAt.Format(time.RFC3339),
+		Size:      job.Size,
+	}
+	if job.FinishedAt != nil {
+		m.FinishedAt = job.FinishedAt.Format(time.RFC3339)
+	}
+	if job.ExpiresAt != nil {
+		m.ExpiresAt = job.ExpiresAt.Format(time.RFC3339)
+	}
+	if job.Status == export.StatusDone {
+		expires := time.Now().Add(time.Duration(h.cfg.ExportLinkTTL) * time.Minute)
+		m.DownloadUrl = fmt.Sprintf("/v1/exports/%s/download?expires=%d&signature=%s",
+			job.Id, expires.Unix(), export.Sign(h.cfg.SigningKey, job.Id, expires))
+		m.DownloadUrlExpiresAt = expires.UTC().Format(time.RFC3339)
+	}
+	return m
+}
@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"api-gateway/pkg/export"
+	pbu "api-gateway/protos/user-service"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+)
+
+// fakeExportStore keeps the jobs in a map
+type fakeExportStore struct {
+	export.Store
+
+	jobs map[string]*export.Job
+}
+
+func (s *fakeExportStore) Finish(id, blobKey string, size int64, ttl time.Duration) error {
+	job := s.jobs[id]
+	job.Status, job.BlobKey, job.Size = export.StatusDone, blobKey, size
+	return nil
+}
+
+func (s *fakeExportStore) Downloadable(id string) (*export.Job, error) {
+	job, ok := s.jobs[id]
+	if !ok || job.Status != export.StatusDone {
+		return nil, sql.ErrNoRows
+	}
+	return job, nil
+}
+
+// fakeExportService streams the chunks it is given
+type fakeExportService struct {
+	pbu.UserServiceClient
+
+	chunks []*pbu.ExportChunk
+}
+
+func (f *fakeExportService) ExportUserData(ctx context.Context, in *pbu.ExportUserDataReq, opts ...grpc.CallOption) (pbu.UserService_ExportUserDataClient, error) {
+	return &fakeExportStream{chunks: f.chunks}, nil
+}
+
+type fakeExportStream struct {
+	grpc.ClientStream
+
+	chunks []*pbu.ExportChunk
+}
+
+func (s *fakeExportStream) Recv() (*pbu.ExportChunk, error) {
+	if len(s.chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return chunk, nil
+}
+
+func TestExportStaysOutOfThePictureStore(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	job := &export.Job{Id: "6f1c3c1e-5a4b-4f0e-9a51-0c7f5a2b9e10", UserId: "user-1", Status: export.StatusRunning}
+	exports := &fakeExportStore{jobs: map[string]*export.Job{job.Id: job}}
+	users := &fakeExportService{chunks: []*pbu.ExportChunk{{Name: "profile.json", Content: []byte(`{"id":"user-1"}`)}}}
+	pictures, archives := newFakeBlobStore(), newFakeBlobStore()
+	h := newTestHandler(&fakeServices{user: users})
+	h.blobs, h.exportBlobs, h.exports = pictures, archives, exports
+	h.cfg.SigningKey = "secret"
+
+	h.runExport(context.Background(), job)
+
+	if keys := pictures.keys(); len(keys) != 0 {
+		t.Fatalf("picture store got %v", keys)
+	}
+	want := fmt.Sprintf("exports/user-1/%s.zip", job.Id)
+	if keys := archives.keys(); len(keys) != 1 || keys[0] != want {
+		t.Fatalf("export store got %v, want %s", keys, want)
+	}
+	if job.Status != export.StatusDone || job.BlobKey != want {
+		t.Fatalf("job = %+v", job)
+	}
+
+	expires := time.Now().Add(time.Minute)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, fmt.Sprintf("/v1/exports/%s/download?expires=%d&signature=%s",
+		job.Id, expires.Unix(), export.Sign("secret", job.Id, expires)), nil)
+	c.Params = gin.Params{{Key: "id", Value: job.Id}}
+
+	h.DownloadExport(c)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", w.Code, w.Body)
+	}
+	if !bytes.Equal(w.Body.Bytes(), archives.blobs[want]) {
+		t.Errorf("downloaded %d bytes, not the stored archive", w.Body.Len())
+	}
+}
@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"context"
+
+	"api-gateway/config"
+	"api-gateway/pkg/logger"
+	pbc "api-gateway/protos/comment-service"
+	pbp "api-gateway/protos/post-service"
+	pbu "api-gateway/protos/user-service"
+
+	"google.golang.org/grpc"
+)
+
+// fakeServices serves the clients a test sets, calling one it did not set
+// panics
+type fakeServices struct {
+	user    pbu.UserServiceClient
+	post    pbp.PostServiceClient
+	comment pbc.CommentServiceClient
+}
+
+func (s *fakeServices) UserService() pbu.UserServiceClient       { return s.user }
+func (s *fakeServices) PostService() pbp.PostServiceClient       { return s.post }
+func (s *fakeServices) CommentService() pbc.CommentServiceClient { return s.comment }
+
+// fakeUserService answers Authorization with a fixed result
+type fakeUserService struct {
+	pbu.UserServiceClient
+
+	authRes *pbu.AuthRes
+	authErr error
+}
+
+func (f *fakeUserService) Authorization(ctx context.Context, in *pbu.AuthUser, opts ...grpc.CallOption) (*pbu.AuthRes, error) {
+	return f.authRes, f.authErr
+}
+
+func newTestHandler(services *fakeServices) *handlerV1 {
+	return New(&HandlerV1Config{
+		Logger:         logger.New("error", "test"),
+		ServiceManager: services,
+		Cfg:            config.Config{CtxTimeout: 5},
+	})
+}
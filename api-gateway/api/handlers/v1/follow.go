@@ -0,0 +1,380 @@
+package v1
+
+import (
+	"net/http"
+	"strconv"
+
+	"api-gateway/api/handlers/middleware"
+	"api-gateway/api/handlers/models"
+	l "api-gateway/pkg/logger"
+	pbu "api-gateway/protos/user-service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/cast"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Follow
+// @Summary Follow
+// @Security ApiKeyAuth
+// @Description Api for following a user
+// @Tags Follow
+// @Accept json
+// @Produce json
+// @Param id path string true "Id of the user to follow"
+// @Success 200 {object} models.Status
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/follow [post]
+func (h *handlerV1) Follow(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().Follow(ctx, &pbu.FollowReq{
+		FollowerId: c.GetString(middleware.UserIdKey),
+		FolloweeId: c.Param("id"),
+	})
+	if err != nil {
+		h.relationError(c, err, "failed to follow user")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Message: res.Message})
+}
+
+// Unfollow
+// @Summary Unfollow
+// @Security ApiKeyAuth
+// @Description Api for unfollowing a user
+// @Tags Follow
+// @Accept json
+// @Produce json
+// @Param id path string true "Id of the user to unfollow"
+// @Success 200 {object} models.Status
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/follow [delete]
+func (h *handlerV1) Unfollow(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().Unfollow(ctx, &pbu.FollowReq{
+		FollowerId: c.GetString(middleware.UserIdKey),
+		FolloweeId: c.Param("id"),
+	})
+	if err != nil {
+		h.relationError(c, err, "failed to unfollow user")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Message: res.Message})
+}
+
+// IsFollowing
+// @Summary IsFollowing
+// @Security ApiKeyAuth
+// @Description Api for checking whether a user follows another one
+// @Tags Follow
+// @Accept json
+// @Produce json
+// @Param id path string true "Follower id"
+// @Param target path string true "Followee id"
+// @Success 200 {object} models.IsFollowing
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/following/{target} [get]
+func (h *handlerV1) IsFollowing(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().IsFollowing(ctx, &pbu.FollowReq{
+		FollowerId: c.Param("id"),
+		FolloweeId: c.Param("target"),
+	})
+	if err != nil {
+		h.relationError(c, err, "failed to check follow")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.IsFollowing{Following: res.Following})
+}
+
+// ListFollowers
+// @Summary ListFollowers
+// @Security ApiKeyAuth
+// @Description Api for listing the followers of a user, newest first
+// @Tags Follow
+// @Accept json
+// @Produce json
+// @Param id path string true "User id"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "Page size, at most 100"
+// @Success 200 {object} models.FollowList
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/followers [get]
+func (h *handlerV1) ListFollowers(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().ListFollowers(ctx, followListReq(c))
+	if err != nil {
+		h.relationError(c, err, "failed to list followers")
+		return
+	}
+
+	c.JSON(http.StatusOK, followList(res))
+}
+
+// ListFollowing
+// @Summary ListFollowing
+// @Security ApiKeyAuth
+// @Description Api for listing the users a user follows, newest first
+// @Tags Follow
+// @Accept json
+// @Produce json
+// @Param id path string true "User id"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "Page size, at most 100"
+// @Success 200 {object} models.FollowList
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/following [get]
+func (h *handlerV1) ListFollowing(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().ListFollowing(ctx, followListReq(c))
+	if err != nil {
+		h.relationError(c, err, "failed to list following")
+		return
+	}
+
+	c.JSON(http.StatusOK, followList(res))
+}
+
+// SetPrivacy
+// @Summary SetPrivacy
+// @Security ApiKeyAuth
+// @Description Api for making an account private or public, going public approves all pending follow requests
+// @Tags Follow
+// @Accept json
+// @Produce json
+// @Param id path string true "User id"
+// @Param is_private query bool true "Whether the account is private"
+// @Success 200 {object} models.User
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/privacy [put]
+func (h *handlerV1) SetPrivacy(c *gin.Context) {
+	private, err := strconv.ParseBool(c.Query("is_private"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "is_private must be true or false",
+		})
+		return
+	}
+
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().SetPrivacy(ctx, &pbu.SetPrivacyReq{
+		UserId:    c.Param("id"),
+		IsPrivate: private,
+	})
+	if err != nil {
+		h.relationError(c, err, "failed to change privacy")
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// ListFollowRequests
+// @Summary ListFollowRequests
+// @Security ApiKeyAuth
+// @Description Api for listing the pending requests to follow a private account, newest first
+// @Tags Follow
+// @Accept json
+// @Produce json
+// @Param id path string true "User id"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "Page size, at most 100"
+// @Success 200 {object} models.FollowList
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/follow-requests [get]
+func (h *handlerV1) ListFollowRequests(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().ListFollowRequests(ctx, followListReq(c))
+	if err != nil {
+		h.relationError(c, err, "failed to list follow requests")
+		return
+	}
+
+	c.JSON(http.StatusOK, followList(res))
+}
+
+// ApproveFollowRequest
+// @Summary ApproveFollowRequest
+// @Security ApiKeyAuth
+// @Description Api for approving a request to follow a private account
+// @Tags Follow
+// @Accept json
+// @Produce json
+// @Param id path string true "Id of the private account"
+// @Param requester path string true "Id of the user who asked to follow"
+// @Success 200 {object} models.Status
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/follow-requests/{requester}/approve [post]
+func (h *handlerV1) ApproveFollowRequest(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().ApproveFollowRequest(ctx, &pbu.FollowReq{
+		FollowerId: c.Param("requester"),
+		FolloweeId: c.Param("id"),
+	})
+	if err != nil {
+		h.relationError(c, err, "failed to approve follow request")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Message: res.Message})
+}
+
+// RejectFollowRequest
+// @Summary RejectFollowRequest
+// @Security ApiKeyAuth
+// @Description Api for rejecting a request to follow a private account
+// @Tags Follow
+// @Accept json
+// @Produce json
+// @Param id path string true "Id of the private account"
+// @Param requester path string true "Id of the user who asked to follow"
+// @Success 200 {object} models.Status
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/follow-requests/{requester}/reject [post]
+func (h *handlerV1) RejectFollowRequest(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().RejectFollowRequest(ctx, &pbu.FollowReq{
+		FollowerId: c.Param("requester"),
+		FolloweeId: c.Param("id"),
+	})
+	if err != nil {
+		h.relationError(c, err, "failed to reject follow request")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Message: res.Message})
+}
+
+// SuggestUsers
+// @Summary SuggestUsers
+// @Security ApiKeyAuth
+// @Description Api for people the user may know, ranked by mutual follows, posts both commented on and shared post categories
+// @Tags Follow
+// @Accept json
+// @Produce json
+// @Param id path string true "User id"
+// @Param limit query int false "How many suggestions, at most 100"
+// @Success 200 {object} models.Suggestions
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/suggestions [get]
+func (h *handlerV1) SuggestUsers(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().SuggestUsers(ctx, &pbu.SuggestUsersReq{
+		UserId: c.Param("id"),
+		Limit:  cast.ToInt64(c.Query("limit")),
+	})
+	if err != nil {
+		h.relationError(c, err, "failed to suggest users")
+		return
+	}
+
+	suggestions := models.Suggestions{Suggestions: []models.Suggestion{}}
+	for _, s := range res.Suggestions {
+		suggestions.Suggestions = append(suggestions.Suggestions, models.Suggestion{
+			User: models.FollowUser{
+				Id:       s.User.Id,
+				Name:     s.User.FirstName,
+				LastName: s.User.LastName,
+				UserName: s.User.UserName,
+			},
+			MutualFollows:    s.MutualFollows,
+			SharedPosts:      s.SharedPosts,
+			SharedCategories: s.SharedCategories,
+			Score:            s.Score,
+		})
+	}
+
+	c.JSON(http.StatusOK, suggestions)
+}
+
+func followListReq(c *gin.Context) *pbu.FollowListReq {
+	return &pbu.FollowListReq{
+		UserId: c.Param("id"),
+		Cursor: c.Query("cursor"),
+		Limit:  cast.ToInt64(c.Query("limit")),
+	}
+}
+
+func followList(res *pbu.FollowListRes) models.FollowList {
+	list := models.FollowList{
+		Users:      []models.FollowUser{},
+		NextCursor: res.NextCursor,
+	}
+	for _, u := range res.Users {
+		list.Users = append(list.Users, models.FollowUser{
+			Id:       u.Id,
+			Name:     u.FirstName,
+			LastName: u.LastName,
+			UserName: u.UserName,
+		})
+	}
+	return list
+}
+
+func (h *handlerV1) relationError(c *gin.Context, err error, msg string) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+	case codes.PermissionDenied:
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+	case codes.AlreadyExists:
+		c.JSON(http.StatusConflict, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": msg,
+		})
+		h.log.Error(msg, l.Error(err))
+	}
+}
@@ -1,12 +1,23 @@
 package v1
 
 import (
+	"context"
+	"time"
+
+	"api-gateway/api/handlers/middleware"
 	"api-gateway/api/handlers/tokens"
 	"api-gateway/config"
+	"api-gateway/pkg/blobstore"
+	"api-gateway/pkg/denylist"
+	"api-gateway/pkg/export"
 	"api-gateway/pkg/logger"
 	"api-gateway/services"
 
+	"shared/auth"
+
 	"github.com/casbin/casbin/v2"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/metadata"
 )
 
 type handlerV1 struct {
@@ -14,7 +25,11 @@ type handlerV1 struct {
 	serviceManager services.IServiceManager
 	cfg            config.Config
 	jwthandler     tokens.JWTHandler
-	enforcer       *casbin.Enforcer
+	enforcer       *casbin.SyncedEnforcer
+	denylist       denylist.Denylist
+	blobs          blobstore.BlobStore
+	exportBlobs    blobstore.BlobStore
+	exports        export.Store
 }
 
 // HandlerV1Config ...
@@ -23,7 +38,11 @@ type HandlerV1Config struct {
 	ServiceManager services.IServiceManager
 	Cfg            config.Config
 	JWTHandler     tokens.JWTHandler
-	Enforcer       *casbin.Enforcer
+	Enforcer       *casbin.SyncedEnforcer
+	Denylist       denylist.Denylist
+	Blobs          blobstore.BlobStore
+	ExportBlobs    blobstore.BlobStore
+	Exports        export.Store
 }
 
 // New ...
@@ -34,5 +53,20 @@ func New(c *HandlerV1Config) *handlerV1 {
 		cfg:            c.Cfg,
 		jwthandler:     c.JWTHandler,
 		enforcer:       c.Enforcer,
+		denylist:       c.Denylist,
+		blobs:          c.Blobs,
+		exportBlobs:    c.ExportBlobs,
+		exports:        c.Exports,
 	}
 }
+
+// authContext returns the request context for gRPC calls, it carries the
+// authenticated user as metadata so the services can check ownership
+func (h *handlerV1) authContext(c *gin.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx = metadata.AppendToOutgoingContext(ctx,
+		auth.UserIdKey, c.GetString(middleware.UserIdKey),
+		auth.RoleKey, c.GetString(middleware.RoleKey),
+	)
+	return ctx, cancel
+}
@@ -0,0 +1,103 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"api-gateway/api/handlers/models"
+	l "api-gateway/pkg/logger"
+	pbu "api-gateway/protos/user-service"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ForgotPassword
+// @Summary ForgotPassword
+// @Description Api for sending a password reset code to the email of the account
+// @Tags Authorizations
+// @Accept json
+// @Produce json
+// @Param email query string true "Email"
+// @Success 200 {object} models.Status
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/password/forgot [post]
+func (h *handlerV1) ForgotPassword(c *gin.Context) {
+	email := c.Query("email")
+	if email == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "email is required",
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().ForgotPassword(ctx, &pbu.ForgotPasswordReq{
+		Email: email,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to send reset code",
+		})
+		h.log.Error("failed to send reset code", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Message: res.Message})
+}
+
+// ResetPassword
+// @Summary ResetPassword
+// @Description Api for setting a new password with the code sent by ForgotPassword
+// @Tags Authorizations
+// @Accept json
+// @Produce json
+// @Param email query string true "Email"
+// @Param code query string true "Code"
+// @Param new_password query string true "New password"
+// @Success 200 {object} models.Status
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/password/reset [post]
+func (h *handlerV1) ResetPassword(c *gin.Context) {
+	body := models.ResetPassword{
+		Email:       c.Query("email"),
+		Code:        c.Query("code"),
+		NewPassword: c.Query("new_password"),
+	}
+	if err := body.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().ResetPassword(ctx, &pbu.ResetPasswordReq{
+		Email:       body.Email,
+		Code:        body.Code,
+		NewPassword: body.NewPassword,
+	})
+	if status.Code(err) == codes.InvalidArgument {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to reset password",
+		})
+		h.log.Error("failed to reset password", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Message: res.Message})
+}
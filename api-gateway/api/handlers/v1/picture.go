@@ -0,0 +1,183 @@
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"api-gateway/api/handlers/middleware"
+	"api-gateway/api/handlers/models"
+	l "api-gateway/pkg/logger"
+	"api-gateway/pkg/picture"
+	pbu "api-gateway/protos/user-service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// profile pictures are scaled to fit avatarSide, thumbnails are square
+const avatarSide = 1024
+
+var avatarThumbnails = []int{256, 64}
+
+// UploadProfilePicture
+// @Summary UploadProfilePicture
+// @Security ApiKeyAuth
+// @Description Api for uploading a JPEG or PNG profile picture, metadata is stripped and thumbnails are generated
+// @Tags User
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "User id"
+// @Param file formData file true "Picture"
+// @Success 200 {object} models.ProfilePicture
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 413 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/{id}/picture [post]
+func (h *handlerV1) UploadProfilePicture(c *gin.Context) {
+	userId := c.Param("id")
+	// checked before anything is stored, user-service checks again on update
+	if c.GetString(middleware.UserIdKey) != userId && c.GetString(middleware.RoleKey) != "admin" {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": "only the owner or an admin can change this resource",
+		})
+		return
+	}
+
+	data, err := h.readUpload(c)
+	if err != nil {
+		return
+	}
+	processed, err := picture.Process(data, avatarSide, avatarThumbnails)
+	if errors.Is(err, picture.ErrUnsupported) || errors.Is(err, picture.ErrTooLarge) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to process picture",
+		})
+		h.log.Error("failed to process picture", l.Error(err))
+		return
+	}
+
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	// a fresh name per upload, so caches never serve an old picture
+	base := fmt.Sprintf("avatars/%s/%s", userId, uuid.NewString())
+	images := map[string]picture.Image{base + processed.Main.Ext: processed.Main}
+	response := models.ProfilePicture{
+		Url:        h.blobs.URL(base + processed.Main.Ext),
+		Thumbnails: map[string]string{},
+	}
+	for size, thumb := range processed.Thumbnails {
+		key := fmt.Sprintf("%s_%d%s", base, size, thumb.Ext)
+		images[key] = thumb
+		response.Thumbnails[strconv.Itoa(size)] = h.blobs.URL(key)
+	}
+
+	var stored []string
+	cleanUp := func() {
+		for _, key := range stored {
+			if err := h.blobs.Delete(ctx, key); err != nil {
+				h.log.Error("failed to delete picture", l.Error(err))
+			}
+		}
+	}
+	for key, img := range images {
+		if err := h.blobs.Put(ctx, key, bytes.NewReader(img.Data), int64(len(img.Data)), img.ContentType); err != nil {
+			cleanUp()
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "failed to store picture",
+			})
+			h.log.Error("failed to store picture", l.Error(err))
+			return
+		}
+		stored = append(stored, key)
+	}
+
+	_, err = h.serviceManager.UserService().Update(ctx, &pbu.UpdateUserReq{
+		Id:             userId,
+		ProfilePicture: response.Url,
+	})
+	if err != nil {
+		cleanUp()
+		switch status.Code(err) {
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": status.Convert(err).Message(),
+			})
+		case codes.PermissionDenied:
+			c.JSON(http.StatusForbidden, gin.H{
+				"error": status.Convert(err).Message(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "failed to update user",
+			})
+			h.log.Error("failed to set profile picture", l.Error(err))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// readUpload returns the "file" form field, it answers the request itself
+// when the upload is missing or too large
+func (h *handlerV1) readUpload(c *gin.Context) ([]byte, error) {
+	maxSize := int64(h.cfg.UploadMaxSizeMB) << 20
+	// leave room for the rest of the multipart body
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxSize+1<<20)
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+				"error": fmt.Sprintf("file must be at most %d MB", h.cfg.UploadMaxSizeMB),
+			})
+			return nil, err
+		}
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "file is required",
+		})
+		return nil, err
+	}
+	if header.Size > maxSize {
+		err := fmt.Errorf("upload of %d bytes", header.Size)
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+			"error": fmt.Sprintf("file must be at most %d MB", h.cfg.UploadMaxSizeMB),
+		})
+		return nil, err
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to read file",
+		})
+		h.log.Error("failed to open upload", l.Error(err))
+		return nil, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxSize))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to read file",
+		})
+		h.log.Error("failed to read upload", l.Error(err))
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,203 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"api-gateway/api/handlers/middleware"
+	"api-gateway/api/handlers/models"
+	"api-gateway/pkg/blobstore"
+	pbu "api-gateway/protos/user-service"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeBlobStore keeps blobs in a map, putErr fails every Put
+type fakeBlobStore struct {
+	mu     sync.Mutex
+	blobs  map[string][]byte
+	putErr error
+}
+
+func newFakeBlobStore() *fakeBlobStore {
+	return &fakeBlobStore{blobs: map[string][]byte{}}
+}
+
+func (s *fakeBlobStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	if s.putErr != nil {
+		return s.putErr
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.blobs[key] = data
+	return nil
+}
+
+func (s *fakeBlobStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.blobs[key]
+	if !ok {
+		return nil, blobstore.ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *fakeBlobStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.blobs, key)
+	return nil
+}
+
+func (s *fakeBlobStore) URL(key string) string {
+	return "http://media.test/" + key
+}
+
+func (s *fakeBlobStore) keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var keys []string
+	for k := range s.blobs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// fakeUpdateService records the profile updates
+type fakeUpdateService struct {
+	pbu.UserServiceClient
+
+	updates   []*pbu.UpdateUserReq
+	updateErr error
+}
+
+func (f *fakeUpdateService) Update(ctx context.Context, in *pbu.UpdateUserReq, opts ...grpc.CallOption) (*pbu.User, error) {
+	f.updates = append(f.updates, in)
+	if f.updateErr != nil {
+		return nil, f.updateErr
+	}
+	return &pbu.User{Id: in.Id, ProfilePicture: in.ProfilePicture}, nil
+}
+
+func uploadBody(t *testing.T, data []byte) (io.Reader, string) {
+	t.Helper()
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	if data != nil {
+		part, err := w.CreateFormFile("file", "me.png")
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write(data)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return &body, w.FormDataContentType()
+}
+
+func TestUploadProfilePicture(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	var pic bytes.Buffer
+	if err := png.Encode(&pic, image.NewRGBA(image.Rect(0, 0, 300, 200))); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		caller      string
+		role        string
+		data        []byte
+		putErr      error
+		updateErr   error
+		want        int
+		wantStored  int
+		wantUpdates int
+	}{
+		{name: "owner", caller: "user-1", role: "user", data: pic.Bytes(), want: http.StatusOK, wantStored: 3, wantUpdates: 1},
+		{name: "admin", caller: "admin-1", role: "admin", data: pic.Bytes(), want: http.StatusOK, wantStored: 3, wantUpdates: 1},
+		{name: "another user", caller: "user-2", role: "user", data: pic.Bytes(), want: http.StatusForbidden},
+		{name: "no file", caller: "user-1", role: "user", want: http.StatusBadRequest},
+		{name: "not a picture", caller: "user-1", role: "user", data: []byte("GIF89a not really"), want: http.StatusBadRequest},
+		{name: "too large", caller: "user-1", role: "user", data: bytes.Repeat([]byte{0}, 1<<20+1), want: http.StatusRequestEntityTooLarge},
+		{name: "store fails", caller: "user-1", role: "user", data: pic.Bytes(), putErr: errors.New("disk full"), want: http.StatusInternalServerError},
+		{name: "user gone", caller: "user-1", role: "user", data: pic.Bytes(), updateErr: status.Error(codes.NotFound, "user not found"), want: http.StatusNotFound, wantUpdates: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &fakeUpdateService{updateErr: tt.updateErr}
+			blobs := newFakeBlobStore()
+			blobs.putErr = tt.putErr
+			h := newTestHandler(&fakeServices{user: users})
+			h.blobs = blobs
+			h.cfg.UploadMaxSizeMB = 1
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			body, contentType := uploadBody(t, tt.data)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/users/user-1/picture", body)
+			c.Request.Header.Set("Content-Type", contentType)
+			c.Params = gin.Params{{Key: "id", Value: "user-1"}}
+			c.Set(middleware.UserIdKey, tt.caller)
+			c.Set(middleware.RoleKey, tt.role)
+
+			h.UploadProfilePicture(c)
+
+			if w.Code != tt.want {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.want, w.Body)
+			}
+			// nothing is left behind when the upload fails
+			if keys := blobs.keys(); len(keys) != tt.wantStored {
+				t.Fatalf("stored %v, want %d blobs", keys, tt.wantStored)
+			}
+			if len(users.updates) != tt.wantUpdates {
+				t.Fatalf("%d updates, want %d", len(users.updates), tt.wantUpdates)
+			}
+			if tt.want != http.StatusOK {
+				return
+			}
+
+			var res models.ProfilePicture
+			if err := json.Unmarshal(w.Body.Bytes(), &res); err != nil {
+				t.Fatal(err)
+			}
+			if users.updates[0].ProfilePicture != res.Url || users.updates[0].Id != "user-1" {
+				t.Errorf("update %v does not set %s", users.updates[0], res.Url)
+			}
+			urls := []string{res.Url, res.Thumbnails["256"], res.Thumbnails["64"]}
+			for i, key := range blobs.keys() {
+				if !strings.HasPrefix(key, "avatars/user-1/") || !strings.HasSuffix(key, ".png") {
+					t.Errorf("blob key %q", key)
+				}
+				found := false
+				for _, u := range urls {
+					found = found || u == blobs.URL(key)
+				}
+				if !found {
+					t.Errorf("blob %d %q is not in the response %+v", i, key, res)
+				}
+			}
+		})
+	}
+}
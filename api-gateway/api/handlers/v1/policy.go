@@ -0,0 +1,273 @@
+package v1
+
+import (
+	"net/http"
+
+	"api-gateway/api/handlers/models"
+	l "api-gateway/pkg/logger"
+	pbu "api-gateway/protos/user-service"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListPolicies
+// @Summary ListPolicies
+// @Security ApiKeyAuth
+// @Description Api for listing casbin policies and role assignments, admin only
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.Policies
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/admin/policies [get]
+func (h *handlerV1) ListPolicies(c *gin.Context) {
+	policies, err := h.enforcer.GetPolicy()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to list policies",
+		})
+		h.log.Error("failed to list policies", l.Error(err))
+		return
+	}
+	groupings, err := h.enforcer.GetGroupingPolicy()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to list policies",
+		})
+		h.log.Error("failed to list role assignments", l.Error(err))
+		return
+	}
+
+	response := models.Policies{
+		Policies: []models.Policy{},
+		Roles:    []models.RoleAssignment{},
+	}
+	for _, p := range policies {
+		response.Policies = append(response.Policies, models.Policy{Sub: p[0], Obj: p[1], Act: p[2]})
+	}
+	for _, g := range groupings {
+		response.Roles = append(response.Roles, models.RoleAssignment{UserId: g[0], Role: g[1]})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// AddPolicy
+// @Summary AddPolicy
+// @Security ApiKeyAuth
+// @Description Api for allowing a role or user to call a path, admin only
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param Policy body models.Policy true "policy"
+// @Success 201 {object} models.Policy
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/admin/policies [post]
+func (h *handlerV1) AddPolicy(c *gin.Context) {
+	var body models.Policy
+	if err := c.ShouldBindJSON(&body); err != nil || body.Sub == "" || body.Obj == "" || body.Act == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "sub, obj and act are required",
+		})
+		return
+	}
+
+	// casbin reports an existing rule as added, so look it up first
+	exists, err := h.enforcer.HasPolicy(body.Sub, body.Obj, body.Act)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to add policy",
+		})
+		h.log.Error("failed to look up policy", l.Error(err))
+		return
+	}
+	if exists {
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "policy already exists",
+		})
+		return
+	}
+
+	added, err := h.enforcer.AddPolicy(body.Sub, body.Obj, body.Act)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to add policy",
+		})
+		h.log.Error("failed to add policy", l.Error(err))
+		return
+	}
+	if !added {
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "policy already exists",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, body)
+}
+
+// RemovePolicy
+// @Summary RemovePolicy
+// @Security ApiKeyAuth
+// @Description Api for removing a policy, admin only
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param sub query string true "Role or user id"
+// @Param obj query string true "Path"
+// @Param act query string true "Method"
+// @Success 200 {object} models.Status
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/admin/policies [delete]
+func (h *handlerV1) RemovePolicy(c *gin.Context) {
+	removed, err := h.enforcer.RemovePolicy(c.Query("sub"), c.Query("obj"), c.Query("act"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to remove policy",
+		})
+		h.log.Error("failed to remove policy", l.Error(err))
+		return
+	}
+	if !removed {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "policy not found",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Message: "policy removed"})
+}
+
+// AssignRole
+// @Summary AssignRole
+// @Security ApiKeyAuth
+// @Description Api for granting a role to a user, admin only
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param RoleAssignment body models.RoleAssignment true "role assignment"
+// @Success 201 {object} models.RoleAssignment
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/admin/roles [post]
+func (h *handlerV1) AssignRole(c *gin.Context) {
+	var body models.RoleAssignment
+	if err := c.ShouldBindJSON(&body); err != nil || body.UserId == "" || body.Role == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "user_id and role are required",
+		})
+		return
+	}
+
+	exists, err := h.enforcer.HasGroupingPolicy(body.UserId, body.Role)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to assign role",
+		})
+		h.log.Error("failed to look up role assignment", l.Error(err))
+		return
+	}
+	if exists {
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "user already has this role",
+		})
+		return
+	}
+
+	added, err := h.enforcer.AddRoleForUser(body.UserId, body.Role)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to assign role",
+		})
+		h.log.Error("failed to assign role", l.Error(err))
+		return
+	}
+	if !added {
+		c.JSON(http.StatusConflict, gin.H{
+			"error": "user already has this role",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, body)
+}
+
+// RevokeRole
+// @Summary RevokeRole
+// @Security ApiKeyAuth
+// @Description Api for taking a role away from a user, admin only
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param user_id query string true "User id"
+// @Param role query string true "Role"
+// @Success 200 {object} models.Status
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/admin/roles [delete]
+func (h *handlerV1) RevokeRole(c *gin.Context) {
+	removed, err := h.enforcer.DeleteRoleForUser(c.Query("user_id"), c.Query("role"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to revoke role",
+		})
+		h.log.Error("failed to revoke role", l.Error(err))
+		return
+	}
+	if !removed {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "user does not have this role",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Message: "role revoked"})
+}
+
+// UnlockUser
+// @Summary UnlockUser
+// @Security ApiKeyAuth
+// @Description Api for lifting the login lockout of a user, admin only
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path string true "User id"
+// @Success 200 {object} models.Status
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/admin/users/{id}/unlock [post]
+func (h *handlerV1) UnlockUser(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().UnlockUser(ctx, &pbu.UnlockUserReq{
+		UserId: c.Param("id"),
+	})
+	if status.Code(err) == codes.NotFound {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "user not found",
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to unlock user",
+		})
+		h.log.Error("failed to unlock user", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Message: res.Message})
+}
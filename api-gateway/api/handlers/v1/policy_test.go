@@ -0,0 +1,173 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"api-gateway/api/handlers/models"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/gin-gonic/gin"
+)
+
+// newPolicyHandler returns a handler with an in-memory enforcer holding the
+// given policies and role assignments
+func newPolicyHandler(t *testing.T, policies, roles [][]string) *handlerV1 {
+	e, err := casbin.NewSyncedEnforcer("../../../auth.conf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range policies {
+		if _, err := e.AddPolicy(p[0], p[1], p[2]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, g := range roles {
+		if _, err := e.AddRoleForUser(g[0], g[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	h := newTestHandler(&fakeServices{})
+	h.enforcer = e
+	return h
+}
+
+func servePolicy(handler gin.HandlerFunc, method, target, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(method, target, strings.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+	handler(c)
+	return w
+}
+
+func TestAddPolicy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name string
+		body string
+		want int
+	}{
+		{"new policy", `{"sub":"editor","obj":"/v1/posts/*","act":"PUT"}`, http.StatusCreated},
+		{"existing policy", `{"sub":"user","obj":"/v1/posts","act":"GET"}`, http.StatusConflict},
+		{"missing act", `{"sub":"editor","obj":"/v1/posts/*"}`, http.StatusBadRequest},
+		{"not json", `editor`, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newPolicyHandler(t, [][]string{{"user", "/v1/posts", "GET"}}, nil)
+
+			w := servePolicy(h.AddPolicy, http.MethodPost, "/v1/admin/policies", tt.body)
+
+			if w.Code != tt.want {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.want, w.Body)
+			}
+			if has, _ := h.enforcer.HasPolicy("editor", "/v1/posts/*", "PUT"); has != (tt.want == http.StatusCreated) {
+				t.Errorf("enforcer has the policy = %v after status %d", has, w.Code)
+			}
+		})
+	}
+}
+
+func TestRemovePolicy(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name  string
+		query string
+		want  int
+	}{
+		{"existing policy", "sub=user&obj=/v1/posts&act=GET", http.StatusOK},
+		{"unknown policy", "sub=user&obj=/v1/posts&act=POST", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newPolicyHandler(t, [][]string{{"user", "/v1/posts", "GET"}}, nil)
+
+			w := servePolicy(h.RemovePolicy, http.MethodDelete, "/v1/admin/policies?"+tt.query, "")
+
+			if w.Code != tt.want {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.want, w.Body)
+			}
+			if has, _ := h.enforcer.HasPolicy("user", "/v1/posts", "GET"); has != (tt.want != http.StatusOK) {
+				t.Errorf("enforcer has the policy = %v after status %d", has, w.Code)
+			}
+		})
+	}
+}
+
+func TestAssignAndRevokeRole(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := newPolicyHandler(t, [][]string{{"editor", "/v1/posts/*", "DELETE"}}, nil)
+
+	steps := []struct {
+		name    string
+		handler gin.HandlerFunc
+		method  string
+		target  string
+		body    string
+		want    int
+	}{
+		{"assign", h.AssignRole, http.MethodPost, "/v1/admin/roles", `{"user_id":"user-1","role":"editor"}`, http.StatusCreated},
+		{"assign again", h.AssignRole, http.MethodPost, "/v1/admin/roles", `{"user_id":"user-1","role":"editor"}`, http.StatusConflict},
+		{"assign without role", h.AssignRole, http.MethodPost, "/v1/admin/roles", `{"user_id":"user-1"}`, http.StatusBadRequest},
+		{"revoke", h.RevokeRole, http.MethodDelete, "/v1/admin/roles?user_id=user-1&role=editor", "", http.StatusOK},
+		{"revoke again", h.RevokeRole, http.MethodDelete, "/v1/admin/roles?user_id=user-1&role=editor", "", http.StatusNotFound},
+	}
+	for _, s := range steps {
+		w := servePolicy(s.handler, s.method, s.target, s.body)
+		if w.Code != s.want {
+			t.Fatalf("%s: status = %d, want %d: %s", s.name, w.Code, s.want, w.Body)
+		}
+		if s.name == "assign" {
+			if ok, err := h.enforcer.Enforce("user-1", "/v1/posts/post-1", "DELETE"); err != nil || !ok {
+				t.Fatalf("user-1 not allowed through the assigned role: %v, %v", ok, err)
+			}
+		}
+	}
+	if ok, _ := h.enforcer.Enforce("user-1", "/v1/posts/post-1", "DELETE"); ok {
+		t.Error("user-1 still allowed after the role was revoked")
+	}
+}
+
+func TestListPolicies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := newPolicyHandler(t,
+		[][]string{{"user", "/v1/posts", "GET"}, {"editor", "/v1/posts/*", "PUT"}},
+		[][]string{{"user-1", "editor"}},
+	)
+
+	w := servePolicy(h.ListPolicies, http.MethodGet, "/v1/admin/policies", "")
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d: %s", w.Code, http.StatusOK, w.Body)
+	}
+	var got models.Policies
+	if err := json.Unmarshal(w.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	want := models.Policies{
+		Policies: []models.Policy{
+			{Sub: "user", Obj: "/v1/posts", Act: "GET"},
+			{Sub: "editor", Obj: "/v1/posts/*", Act: "PUT"},
+		},
+		Roles: []models.RoleAssignment{{UserId: "user-1", Role: "editor"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListPolicies = %+v, want %+v", got, want)
+	}
+}
+
+func TestListPoliciesEmpty(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	h := newPolicyHandler(t, nil, nil)
+
+	w := servePolicy(h.ListPolicies, http.MethodGet, "/v1/admin/policies", "")
+
+	if body := strings.TrimSpace(w.Body.String()); body != `{"policies":[],"roles":[]}` {
+		t.Errorf("body = %s, want empty lists", body)
+	}
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"net/http"
+
+	pbc "api-gateway/protos/comment-service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetPostById
+// @Summary GetPostById
+// @Security ApiKeyAuth
+// @Description Api for showing a post with its writer and comments, which counts a view of the post. A caller that is not signed in is counted once per client address.
+// @Tags comment
+// @Accept json
+// @Produce json
+// @Param id query string true "ID"
+// @Success 200 {object} models.Post
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/post [get]
+func (h *handlerV1) GetPostById(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	response, err := h.serviceManager.CommentService().GetPostById(ctx, &pbc.GetPostByIdRequest{
+		PostId:   c.Query("id"),
+		ClientIp: c.ClientIP(),
+	})
+	if err != nil {
+		h.relationError(c, err, "failed to get post by id")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	pbc "api-gateway/protos/comment-service"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeCommentService records the posts asked for and finds only post-1
+type fakeCommentService struct {
+	pbc.CommentServiceClient
+
+	requests []*pbc.GetPostByIdRequest
+}
+
+func (f *fakeCommentService) GetPostById(ctx context.Context, in *pbc.GetPostByIdRequest, opts ...grpc.CallOption) (*pbc.GetPostByIdResponse, error) {
+	f.requests = append(f.requests, in)
+	if in.PostId != "post-1" {
+		return nil, status.Error(codes.NotFound, "post not found")
+	}
+	return &pbc.GetPostByIdResponse{Post: &pbc.Post{Id: in.PostId}}, nil
+}
+
+func TestGetPostByIdForwardsClientIp(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		name string
+		id   string
+		want int
+	}{
+		{name: "found", id: "post-1", want: http.StatusOK},
+		{name: "hidden or missing", id: "post-2", want: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comments := &fakeCommentService{}
+			h := newTestHandler(&fakeServices{comment: comments})
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/v1/post?id="+tt.id, nil)
+			c.Request.RemoteAddr = "203.0.113.7:41000"
+
+			h.GetPostById(c)
+
+			if w.Code != tt.want {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.want, w.Body)
+			}
+			if len(comments.requests) != 1 || comments.requests[0].ClientIp != "203.0.113.7" {
+				t.Fatalf("requests = %v, want one from 203.0.113.7", comments.requests)
+			}
+		})
+	}
+}
@@ -2,17 +2,19 @@ package v1
 
 import (
 	"context"
-	"log"
+	"math"
 	"net/http"
-
+	"strconv"
 	"time"
 
 	"api-gateway/api/handlers/models"
-	token "api-gateway/api/handlers/tokens"
 	l "api-gateway/pkg/logger"
 	pbu "api-gateway/protos/user-service"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Register ...
@@ -33,6 +35,7 @@ import (
 // @Param profilePicture query string false "Profile picture URL"
 // @Success 200 {object} models.User
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/register/ [post]
 func (h *handlerV1) Register(c *gin.Context) {
@@ -73,6 +76,18 @@ func (h *handlerV1) Register(c *gin.Context) {
 		Gender:         body.Gender,
 		ProfilePicture: body.ProfilePicture,
 	})
+	if status.Code(err) == codes.InvalidArgument {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+		return
+	}
+	if status.Code(err) == codes.AlreadyExists {
+		c.JSON(http.StatusConflict, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
@@ -86,15 +101,18 @@ func (h *handlerV1) Register(c *gin.Context) {
 
 // LogIn
 // @Summary LogIn User
-// @Description LogIn - Api for login users
+// @Description LogIn - Api for login users, logging in to an account deleted within its restore window restores it
 // @Tags Authorizations
 // @Accept json
 // @Produce json
 // @Param email query string false "Email"
 // @Param username query string false "Username"
 // @Param password query string true "Password"
-// @Success 200 {object} models.User
+// @Success 200 {object} models.UserBYtokens
+// @Success 202 {object} models.MfaChallenge
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 429 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/login [post]
 func (h *handlerV1) LogIn(c *gin.Context) {
@@ -116,27 +134,44 @@ func (h *handlerV1) LogIn(c *gin.Context) {
 		Email:    email,
 		UserName: username,
 		Password: password,
+		ClientIp: c.ClientIP(),
 	})
 
+	if status.Code(err) == codes.ResourceExhausted {
+		c.Header("Retry-After", strconv.Itoa(retryAfter(err)))
+		c.JSON(http.StatusTooManyRequests, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+		return
+	}
+	if status.Code(err) == codes.Unauthenticated {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "invalid login or password",
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "failed to log in user",
 		})
 		return
 	}
-
-	h.jwthandler = token.JWTHandler{
-		Sub:       response.User.Id,
-		Iss:       time.Now().String(),
-		Exp:       time.Now().Add(time.Hour * 6).String(),
-		Role:      "user",
-		SigninKey: h.cfg.SigningKey,
-		Timeot:    h.cfg.AccessTokenTimout,
+	if response.MfaRequired {
+		// no tokens yet, the client has to finish with /v1/login/2fa
+		c.JSON(http.StatusAccepted, models.MfaChallenge{
+			MfaRequired: true,
+			Challenge:   response.Challenge,
+		})
+		return
 	}
 
-	access, refresh_token, err := h.jwthandler.GenerateAuthJWT()
+	access, refresh_token, err := h.issueTokens(ctx, response.User.Id)
 	if err != nil {
-		log.Fatal("error while generating auth token")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "error generating token",
+		})
+		h.log.Error("failed to generate tokens", l.Error(err))
+		return
 	}
 
 	var respModel models.UserBYtokens
@@ -148,6 +183,7 @@ func (h *handlerV1) LogIn(c *gin.Context) {
 	respModel.RefreshToken = refresh_token
 	respModel.Password = response.User.Password
 	respModel.AccessToken = access
+	respModel.Restored = response.Restored
 
 	c.JSON(http.StatusOK, respModel)
 }
@@ -162,6 +198,9 @@ func (h *handlerV1) LogIn(c *gin.Context) {
 // @Param code query string true "Code"
 // @Success 200 {object} models.UserResponse
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 410 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/verification [post]
 func (h *handlerV1) Verification(c *gin.Context) {
@@ -176,24 +215,21 @@ func (h *handlerV1) Verification(c *gin.Context) {
 		Email: email,
 		Code:  code,
 	})
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": "failed to verify user",
+	// user-service reports an expired code as DeadlineExceeded, a timeout of
+	// this call leaves ctx done
+	if status.Code(err) == codes.DeadlineExceeded && ctx.Err() == nil {
+		c.JSON(http.StatusGone, gin.H{
+			"error": status.Convert(err).Message(),
 		})
 		return
 	}
-
-	// Create access and refresh tokens JWT
-	h.jwthandler = token.JWTHandler{
-		Sub:       createdUser.User.Id,
-		Iss:       time.Now().String(),
-		Exp:       time.Now().Add(time.Hour * 6).String(),
-		Role:      "user",
-		SigninKey: h.cfg.SigningKey,
-		Timeot:    h.cfg.AccessTokenTimout,
+	if err != nil {
+		h.relationError(c, err, "failed to verify user")
+		return
 	}
+
 	// aksestoken bn refreshtokeni generatsa qiliah
-	access, refresh, err := h.jwthandler.GenerateAuthJWT()
+	access, refresh, err := h.issueTokens(ctx, createdUser.User.Id)
 
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, "error generating token")
@@ -212,3 +248,14 @@ func (h *handlerV1) Verification(c *gin.Context) {
 
 	c.JSON(http.StatusOK, response)
 }
+
+// retryAfter returns the seconds from the RetryInfo of a lockout error,
+// rounded up, or 1 when the service did not say
+func retryAfter(err error) int {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			return int(math.Max(1, math.Ceil(info.RetryDelay.AsDuration().Seconds())))
+		}
+	}
+	return 1
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestVerificationErrors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		code codes.Code
+		want int
+	}{
+		{codes.InvalidArgument, http.StatusBadRequest},
+		{codes.NotFound, http.StatusNotFound},
+		{codes.DeadlineExceeded, http.StatusGone},
+		{codes.AlreadyExists, http.StatusConflict},
+		{codes.Unavailable, http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code.String(), func(t *testing.T) {
+			h := newTestHandler(&fakeServices{
+				user: &fakeUserService{authErr: status.Error(tt.code, "nope")},
+			})
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/v1/verification?email=a@b.c&code=123456", nil)
+
+			h.Verification(c)
+
+			if w.Code != tt.want {
+				t.Fatalf("status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"net/http"
+
+	"api-gateway/api/handlers/models"
+	pbp "api-gateway/protos/post-service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/cast"
+)
+
+// SearchTags
+// @Summary SearchTags
+// @Security ApiKeyAuth
+// @Description Api for autocompleting hashtags, the most used ones starting with prefix come first
+// @Tags post
+// @Accept json
+// @Produce json
+// @Param prefix query string true "Start of the tag, with or without #"
+// @Param limit query int false "Page size, at most 100"
+// @Success 200 {object} models.Tags
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/tags [get]
+func (h *handlerV1) SearchTags(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.PostService().SearchTags(ctx, &pbp.SearchTagsReq{
+		Prefix: c.Query("prefix"),
+		Limit:  cast.ToInt64(c.Query("limit")),
+	})
+	if err != nil {
+		h.relationError(c, err, "failed to search tags")
+		return
+	}
+
+	tags := models.Tags{Tags: []models.Tag{}}
+	for _, t := range res.Tags {
+		tags.Tags = append(tags.Tags, models.Tag{Name: t.Name, Posts: t.Posts})
+	}
+	c.JSON(http.StatusOK, tags)
+}
+
+// GetTag
+// @Summary GetTag
+// @Security ApiKeyAuth
+// @Description Api for how many posts use a hashtag
+// @Tags post
+// @Accept json
+// @Produce json
+// @Param name path string true "Tag, without #"
+// @Success 200 {object} models.Tag
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/tags/{name} [get]
+func (h *handlerV1) GetTag(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.PostService().GetTag(ctx, &pbp.TagReq{Name: c.Param("name")})
+	if err != nil {
+		h.relationError(c, err, "failed to get tag")
+		return
+	}
+	c.JSON(http.StatusOK, models.Tag{Name: res.Name, Posts: res.Posts})
+}
+
+// GetPostsByTag
+// @Summary GetPostsByTag
+// @Security ApiKeyAuth
+// @Description Api for the posts filed under a hashtag, newest first
+// @Tags post
+// @Accept json
+// @Produce json
+// @Param name path string true "Tag, without #"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "Page size, at most 100"
+// @Success 200 {object} models.TagPosts
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/tags/{name}/posts [get]
+func (h *handlerV1) GetPostsByTag(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.PostService().GetPostsByTag(ctx, &pbp.PostsByTagReq{
+		Tag:    c.Param("name"),
+		Cursor: c.Query("cursor"),
+		Limit:  cast.ToInt64(c.Query("limit")),
+	})
+	if err != nil {
+		h.relationError(c, err, "failed to get posts by tag")
+		return
+	}
+
+	posts := models.TagPosts{Posts: []models.TagPost{}, NextCursor: res.NextCursor}
+	for _, p := range res.Posts {
+		posts.Posts = append(posts.Posts, models.TagPost{
+			Id:        p.Id,
+			Title:     p.Title,
+			Content:   p.Content,
+			Category:  p.Category,
+			OwnerId:   p.OwnerId,
+			Views:     p.Views,
+			Likes:     p.Likes,
+			Dislikes:  p.Dislikes,
+			CreatedAt: p.CreatedAt,
+		})
+	}
+	c.JSON(http.StatusOK, posts)
+}
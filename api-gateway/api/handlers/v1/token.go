@@ -0,0 +1,163 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"api-gateway/api/handlers/models"
+	token "api-gateway/api/handlers/tokens"
+	l "api-gateway/pkg/logger"
+	pbu "api-gateway/protos/user-service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/spf13/cast"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RefreshToken
+// @Summary RefreshToken
+// @Description Api for exchanging a refresh token for a new access and refresh token
+// @Tags Authorizations
+// @Accept json
+// @Produce json
+// @Param refresh_token query string true "Refresh token"
+// @Success 200 {object} models.Tokens
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/token/refresh [post]
+func (h *handlerV1) RefreshToken(c *gin.Context) {
+	claims, err := token.ExtractClaim(c.Query("refresh_token"), []byte(h.cfg.SigningKey))
+	if err != nil || cast.ToString(claims["typ"]) != "refresh" {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "invalid refresh token",
+		})
+		return
+	}
+
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	next := h.newRefreshToken(cast.ToString(claims["sub"]), cast.ToString(claims["fid"]))
+	res, err := h.serviceManager.UserService().RotateRefreshToken(ctx, &pbu.RotateRefreshTokenReq{
+		Id:   cast.ToString(claims["jti"]),
+		Next: next,
+	})
+	if status.Code(err) == codes.Unauthenticated {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to refresh token",
+		})
+		h.log.Error("failed to rotate refresh token", l.Error(err))
+		return
+	}
+
+	access, refresh, err := h.signTokens(res.User.Id, next)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "error generating token",
+		})
+		h.log.Error("failed to generate tokens", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Tokens{
+		AccessToken:  access,
+		RefreshToken: refresh,
+	})
+}
+
+// issueTokens starts a new refresh token family for the user, registers its
+// first token in user-service and signs the pair
+func (h *handlerV1) issueTokens(ctx context.Context, userId string) (access, refresh string, err error) {
+	rt := h.newRefreshToken(userId, uuid.NewString())
+	if _, err = h.serviceManager.UserService().SaveRefreshToken(ctx, rt); err != nil {
+		return "", "", err
+	}
+	return h.signTokens(userId, rt)
+}
+
+func (h *handlerV1) newRefreshToken(userId, familyId string) *pbu.RefreshToken {
+	return &pbu.RefreshToken{
+		Id:        uuid.NewString(),
+		FamilyId:  familyId,
+		UserId:    userId,
+		ExpiresAt: time.Now().Add(time.Minute * time.Duration(h.cfg.RefreshTokenTimeout)).Format(time.RFC3339),
+	}
+}
+
+func (h *handlerV1) signTokens(userId string, rt *pbu.RefreshToken) (access, refresh string, err error) {
+	jwtHandler := token.JWTHandler{
+		Sub:            userId,
+		Iss:            time.Now().String(),
+		Exp:            time.Now().Add(time.Hour * 6).String(),
+		Role:           "user",
+		SigninKey:      h.cfg.SigningKey,
+		Timeot:         h.cfg.AccessTokenTimout,
+		RefreshId:      rt.Id,
+		FamilyId:       rt.FamilyId,
+		RefreshTimeout: h.cfg.RefreshTokenTimeout,
+	}
+	return jwtHandler.GenerateAuthJWT()
+}
+
+// Logout
+// @Summary Logout
+// @Security ApiKeyAuth
+// @Description Api for revoking the current access token and, when given, the family of the refresh token
+// @Tags Authorizations
+// @Accept json
+// @Produce json
+// @Param refresh_token query string false "Refresh token"
+// @Success 200 {object} models.Status
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/logout [post]
+func (h *handlerV1) Logout(c *gin.Context) {
+	claims, err := token.ExtractClaim(c.GetHeader("Authorization"), []byte(h.cfg.SigningKey))
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "invalid access token",
+		})
+		return
+	}
+
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	// the access token only has to stay denied until it would expire anyway
+	ttl := time.Until(time.Unix(cast.ToInt64(claims["exp"]), 0))
+	if err := h.denylist.Add(ctx, cast.ToString(claims["jti"]), ttl); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to log out",
+		})
+		h.log.Error("failed to revoke access token", l.Error(err))
+		return
+	}
+
+	if refresh := c.Query("refresh_token"); refresh != "" {
+		rtClaims, err := token.ExtractClaim(refresh, []byte(h.cfg.SigningKey))
+		if err == nil && cast.ToString(rtClaims["typ"]) == "refresh" {
+			_, err = h.serviceManager.UserService().RevokeRefreshToken(ctx, &pbu.RefreshToken{
+				Id:     cast.ToString(rtClaims["jti"]),
+				UserId: cast.ToString(claims["sub"]),
+			})
+			if err != nil && status.Code(err) != codes.NotFound {
+				c.JSON(http.StatusInternalServerError, gin.H{
+					"error": "failed to log out",
+				})
+				h.log.Error("failed to revoke refresh token", l.Error(err))
+				return
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, models.Status{Message: "logged out"})
+}
@@ -0,0 +1,117 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"api-gateway/api/handlers/tokens"
+	"api-gateway/pkg/denylist"
+	pbu "api-gateway/protos/user-service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/cast"
+	"google.golang.org/grpc"
+)
+
+// fakeRevokeService records the refresh tokens revoked
+type fakeRevokeService struct {
+	pbu.UserServiceClient
+
+	revoked []*pbu.RefreshToken
+}
+
+func (f *fakeRevokeService) RevokeRefreshToken(ctx context.Context, in *pbu.RefreshToken, opts ...grpc.CallOption) (*pbu.Status, error) {
+	f.revoked = append(f.revoked, in)
+	return &pbu.Status{}, nil
+}
+
+func TestLogout(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	const signingKey = "test-key"
+	issue := func(t *testing.T) (access, refresh, jti string) {
+		jwt := tokens.JWTHandler{Sub: "user-1", Role: "user", SigninKey: signingKey, Timeot: 5, RefreshId: "refresh-1", FamilyId: "family-1", RefreshTimeout: 60}
+		access, refresh, err := jwt.GenerateAuthJWT()
+		if err != nil {
+			t.Fatal(err)
+		}
+		claims, err := tokens.ExtractClaim(access, []byte(signingKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return access, refresh, cast.ToString(claims["jti"])
+	}
+
+	tests := []struct {
+		name        string
+		header      func(access string) string
+		refresh     func(access, refresh string) string
+		want        int
+		wantDenied  bool
+		wantRevoked bool
+	}{
+		{
+			name:       "access token only",
+			header:     func(access string) string { return access },
+			refresh:    func(access, refresh string) string { return "" },
+			want:       http.StatusOK,
+			wantDenied: true,
+		},
+		{
+			name:        "with refresh token",
+			header:      func(access string) string { return access },
+			refresh:     func(access, refresh string) string { return refresh },
+			want:        http.StatusOK,
+			wantDenied:  true,
+			wantRevoked: true,
+		},
+		{
+			name:       "access token passed as refresh token",
+			header:     func(access string) string { return access },
+			refresh:    func(access, refresh string) string { return access },
+			want:       http.StatusOK,
+			wantDenied: true,
+		},
+		{
+			name:    "invalid access token",
+			header:  func(access string) string { return access + "x" },
+			refresh: func(access, refresh string) string { return refresh },
+			want:    http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			access, refresh, jti := issue(t)
+			users := &fakeRevokeService{}
+			h := newTestHandler(&fakeServices{user: users})
+			h.cfg.SigningKey = signingKey
+			h.denylist = denylist.NewMemory()
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			target := "/v1/logout"
+			if rt := tt.refresh(access, refresh); rt != "" {
+				target += "?refresh_token=" + url.QueryEscape(rt)
+			}
+			c.Request = httptest.NewRequest(http.MethodPost, target, nil)
+			c.Request.Header.Set("Authorization", tt.header(access))
+
+			h.Logout(c)
+
+			if w.Code != tt.want {
+				t.Fatalf("status = %d, want %d: %s", w.Code, tt.want, w.Body)
+			}
+			if denied, _ := h.denylist.Contains(context.Background(), jti); denied != tt.wantDenied {
+				t.Errorf("access token denied = %v, want %v", denied, tt.wantDenied)
+			}
+			if revoked := len(users.revoked) == 1; revoked != tt.wantRevoked {
+				t.Fatalf("revoked %v, want a revoke %v", users.revoked, tt.wantRevoked)
+			}
+			if tt.wantRevoked && (users.revoked[0].Id != "refresh-1" || users.revoked[0].UserId != "user-1") {
+				t.Errorf("revoked %v, want refresh-1 of user-1", users.revoked[0])
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"net/http"
+
+	"api-gateway/api/handlers/models"
+	pbp "api-gateway/protos/post-service"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/cast"
+)
+
+// GetTrending
+// @Summary GetTrending
+// @Security ApiKeyAuth
+// @Description Api for the trending posts, ranked by views, reactions and comments that count less the older the post is. The ranking is recomputed every few minutes.
+// @Tags post
+// @Accept json
+// @Produce json
+// @Param category query string false "Only posts of this category"
+// @Param window query int false "Only posts of the last window hours"
+// @Param page query int false "Page, starting at 1"
+// @Param limit query int false "Page size, at most 100"
+// @Success 200 {object} models.TrendingPosts
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/posts/trending [get]
+func (h *handlerV1) GetTrending(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.PostService().GetTrending(ctx, &pbp.GetTrendingReq{
+		Category:    c.Query("category"),
+		WindowHours: cast.ToInt64(c.Query("window")),
+		Page:        cast.ToInt64(c.Query("page")),
+		Limit:       cast.ToInt64(c.Query("limit")),
+	})
+	if err != nil {
+		h.relationError(c, err, "failed to get trending posts")
+		return
+	}
+
+	trending := models.TrendingPosts{Posts: []models.TrendingPost{}, RankedAt: res.RankedAt}
+	for _, p := range res.Posts {
+		trending.Posts = append(trending.Posts, models.TrendingPost{
+			Id:        p.Post.Id,
+			Title:     p.Post.Title,
+			Content:   p.Post.Content,
+			Category:  p.Post.Category,
+			OwnerId:   p.Post.OwnerId,
+			Views:     p.Post.Views,
+			Likes:     p.Post.Likes,
+			Dislikes:  p.Post.Dislikes,
+			CreatedAt: p.Post.CreatedAt,
+			Score:     p.Score,
+		})
+	}
+
+	c.JSON(http.StatusOK, trending)
+}
@@ -0,0 +1,207 @@
+package v1
+
+import (
+	"net/http"
+
+	"api-gateway/api/handlers/middleware"
+	"api-gateway/api/handlers/models"
+	l "api-gateway/pkg/logger"
+	pbu "api-gateway/protos/user-service"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EnrollTotp
+// @Summary EnrollTotp
+// @Security ApiKeyAuth
+// @Description Api for creating a TOTP secret, 2FA is turned on by ConfirmTotp
+// @Tags TwoFactor
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.TotpEnrollment
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/2fa/enroll [post]
+func (h *handlerV1) EnrollTotp(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().EnrollTotp(ctx, &pbu.TotpReq{
+		UserId: c.GetString(middleware.UserIdKey),
+	})
+	if err != nil {
+		h.twoFactorError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.TotpEnrollment{
+		Secret: res.Secret,
+		Uri:    res.Uri,
+	})
+}
+
+// ConfirmTotp
+// @Summary ConfirmTotp
+// @Security ApiKeyAuth
+// @Description Api for turning 2FA on with a first code, returns the recovery codes
+// @Tags TwoFactor
+// @Accept json
+// @Produce json
+// @Param code query string true "TOTP code"
+// @Success 200 {object} models.RecoveryCodes
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/2fa/confirm [post]
+func (h *handlerV1) ConfirmTotp(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().ConfirmTotp(ctx, &pbu.TotpReq{
+		UserId: c.GetString(middleware.UserIdKey),
+		Code:   c.Query("code"),
+	})
+	if err != nil {
+		h.twoFactorError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.RecoveryCodes{Codes: res.Codes})
+}
+
+// GenerateRecoveryCodes
+// @Summary GenerateRecoveryCodes
+// @Security ApiKeyAuth
+// @Description Api for replacing the recovery codes, the old ones stop working
+// @Tags TwoFactor
+// @Accept json
+// @Produce json
+// @Param code query string true "TOTP code"
+// @Success 200 {object} models.RecoveryCodes
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/2fa/recovery-codes [post]
+func (h *handlerV1) GenerateRecoveryCodes(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().GenerateRecoveryCodes(ctx, &pbu.TotpReq{
+		UserId: c.GetString(middleware.UserIdKey),
+		Code:   c.Query("code"),
+	})
+	if err != nil {
+		h.twoFactorError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.RecoveryCodes{Codes: res.Codes})
+}
+
+// DisableTotp
+// @Summary DisableTotp
+// @Security ApiKeyAuth
+// @Description Api for turning 2FA off with a TOTP or recovery code
+// @Tags TwoFactor
+// @Accept json
+// @Produce json
+// @Param code query string true "TOTP or recovery code"
+// @Success 200 {object} models.Status
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 409 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/2fa/disable [post]
+func (h *handlerV1) DisableTotp(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	res, err := h.serviceManager.UserService().DisableTotp(ctx, &pbu.TotpReq{
+		UserId: c.GetString(middleware.UserIdKey),
+		Code:   c.Query("code"),
+	})
+	if err != nil {
+		h.twoFactorError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.Status{Message: res.Message})
+}
+
+// LogInTwoFactor
+// @Summary LogInTwoFactor
+// @Description Api for finishing a login with 2FA, exchanges the challenge and a TOTP or recovery code for tokens
+// @Tags Authorizations
+// @Accept json
+// @Produce json
+// @Param challenge query string true "Challenge from login"
+// @Param code query string true "TOTP or recovery code"
+// @Success 200 {object} models.UserBYtokens
+// @Failure 401 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/login/2fa [post]
+func (h *handlerV1) LogInTwoFactor(c *gin.Context) {
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	response, err := h.serviceManager.UserService().VerifyLoginChallenge(ctx, &pbu.LoginChallengeReq{
+		Challenge: c.Query("challenge"),
+		Code:      c.Query("code"),
+	})
+	if status.Code(err) == codes.Unauthenticated {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "failed to log in user",
+		})
+		h.log.Error("failed to verify login challenge", l.Error(err))
+		return
+	}
+
+	access, refresh, err := h.issueTokens(ctx, response.User.Id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "error generating token",
+		})
+		h.log.Error("failed to generate tokens", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, models.UserBYtokens{
+		Id:           response.User.Id,
+		Name:         response.User.FirstName,
+		LastName:     response.User.LastName,
+		UserName:     response.User.UserName,
+		Email:        response.User.Email,
+		AccessToken:  access,
+		RefreshToken: refresh,
+		Restored:     response.Restored,
+	})
+}
+
+func (h *handlerV1) twoFactorError(c *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+	case codes.FailedPrecondition:
+		c.JSON(http.StatusConflict, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+	case codes.PermissionDenied:
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "two-factor authentication request failed",
+		})
+		h.log.Error("two-factor authentication request failed", l.Error(err))
+	}
+}
@@ -1,12 +1,12 @@
 package v1
 
 import (
-	"context"
 	"net/http"
-	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/spf13/cast"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	models "api-gateway/api/handlers/models"
 	l "api-gateway/pkg/logger"
@@ -41,7 +41,7 @@ func (h *handlerV1) CreateUser(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.authContext(c)
 	defer cancel()
 
 	response, err := h.serviceManager.UserService().Register(ctx, &pbu.CreateUserReq{
@@ -56,6 +56,12 @@ func (h *handlerV1) CreateUser(c *gin.Context) {
 		Gender:         body.Gender,
 		ProfilePicture: body.ProfilePicture,
 	})
+	if status.Code(err) == codes.InvalidArgument {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
@@ -84,7 +90,7 @@ func (h *handlerV1) GetUser(c *gin.Context) {
 	field := c.Query("field")
 	value := c.Query("value")
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.authContext(c)
 	defer cancel()
 
 	response, err := h.serviceManager.UserService().Get(
@@ -112,11 +118,11 @@ func (h *handlerV1) GetUser(c *gin.Context) {
 // @Produce json
 // @Param page query string false "Page number for pagination"
 // @Param limit query string false "Number of items per page"
-// @Param field query string false "Field for filtering"
+// @Param field query string false "Field for filtering, e.g. gender or username"
 // @Param value query string false "Value for filtering"
-// @Param sortBy query string false "Field to sort by"
-// @Param startedAt query string false "Start date for date range filtering"
-// @Param endedAt query string false "End date for date range filtering"
+// @Param sort_by query string false "Field to sort by, prefix with - for descending"
+// @Param started_at query string false "Created at or after, RFC3339 time or ISO date"
+// @Param ended_at query string false "Created before, RFC3339 time or ISO date (inclusive)"
 // @Success 200 {object} models.User
 // @Failure 400 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
@@ -133,7 +139,7 @@ func (h *handlerV1) ListUsers(c *gin.Context) {
 		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.authContext(c)
 	defer cancel()
 
 	response, err := h.serviceManager.UserService().GetAll(
@@ -146,6 +152,12 @@ func (h *handlerV1) ListUsers(c *gin.Context) {
 			StartedAt: params.StartedAt,
 			EndedAt:   params.EndedAt,
 		})
+	if status.Code(err) == codes.InvalidArgument {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
@@ -157,6 +169,56 @@ func (h *handlerV1) ListUsers(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
+// SearchUsers finds users by username, name and last name
+// @Summary SearchUsers
+// @Security ApiKeyAuth
+// @Description API for searching users, usernames starting with q come first
+// @Tags User
+// @Accept json
+// @Produce json
+// @Param q query string true "Search text"
+// @Param page query string false "Page number for pagination"
+// @Param limit query string false "Number of items per page"
+// @Success 200 {object} models.User
+// @Failure 400 {object} models.StandardErrorModel
+// @Failure 500 {object} models.StandardErrorModel
+// @Router /v1/users/search [get]
+func (h *handlerV1) SearchUsers(c *gin.Context) {
+	params, errStr := utils.ParseQueryParams(c.Request.URL.Query())
+	if errStr != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": errStr[0],
+		})
+		h.log.Error("failed to parse query params json" + errStr[0])
+		return
+	}
+
+	ctx, cancel := h.authContext(c)
+	defer cancel()
+
+	response, err := h.serviceManager.UserService().SearchUsers(
+		ctx, &pbu.SearchUsersReq{
+			Q:     c.Query("q"),
+			Page:  params.Page,
+			Limit: params.Limit,
+		})
+	if status.Code(err) == codes.InvalidArgument {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+		return
+	}
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		h.log.Error("failed to search users", l.Error(err))
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
 // UpdateUser updates user by field and value
 // @Summary UpdateUser
 // @Security ApiKeyAuth
@@ -169,13 +231,14 @@ func (h *handlerV1) ListUsers(c *gin.Context) {
 // @Param User body models.UserUpdate true "updateUserModel"
 // @Success 200 {object} models.User
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/user/update [put]
 func (h *handlerV1) UpdateUser(c *gin.Context) {
 	field := c.Query("field")
 	value := c.Query("value")
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.authContext(c)
 	defer cancel()
 
 	// Fetch existing user by field and value
@@ -204,15 +267,28 @@ func (h *handlerV1) UpdateUser(c *gin.Context) {
 
 	// Update user using service method
 	updated, err := h.serviceManager.UserService().Update(ctx, &pbu.UpdateUserReq{
+		Id:             response.Id,
 		FirstName:      response.FirstName,
 		LastName:       response.LastName,
-		Password:       response.Password,
 		UserName:       response.UserName,
 		BirthDate:      response.BirthDate,
 		Biography:      response.Biography,
 		Gender:         response.Gender,
 		ProfilePicture: response.ProfilePicture,
+		PhoneNumber:    response.PhoneNumber,
 	})
+	if status.Code(err) == codes.InvalidArgument {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+		return
+	}
+	if status.Code(err) == codes.PermissionDenied {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": status.Convert(err).Message(),
+		})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
@@ -227,37 +303,38 @@ func (h *handlerV1) UpdateUser(c *gin.Context) {
 // DeleteUser deletes user by field and value
 // @Summary DeleteUser
 // @Security ApiKeyAuth
-// @Description API for deleting user by field and value
+// @Description API for deleting user by field and value. The account can be restored by logging in during the restore window, after it the user, their posts and comments are removed for good. is_hard_delete skips the window.
 // @Tags User
 // @Accept json
 // @Produce json
 // @Param field query string true "Field name for filtering"
 // @Param value query string true "Value for filtering"
-// @Success 200 {object} models.User
+// @Param is_hard_delete query bool false "Remove the account right away"
+// @Success 200 {object} models.Status
 // @Failure 400 {object} models.StandardErrorModel
+// @Failure 403 {object} models.StandardErrorModel
+// @Failure 404 {object} models.StandardErrorModel
 // @Failure 500 {object} models.StandardErrorModel
 // @Router /v1/users [delete]
 func (h *handlerV1) DeleteUser(c *gin.Context) {
 	field := c.Query("field")
 	value := c.Query("value")
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.authContext(c)
 	defer cancel()
 
 	response, err := h.serviceManager.UserService().Delete(
 		ctx, &pbu.DeleteUserReq{
-			Field: field,
-			Value: value,
+			Field:        field,
+			Value:        value,
+			IsHardDelete: c.Query("is_hard_delete"),
 		})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		h.log.Error("failed to delete user", l.Error(err))
+		h.relationError(c, err, "failed to delete user")
 		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, models.Status{Message: response.Message})
 }
 
 // GetAllData data
@@ -277,11 +354,11 @@ func (h *handlerV1) GetAllUserData(c *gin.Context) {
 	page := c.Query("page")
 	limit := c.Query("limit")
 
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*time.Duration(h.cfg.CtxTimeout))
+	ctx, cancel := h.authContext(c)
 	defer cancel()
 
-	response, err := h.serviceManager.CommentService().GetAll(
-		ctx, &pbc.GetAllCommentsReq{
+	response, err := h.serviceManager.CommentService().GetAllUsers(
+		ctx, &pbc.GetAllCommentsRequest{
 			Page:  cast.ToInt64(page),
 			Limit: cast.ToInt64(limit),
 		})
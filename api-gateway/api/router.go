@@ -1,11 +1,17 @@
 package api
 
 import (
+	"path/filepath"
+	"strings"
+
 	_ "api-gateway/api/docs" // swag
 	"api-gateway/api/handlers/middleware"
 	"api-gateway/api/handlers/tokens"
 	v1 "api-gateway/api/handlers/v1"
 	"api-gateway/config"
+	"api-gateway/pkg/blobstore"
+	"api-gateway/pkg/denylist"
+	"api-gateway/pkg/export"
 	"api-gateway/pkg/logger"
 	"api-gateway/services"
 
@@ -21,7 +27,11 @@ type Option struct {
 	Conf           config.Config
 	Logger         logger.Logger
 	ServiceManager services.IServiceManager
-	CasbinEnforcer *casbin.Enforcer
+	CasbinEnforcer *casbin.SyncedEnforcer
+	Denylist       denylist.Denylist
+	Blobs          blobstore.BlobStore
+	ExportBlobs    blobstore.BlobStore
+	Exports        export.Store
 }
 
 // @Title Welcome to swagger service
@@ -33,6 +43,16 @@ type Option struct {
 func New(option Option) *gin.Engine {
 	router := gin.New()
 
+	// login attempts are counted per client address, it must not be
+	// taken from headers anyone can set
+	var proxies []string
+	if option.Conf.TrustedProxies != "" {
+		proxies = strings.Split(option.Conf.TrustedProxies, ",")
+	}
+	if err := router.SetTrustedProxies(proxies); err != nil {
+		option.Logger.Error("invalid trusted proxies", logger.Error(err))
+	}
+
 	jwtHandler := tokens.JWTHandler{
 		SigninKey: option.Conf.SigningKey,
 	}
@@ -43,27 +63,84 @@ func New(option Option) *gin.Engine {
 		Cfg:            option.Conf,
 		JWTHandler:     jwtHandler,
 		Enforcer:       option.CasbinEnforcer,
+		Denylist:       option.Denylist,
+		Blobs:          option.Blobs,
+		ExportBlobs:    option.ExportBlobs,
+		Exports:        option.Exports,
 	})
 
 	router.Use(gin.Logger())
 	router.Use(gin.Recovery())
-	router.Use(middleware.NewAuthorizer(option.CasbinEnforcer, jwtHandler, option.Conf))
+	router.Use(middleware.NewAuthorizer(option.CasbinEnforcer, jwtHandler, option.Conf, option.Denylist))
+
+	// uploads kept on the local filesystem are served by the gateway itself,
+	// only the pictures though, exports go through their signed links
+	if option.Conf.BlobBackend != "s3" {
+		router.Static("/media/avatars", filepath.Join(option.Conf.BlobDir, "avatars"))
+	}
 
 	api := router.Group("/v1")
 
 	// user registratsiya
 	api.POST("/register", handlerV1.Register)
 	api.POST("/login", handlerV1.LogIn)
+	api.POST("/login/2fa", handlerV1.LogInTwoFactor)
 	api.POST("/verification", handlerV1.Verification)
+	api.POST("/token/refresh", handlerV1.RefreshToken)
+	api.POST("/logout", handlerV1.Logout)
+	api.POST("/password/forgot", handlerV1.ForgotPassword)
+	api.POST("/password/reset", handlerV1.ResetPassword)
+
+	// two-factor authentication
+	api.POST("/2fa/enroll", handlerV1.EnrollTotp)
+	api.POST("/2fa/confirm", handlerV1.ConfirmTotp)
+	api.POST("/2fa/recovery-codes", handlerV1.GenerateRecoveryCodes)
+	api.POST("/2fa/disable", handlerV1.DisableTotp)
+
+	// policies, admin only
+	admin := api.Group("/admin", middleware.AdminOnly())
+	admin.GET("/policies", handlerV1.ListPolicies)
+	admin.POST("/policies", handlerV1.AddPolicy)
+	admin.DELETE("/policies", handlerV1.RemovePolicy)
+	admin.POST("/roles", handlerV1.AssignRole)
+	admin.DELETE("/roles", handlerV1.RevokeRole)
+	admin.POST("/users/:id/unlock", handlerV1.UnlockUser)
 
 	// // users
 	api.POST("/users", handlerV1.CreateUser)
 	api.GET("/users", handlerV1.ListUsers)
+	api.GET("/users/search", handlerV1.SearchUsers)
 	api.GET("/users/:id", handlerV1.GetUser)
 	api.PUT("/user/:id", handlerV1.UpdateUser)
 	api.DELETE("/users/:id", handlerV1.DeleteUser)
+	api.POST("/users/:id/picture", handlerV1.UploadProfilePicture)
+	api.POST("/users/:id/email", handlerV1.RequestEmailChange)
+	api.POST("/users/:id/email/confirm", handlerV1.ConfirmEmailChange)
 	api.GET("/all/user/data/:id", handlerV1.GetAllUserData)
 
+	// personal data exports
+	api.POST("/users/:id/export", handlerV1.RequestExport)
+	api.GET("/exports/:id", handlerV1.GetExport)
+	api.GET("/exports/:id/download", handlerV1.DownloadExport)
+
+	// follows
+	api.POST("/users/:id/follow", handlerV1.Follow)
+	api.DELETE("/users/:id/follow", handlerV1.Unfollow)
+	api.GET("/users/:id/followers", handlerV1.ListFollowers)
+	api.GET("/users/:id/following", handlerV1.ListFollowing)
+	api.GET("/users/:id/following/:target", handlerV1.IsFollowing)
+	api.PUT("/users/:id/privacy", handlerV1.SetPrivacy)
+	api.GET("/users/:id/follow-requests", handlerV1.ListFollowRequests)
+	api.POST("/users/:id/follow-requests/:requester/approve", handlerV1.ApproveFollowRequest)
+	api.POST("/users/:id/follow-requests/:requester/reject", handlerV1.RejectFollowRequest)
+	api.GET("/users/:id/suggestions", handlerV1.SuggestUsers)
+
+	// blocks and mutes
+	api.POST("/users/:id/block", handlerV1.Block)
+	api.DELETE("/users/:id/block", handlerV1.Unblock)
+	api.POST("/users/:id/mute", handlerV1.Mute)
+	api.DELETE("/users/:id/mute", handlerV1.Unmute)
+
 	// // posts
 	// api.POST("/posts", handlerV1.CreatePost)
 	// api.GET("/posts/:id", handlerV1.GetPost)
@@ -71,6 +148,12 @@ func New(option Option) *gin.Engine {
 	// api.PUT("/posts/:id", handlerV1.UpdatePost)
 	// api.DELETE("/posts/:id", handlerV1.DeletePost)
 	// api.GET("/all/post/data", handlerV1.GetAllPostData)
+	api.GET("/posts/trending", handlerV1.GetTrending)
+
+	// hashtags
+	api.GET("/tags", handlerV1.SearchTags)
+	api.GET("/tags/:name", handlerV1.GetTag)
+	api.GET("/tags/:name/posts", handlerV1.GetPostsByTag)
 
 	// // comments
 	// api.POST("/comments", handlerV1.CreateComment)
@@ -79,7 +162,7 @@ func New(option Option) *gin.Engine {
 	// api.PUT("/comments/:id", handlerV1.UpdateComment)
 	// api.DELETE("/comments/:id", handlerV1.DeleteComment)
 	// api.GET("/all", handlerV1.GetAllData)
-	// api.GET("/post", handlerV1.GetPostById)
+	api.GET("/post", handlerV1.GetPostById)
 	// api.GET("/comment", handlerV1.GetCommentByOwner)
 	// api.GET("/commentpost", handlerV1.GetCommentsByPostId)
 	// api.GET("/user", handlerV1.GetUserById)
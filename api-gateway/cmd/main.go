@@ -1,27 +1,47 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"api-gateway/api"
 	"api-gateway/config"
+	"api-gateway/pkg/blobstore"
+	"api-gateway/pkg/db"
+	"api-gateway/pkg/denylist"
+	"api-gateway/pkg/export"
 	"api-gateway/pkg/logger"
+	"api-gateway/pkg/policy"
 	"api-gateway/services"
 
 	"github.com/casbin/casbin/v2"
 	defaultrolemanager "github.com/casbin/casbin/v2/rbac/default-role-manager"
 	"github.com/casbin/casbin/v2/util"
+	"github.com/redis/go-redis/v9"
 )
 
 func main() {
 	cfg := config.Load()
 	log := logger.New(cfg.LogLevel, "api_gateway")
 
+	if cfg.InternalSecret == "" {
+		log.Fatal("INTERNAL_SECRET is not set, the services reject calls without it")
+	}
 	serviceManager, err := services.NewServiceManager(&cfg)
 	if err != nil {
 		log.Error("gRPC dial error", logger.Error(err))
 	}
 
-	// csv file bn accses berish
-	casbinEnforcer, err := casbin.NewEnforcer(cfg.AuthConfigPath, cfg.CSVFilePath)
+	connDB, err, cleanUp := db.ConnectToDB(cfg)
+	if err != nil {
+		log.Fatal("sqlx connection to postgres error", logger.Error(err))
+		return
+	}
+	defer cleanUp()
+
+	// policies and role groupings are stored in postgres
+	casbinEnforcer, err := casbin.NewSyncedEnforcer(cfg.AuthConfigPath, policy.NewAdapter(connDB))
 	if err != nil {
 		log.Fatal("casbin enforcer error", logger.Error(err))
 		return
@@ -33,14 +53,91 @@ func main() {
 		return
 	}
 
+	// reload policies whenever another instance changes them
+	policyWatcher, err := policy.NewWatcher(connDB, db.DSN(cfg), log)
+	if err != nil {
+		log.Fatal("casbin watcher error", logger.Error(err))
+		return
+	}
+	defer policyWatcher.Close()
+
+	if err := casbinEnforcer.SetWatcher(policyWatcher); err != nil {
+		log.Fatal("casbin set watcher error", logger.Error(err))
+		return
+	}
+	// the default callback reloads without taking the synced enforcer's lock
+	_ = policyWatcher.SetUpdateCallback(func(string) {
+		if err := casbinEnforcer.LoadPolicy(); err != nil {
+			log.Error("casbin error reload policy", logger.Error(err))
+		}
+	})
+
 	casbinEnforcer.GetRoleManager().(*defaultrolemanager.RoleManagerImpl).AddMatchingFunc("keyMatch", util.KeyMatch)
 	casbinEnforcer.GetRoleManager().(*defaultrolemanager.RoleManagerImpl).AddMatchingFunc("keyMatch3", util.KeyMatch3)
 
+	// revoked access tokens
+	tokenDenylist := denylist.NewMemory()
+	if cfg.DenylistBackend == "redis" {
+		tokenDenylist = denylist.NewRedis(redis.NewClient(&redis.Options{
+			Addr:     fmt.Sprintf("%s:%d", cfg.RedisHost, cfg.RedisPort),
+			Password: cfg.RedisPassword,
+		}))
+	}
+
+	// uploaded pictures, and the export archives next to them on the
+	// filesystem where only the pictures are served. On S3 the picture
+	// bucket is public, so the archives get a private bucket of their own
+	blobs := blobstore.NewFS(cfg.BlobDir, cfg.BlobBaseURL)
+	exportBlobs := blobs
+	if cfg.BlobBackend == "s3" {
+		if cfg.S3ExportBucket == cfg.S3Bucket {
+			log.Fatal("exports must not share the picture bucket", logger.String("bucket", cfg.S3Bucket))
+			return
+		}
+		s3Config := blobstore.S3Config{
+			Endpoint:  cfg.S3Endpoint,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			Bucket:    cfg.S3Bucket,
+			Region:    cfg.S3Region,
+			UseSSL:    cfg.S3UseSSL,
+			PublicURL: cfg.S3PublicURL,
+		}
+		blobs, err = blobstore.NewS3(context.Background(), s3Config)
+		if err != nil {
+			log.Fatal("blob store error", logger.Error(err))
+			return
+		}
+		s3Config.Bucket = cfg.S3ExportBucket
+		exportBlobs, err = blobstore.NewS3(context.Background(), s3Config)
+		if err != nil {
+			log.Fatal("export blob store error", logger.Error(err))
+			return
+		}
+	}
+
+	// personal data exports, stale jobs and expired archives are swept
+	// every hour
+	exports := export.NewStore(connDB)
+	go func() {
+		ticker := time.NewTicker(time.Hour)
+		defer ticker.Stop()
+		for ; ; <-ticker.C {
+			if err := export.Sweep(context.Background(), exports, exportBlobs, 2*export.Timeout); err != nil {
+				log.Error("export sweep error", logger.Error(err))
+			}
+		}
+	}()
+
 	server := api.New(api.Option{
 		Conf:           cfg,
 		Logger:         log,
 		ServiceManager: serviceManager,
 		CasbinEnforcer: casbinEnforcer,
+		Denylist:       tokenDenylist,
+		Blobs:          blobs,
+		ExportBlobs:    exportBlobs,
+		Exports:        exports,
 	})
 
 	if err := server.Run(cfg.HTTPPort); err != nil {
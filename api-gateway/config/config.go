@@ -17,17 +17,55 @@ type Config struct {
 	PostServicePort    int
 	CommentServiceHost string
 	CommentServicePort int
+	// sent with every call, the services reject calls without it
+	InternalSecret string
 
 	// context timeout in seconds
 	CtxTimeout int
 
-	// casbin
-	AuthConfigPath string
-	CSVFilePath    string
+	// casbin policies live in postgres
+	AuthConfigPath   string
+	PostgresHost     string
+	PostgresPort     int
+	PostgresDatabase string
+	PostgresUser     string
+	PostgresPassword string
 
 	// JWT
-	SigningKey        string
-	AccessTokenTimout int
+	SigningKey          string
+	AccessTokenTimout   int
+	RefreshTokenTimeout int
+
+	// revoked access tokens: "memory" or "redis"
+	DenylistBackend string
+	RedisHost       string
+	RedisPort       int
+	RedisPassword   string
+
+	// uploaded files: "fs" keeps them in BlobDir and serves them under
+	// /media, "s3" puts them into an S3 compatible bucket. Exports go into
+	// S3ExportBucket, which must not be public
+	BlobBackend     string
+	BlobDir         string
+	BlobBaseURL     string
+	S3Endpoint      string
+	S3AccessKey     string
+	S3SecretKey     string
+	S3Bucket        string
+	S3ExportBucket  string
+	S3Region        string
+	S3UseSSL        bool
+	S3PublicURL     string
+	UploadMaxSizeMB int
+
+	// personal data exports are kept for ExportTTL hours, a download link
+	// stays valid for ExportLinkTTL minutes
+	ExportTTL     int
+	ExportLinkTTL int
+
+	// proxies whose X-Forwarded-For is trusted for the client address,
+	// comma separated, empty trusts none
+	TrustedProxies string
 
 	LogLevel string
 	HTTPPort string
@@ -41,6 +79,7 @@ func Load() Config {
 
 	c.LogLevel = cast.ToString(getOrReturnDefault("LOG_LEVEL", "debug"))
 	c.HTTPPort = cast.ToString(getOrReturnDefault("HTTP_PORT", ":8080"))
+	c.TrustedProxies = cast.ToString(getOrReturnDefault("TRUSTED_PROXIES", ""))
 
 	// user serrvice bn connect
 	c.UserServiceHost = cast.ToString(getOrReturnDefault("USER_SERVICE_HOST", "localhost"))
@@ -53,14 +92,44 @@ func Load() Config {
 	// comment service bn connect
 	c.CommentServiceHost = cast.ToString(getOrReturnDefault("COMMENT_SERVICE_HOST", "localhost"))
 	c.CommentServicePort = cast.ToInt(getOrReturnDefault("COMMENT_SERVICE_PORT", 3333))
+	c.InternalSecret = cast.ToString(getOrReturnDefault("INTERNAL_SECRET", ""))
 
 	// Jwt
 	c.SigningKey = cast.ToString(getOrReturnDefault("SIGNING_KEY", "test-key"))
-	c.AccessTokenTimout = cast.ToInt(getOrReturnDefault("ACCESS_TOKEN_TIMOUT", 555555))
+	c.AccessTokenTimout = cast.ToInt(getOrReturnDefault("ACCESS_TOKEN_TIMOUT", 15))
+	c.RefreshTokenTimeout = cast.ToInt(getOrReturnDefault("REFRESH_TOKEN_TIMEOUT", 43200))
+
+	// token denylist
+	c.DenylistBackend = cast.ToString(getOrReturnDefault("DENYLIST_BACKEND", "memory"))
+	c.RedisHost = cast.ToString(getOrReturnDefault("REDIS_HOST", "localhost"))
+	c.RedisPort = cast.ToInt(getOrReturnDefault("REDIS_PORT", 6379))
+	c.RedisPassword = cast.ToString(getOrReturnDefault("REDIS_PASSWORD", ""))
+
+	// uploads
+	c.BlobBackend = cast.ToString(getOrReturnDefault("BLOB_BACKEND", "fs"))
+	c.BlobDir = cast.ToString(getOrReturnDefault("BLOB_DIR", "./media"))
+	c.BlobBaseURL = cast.ToString(getOrReturnDefault("BLOB_BASE_URL", "http://localhost:8080/media"))
+	c.S3Endpoint = cast.ToString(getOrReturnDefault("S3_ENDPOINT", "localhost:9000"))
+	c.S3AccessKey = cast.ToString(getOrReturnDefault("S3_ACCESS_KEY", "minioadmin"))
+	c.S3SecretKey = cast.ToString(getOrReturnDefault("S3_SECRET_KEY", "minioadmin"))
+	c.S3Bucket = cast.ToString(getOrReturnDefault("S3_BUCKET", "media"))
+	c.S3ExportBucket = cast.ToString(getOrReturnDefault("S3_EXPORT_BUCKET", "exports"))
+	c.S3Region = cast.ToString(getOrReturnDefault("S3_REGION", ""))
+	c.S3UseSSL = cast.ToBool(getOrReturnDefault("S3_USE_SSL", false))
+	c.S3PublicURL = cast.ToString(getOrReturnDefault("S3_PUBLIC_URL", ""))
+	c.UploadMaxSizeMB = cast.ToInt(getOrReturnDefault("UPLOAD_MAX_SIZE_MB", 5))
+
+	// personal data exports
+	c.ExportTTL = cast.ToInt(getOrReturnDefault("EXPORT_TTL", 48))
+	c.ExportLinkTTL = cast.ToInt(getOrReturnDefault("EXPORT_LINK_TTL", 15))
 
 	// casbin
 	c.AuthConfigPath = cast.ToString(getOrReturnDefault("AUTH_CONFIG_PATH", "auth.conf"))
-	c.CSVFilePath = cast.ToString(getOrReturnDefault("CSV_FILE_PATH", "casbin_rules.csv"))
+	c.PostgresHost = cast.ToString(getOrReturnDefault("POSTGRES_HOST", "localhost"))
+	c.PostgresPort = cast.ToInt(getOrReturnDefault("POSTGRES_PORT", 5432))
+	c.PostgresDatabase = cast.ToString(getOrReturnDefault("POSTGRES_DATABASE", "gatewaydb"))
+	c.PostgresUser = cast.ToString(getOrReturnDefault("POSTGRES_USER", "postgres"))
+	c.PostgresPassword = cast.ToString(getOrReturnDefault("POSTGRES_PASSWORD", "member1206"))
 
 	c.CtxTimeout = cast.ToInt(getOrReturnDefault("CTX_TIMEOUT", 7))
 
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS casbin_rules;
\ No newline at end of file
@@ -0,0 +1,31 @@
+CREATE TABLE casbin_rules (
+    id SERIAL PRIMARY KEY,
+    ptype VARCHAR(8) NOT NULL,
+    v0 VARCHAR(256) NOT NULL DEFAULT '',
+    v1 VARCHAR(256) NOT NULL DEFAULT '',
+    v2 VARCHAR(256) NOT NULL DEFAULT '',
+    v3 VARCHAR(256) NOT NULL DEFAULT '',
+    v4 VARCHAR(256) NOT NULL DEFAULT '',
+    v5 VARCHAR(256) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (ptype, v0, v1, v2, v3, v4, v5)
+);
+
+-- the policies that used to ship in casbin_rules.csv
+INSERT INTO casbin_rules (ptype, v0, v1, v2) VALUES
+    ('p', 'unauthorized', '/v1/swagger/*', 'GET'),
+    ('p', 'unauthorized', '/v1/swagger/index.html', 'GET'),
+    ('p', 'unauthorized', '/v1/swagger/index.html', 'POST'),
+    ('p', 'unauthorized', '/v1/login', 'POST'),
+    ('p', 'unauthorized', '/v1/register', 'POST'),
+    ('p', 'unauthorized', '/v1/verification', 'POST'),
+    ('p', 'unauthorized', '/v1/token/refresh', 'POST'),
+    ('p', 'unauthorized', '/v1/password/forgot', 'POST'),
+    ('p', 'unauthorized', '/v1/password/reset', 'POST'),
+    ('p', 'user', '/v1/swagger/*', 'GET'),
+    ('p', 'user', '/v1/swagger/index.html', 'GET'),
+    ('p', 'user', '/v1/swagger/index.html', 'POST'),
+    ('p', 'user', '/v1/*', 'GET'),
+    ('p', 'user', '/v1/*', 'POST'),
+    ('p', 'user', '/v1/*', 'DELETE'),
+    ('p', 'user', '/v1/*', 'PUT');
@@ -0,0 +1 @@
+DELETE FROM casbin_rules WHERE ptype = 'p' AND v0 = 'unauthorized' AND v1 = '/v1/login/2fa';
\ No newline at end of file
@@ -0,0 +1,3 @@
+INSERT INTO casbin_rules (ptype, v0, v1, v2) VALUES
+    ('p', 'unauthorized', '/v1/login/2fa', 'POST')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1 @@
+DELETE FROM casbin_rules WHERE ptype = 'p' AND v0 IN ('unauthorized', 'user') AND v1 = '/media/*';
\ No newline at end of file
@@ -0,0 +1,5 @@
+-- uploads on the local filesystem are public, like any profile picture URL
+INSERT INTO casbin_rules (ptype, v0, v1, v2) VALUES
+    ('p', 'unauthorized', '/media/*', 'GET'),
+    ('p', 'user', '/media/*', 'GET')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,3 @@
+DELETE FROM casbin_rules WHERE ptype = 'p' AND v0 = 'unauthorized' AND v1 = '/v1/exports/{id}/download';
+
+DROP TABLE IF EXISTS exports;
\ No newline at end of file
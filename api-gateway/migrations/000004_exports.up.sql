@@ -0,0 +1,20 @@
+-- personal data exports, the archive itself lives in the blob store
+CREATE TABLE exports (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    blob_key TEXT NOT NULL DEFAULT '',
+    size BIGINT NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at TIMESTAMP NULL,
+    expires_at TIMESTAMP NULL
+);
+
+CREATE INDEX exports_user_idx ON exports (user_id, created_at DESC);
+CREATE INDEX exports_expires_idx ON exports (expires_at) WHERE status = 'done';
+
+-- download links are signed, they work without a token
+INSERT INTO casbin_rules (ptype, v0, v1, v2) VALUES
+    ('p', 'unauthorized', '/v1/exports/{id}/download', 'GET')
+ON CONFLICT DO NOTHING;
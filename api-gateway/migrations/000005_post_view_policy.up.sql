@@ -0,0 +1,4 @@
+-- a post can be read without signing in, its view is counted per client address
+INSERT INTO casbin_rules (ptype, v0, v1, v2) VALUES
+    ('p', 'unauthorized', '/v1/post', 'GET')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,33 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+)
+
+// ErrInvalidKey is returned for keys that are empty, absolute or climb out
+// of the store with ".."
+var ErrInvalidKey = errors.New("invalid blob key")
+
+// ErrNotFound is returned by Get for keys that hold no blob
+var ErrNotFound = errors.New("blob not found")
+
+// BlobStore keeps uploaded files. Keys are slash separated paths such as
+// "avatars/<user id>/<name>.jpg", URL returns where clients can fetch them.
+// Get reads a blob back for the ones only the gateway may hand out.
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
+
+func checkKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || path.Clean(key) != key || strings.HasPrefix(key, "..") {
+		return ErrInvalidKey
+	}
+	return nil
+}
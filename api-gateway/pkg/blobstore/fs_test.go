@@ -0,0 +1,74 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFSStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s := NewFS(dir, "http://localhost:8080/media/")
+
+	if err := s.Put(ctx, "avatars/user-1/a.jpg", strings.NewReader("first"), 5, "image/jpeg"); err != nil {
+		t.Fatal(err)
+	}
+	// a second put replaces the blob
+	if err := s.Put(ctx, "avatars/user-1/a.jpg", strings.NewReader("second"), 6, "image/jpeg"); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := s.Get(ctx, "avatars/user-1/a.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(r)
+	r.Close()
+	if err != nil || string(data) != "second" {
+		t.Fatalf("Get = %q, %v, want second", data, err)
+	}
+	// no temporary files are left next to the blob
+	entries, err := os.ReadDir(filepath.Join(dir, "avatars", "user-1"))
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("files in the directory: %v, %v", entries, err)
+	}
+
+	if got, want := s.URL("avatars/user-1/a.jpg"), "http://localhost:8080/media/avatars/user-1/a.jpg"; got != want {
+		t.Errorf("URL = %q, want %q", got, want)
+	}
+
+	if err := s.Delete(ctx, "avatars/user-1/a.jpg"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Get(ctx, "avatars/user-1/a.jpg"); err != ErrNotFound {
+		t.Errorf("Get after Delete error = %v, want ErrNotFound", err)
+	}
+	if err := s.Delete(ctx, "avatars/user-1/a.jpg"); err != nil {
+		t.Errorf("deleting a missing blob = %v, want nil", err)
+	}
+}
+
+func TestFSStoreRejectsKeys(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	s := NewFS(filepath.Join(dir, "store"), "http://localhost/media")
+
+	for _, key := range []string{"", "/etc/passwd", "../outside", "a/../../outside", "a//b", "a/./b", "a/"} {
+		if err := s.Put(ctx, key, strings.NewReader("x"), 1, "text/plain"); err != ErrInvalidKey {
+			t.Errorf("Put(%q) error = %v, want ErrInvalidKey", key, err)
+		}
+		if _, err := s.Get(ctx, key); err != ErrInvalidKey {
+			t.Errorf("Get(%q) error = %v, want ErrInvalidKey", key, err)
+		}
+		if err := s.Delete(ctx, key); err != ErrInvalidKey {
+			t.Errorf("Delete(%q) error = %v, want ErrInvalidKey", key, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "outside")); !os.IsNotExist(err) {
+		t.Errorf("a blob was written outside the store: %v", err)
+	}
+}
@@ -0,0 +1,34 @@
+package db
+
+import (
+	"fmt"
+
+	"api-gateway/config"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq" //postgres drivers
+)
+
+// DSN builds the postgres connection string, pq.Listener needs it as well
+func DSN(cfg config.Config) string {
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
+		cfg.PostgresHost,
+		cfg.PostgresPort,
+		cfg.PostgresUser,
+		cfg.PostgresPassword,
+		cfg.PostgresDatabase,
+	)
+}
+
+func ConnectToDB(cfg config.Config) (*sqlx.DB, error, func()) {
+	connDb, err := sqlx.Connect("postgres", DSN(cfg))
+	if err != nil {
+		return nil, err, nil
+	}
+
+	cleanUpFunc := func() {
+		connDb.Close()
+	}
+
+	return connDb, nil, cleanUpFunc
+}
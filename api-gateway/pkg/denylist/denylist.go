@@ -0,0 +1,13 @@
+package denylist
+
+import (
+	"context"
+	"time"
+)
+
+// Denylist keeps the ids (jti) of access tokens revoked before they expired.
+// Entries only need to live until the token itself would have expired.
+type Denylist interface {
+	Add(ctx context.Context, jti string, ttl time.Duration) error
+	Contains(ctx context.Context, jti string) (bool, error)
+}
@@ -0,0 +1,41 @@
+package denylist
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryDenylist is a process local denylist, it is not shared between
+// gateway instances so use it for development only
+type memoryDenylist struct {
+	mu      sync.Mutex
+	entries map[string]time.Time
+}
+
+// NewMemory ...
+func NewMemory() Denylist {
+	return &memoryDenylist{entries: make(map[string]time.Time)}
+}
+
+func (m *memoryDenylist) Add(ctx context.Context, jti string, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	for id, expiresAt := range m.entries {
+		if now.After(expiresAt) {
+			delete(m.entries, id)
+		}
+	}
+	m.entries[jti] = now.Add(ttl)
+	return nil
+}
+
+func (m *memoryDenylist) Contains(ctx context.Context, jti string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	expiresAt, ok := m.entries[jti]
+	return ok && time.Now().Before(expiresAt), nil
+}
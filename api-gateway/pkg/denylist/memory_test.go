@@ -0,0 +1,49 @@
+package denylist
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryDenylist(t *testing.T) {
+	ctx := context.Background()
+	d := NewMemory()
+
+	if ok, _ := d.Contains(ctx, "jti-1"); ok {
+		t.Fatal("an empty denylist contains jti-1")
+	}
+	if err := d.Add(ctx, "jti-1", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := d.Contains(ctx, "jti-1"); !ok {
+		t.Fatal("an added token is not denied")
+	}
+	if ok, _ := d.Contains(ctx, "jti-2"); ok {
+		t.Fatal("a token that was not added is denied")
+	}
+}
+
+func TestMemoryDenylistExpiry(t *testing.T) {
+	ctx := context.Background()
+	d := NewMemory().(*memoryDenylist)
+
+	// a token that already expired needs no entry
+	if err := d.Add(ctx, "expired", -time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := d.Contains(ctx, "expired"); ok {
+		t.Fatal("an entry past its ttl is still denied")
+	}
+
+	// entries past their ttl are dropped on the next Add
+	if err := d.Add(ctx, "jti-1", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := d.entries["expired"]; ok {
+		t.Fatal("an entry past its ttl was kept")
+	}
+	if _, ok := d.entries["jti-1"]; !ok {
+		t.Fatal("a live entry was dropped")
+	}
+}
@@ -0,0 +1,33 @@
+package denylist
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const redisKeyPrefix = "denylist:"
+
+// redisDenylist shares revoked token ids between all gateway instances,
+// keys expire together with the tokens they block
+type redisDenylist struct {
+	client *redis.Client
+}
+
+// NewRedis ...
+func NewRedis(client *redis.Client) Denylist {
+	return &redisDenylist{client: client}
+}
+
+func (r *redisDenylist) Add(ctx context.Context, jti string, ttl time.Duration) error {
+	return r.client.Set(ctx, redisKeyPrefix+jti, 1, ttl).Err()
+}
+
+func (r *redisDenylist) Contains(ctx context.Context, jti string) (bool, error) {
+	n, err := r.client.Exists(ctx, redisKeyPrefix+jti).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
@@ -1,17 +0,0 @@
-package etc
-
-import (
-	"golang.org/x/crypto/bcrypt"
-)
-
-// This function hash password
-func HashPassword(password string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), 14)
-	return string(bytes), err
-}
-
-// This function chech hash password, pasword - user password, hash - database hash password
-func CheckPasswordHash(password, hash string) bool {
-	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
-	return err == nil
-}
@@ -0,0 +1,87 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// IndexName is the file in every archive that lists the others
+const IndexName = "index.json"
+
+// File is one document of an export
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// AddChunk adds a chunk of a streamed export to files, it continues the last
+// file when the name is the same and begins a new one otherwise
+func AddChunk(files []File, name string, content []byte) []File {
+	if n := len(files); n > 0 && files[n-1].Name == name {
+		files[n-1].Content = append(files[n-1].Content, content...)
+		return files
+	}
+	return append(files, File{Name: name, Content: append([]byte(nil), content...)})
+}
+
+type index struct {
+	UserId      string      `json:"user_id"`
+	GeneratedAt time.Time   `json:"generated_at"`
+	Files       []indexFile `json:"files"`
+}
+
+type indexFile struct {
+	Name   string `json:"name"`
+	Size   int    `json:"size"`
+	Sha256 string `json:"sha256"`
+}
+
+// Archive zips the files together with an index of their names, sizes and
+// checksums
+func Archive(userId string, files []File) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	now := time.Now().UTC()
+
+	idx := index{UserId: userId, GeneratedAt: now, Files: []indexFile{}}
+	for _, f := range files {
+		if err := writeFile(zw, f.Name, f.Content, now); err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(f.Content)
+		idx.Files = append(idx.Files, indexFile{
+			Name:   f.Name,
+			Size:   len(f.Content),
+			Sha256: hex.EncodeToString(sum[:]),
+		})
+	}
+
+	data, err := json.MarshalIndent(idx, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFile(zw, IndexName, data, now); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeFile(zw *zip.Writer, name string, content []byte, modified time.Time) error {
+	w, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(content)
+	return err
+}
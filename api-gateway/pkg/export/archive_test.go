@@ -0,0 +1,127 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+)
+
+func readZip(t *testing.T, data []byte) ([]string, map[string][]byte) {
+	t.Helper()
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	contents := map[string][]byte{}
+	for _, f := range zr.File {
+		if f.Method != zip.Deflate {
+			t.Errorf("%s is not compressed", f.Name)
+		}
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, f.Name)
+		contents[f.Name] = content
+	}
+	return names, contents
+}
+
+func TestArchiveLayout(t *testing.T) {
+	files := []File{
+		{Name: "profile.json", Content: []byte(`{"id": "user-1"}`)},
+		{Name: "posts.json", Content: []byte("[]\n")},
+		{Name: "empty.json", Content: nil},
+	}
+	before := time.Now().UTC().Add(-time.Second)
+	data, err := Archive("user-1", files)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names, contents := readZip(t, data)
+	wantNames := []string{"profile.json", "posts.json", "empty.json", IndexName}
+	if len(names) != len(wantNames) {
+		t.Fatalf("entries = %v, want %v", names, wantNames)
+	}
+	for i := range wantNames {
+		if names[i] != wantNames[i] {
+			t.Fatalf("entries = %v, want %v", names, wantNames)
+		}
+	}
+	for _, f := range files {
+		if !bytes.Equal(contents[f.Name], f.Content) {
+			t.Errorf("%s = %q, want %q", f.Name, contents[f.Name], f.Content)
+		}
+	}
+
+	var idx index
+	if err := json.Unmarshal(contents[IndexName], &idx); err != nil {
+		t.Fatal(err)
+	}
+	if idx.UserId != "user-1" {
+		t.Errorf("index user_id = %q", idx.UserId)
+	}
+	if idx.GeneratedAt.Before(before) || idx.GeneratedAt.After(time.Now().UTC()) {
+		t.Errorf("index generated_at = %v", idx.GeneratedAt)
+	}
+	if len(idx.Files) != len(files) {
+		t.Fatalf("index lists %d files, want %d", len(idx.Files), len(files))
+	}
+	for i, f := range files {
+		sum := sha256.Sum256(f.Content)
+		want := indexFile{Name: f.Name, Size: len(f.Content), Sha256: hex.EncodeToString(sum[:])}
+		if idx.Files[i] != want {
+			t.Errorf("index entry %d = %+v, want %+v", i, idx.Files[i], want)
+		}
+	}
+}
+
+func TestArchiveWithoutFiles(t *testing.T) {
+	data, err := Archive("user-1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, contents := readZip(t, data)
+	if len(names) != 1 || names[0] != IndexName {
+		t.Fatalf("entries = %v, want only the index", names)
+	}
+	// an empty list, not null
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(contents[IndexName], &raw); err != nil {
+		t.Fatal(err)
+	}
+	if string(raw["files"]) != "[]" {
+		t.Fatalf("files = %s, want []", raw["files"])
+	}
+}
+
+func TestAddChunk(t *testing.T) {
+	var files []File
+	chunk := []byte("ab")
+	files = AddChunk(files, "a.json", chunk)
+	files = AddChunk(files, "a.json", []byte("cd"))
+	files = AddChunk(files, "b.json", []byte("ef"))
+	chunk[0] = 'x'
+
+	if len(files) != 2 {
+		t.Fatalf("files = %v, want 2", files)
+	}
+	if files[0].Name != "a.json" || string(files[0].Content) != "abcd" {
+		t.Errorf("first file = %s %q", files[0].Name, files[0].Content)
+	}
+	if files[1].Name != "b.json" || string(files[1].Content) != "ef" {
+		t.Errorf("second file = %s %q", files[1].Name, files[1].Content)
+	}
+}
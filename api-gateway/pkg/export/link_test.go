@@ -0,0 +1,50 @@
+package export
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestVerify(t *testing.T) {
+	const key, id = "signing-key", "4f9c2f9e-2a55-4b43-9b0e-7d3c1c0e6a11"
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Second)
+	unix := func(at time.Time) string { return strconv.FormatInt(at.Unix(), 10) }
+
+	tests := []struct {
+		name      string
+		id        string
+		expires   string
+		signature string
+		want      error
+	}{
+		{"valid", id, unix(future), Sign(key, id, future), nil},
+		{"expired", id, unix(past), Sign(key, id, past), ErrLinkExpired},
+		{"other export", "another-id", unix(future), Sign(key, id, future), ErrBadSignature},
+		{"expiry moved", id, unix(future.Add(time.Hour)), Sign(key, id, future), ErrBadSignature},
+		{"expired link moved to the future", id, unix(future), Sign(key, id, past), ErrBadSignature},
+		{"other key", id, unix(future), Sign("other-key", id, future), ErrBadSignature},
+		{"no signature", id, unix(future), "", ErrBadSignature},
+		{"expiry not a number", id, "tomorrow", Sign(key, id, future), ErrBadSignature},
+		{"no expiry", id, "", Sign(key, id, future), ErrBadSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Verify(key, tt.id, tt.expires, tt.signature); !errors.Is(err, tt.want) {
+				t.Fatalf("Verify() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignIgnoresSubsecond(t *testing.T) {
+	at := time.Unix(1700000000, 0)
+	if Sign("k", "id", at) != Sign("k", "id", at.Add(500*time.Millisecond)) {
+		t.Fatal("the signature depends on more than the unix seconds in the link")
+	}
+	if Sign("k", "id", at) == Sign("k", "id", at.Add(time.Second)) {
+		t.Fatal("the signature does not depend on the expiry")
+	}
+}
@@ -0,0 +1,240 @@
+package export
+
+import (
+	"context"
+	"time"
+
+	"api-gateway/pkg/blobstore"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// Timeout bounds how long building one export may take
+const Timeout = 10 * time.Minute
+
+// states of an export job
+const (
+	StatusRunning = "running"
+	StatusDone    = "done"
+	StatusFailed  = "failed"
+)
+
+// Job is one personal data export, BlobKey and Size are set once it is done
+type Job struct {
+	Id         string     `db:"id"`
+	UserId     string     `db:"user_id"`
+	Status     string     `db:"status"`
+	BlobKey    string     `db:"blob_key"`
+	Size       int64      `db:"size"`
+	Error      string     `db:"error"`
+	CreatedAt  time.Time  `db:"created_at"`
+	FinishedAt *time.Time `db:"finished_at"`
+	ExpiresAt  *time.Time `db:"expires_at"`
+}
+
+// Store keeps the export jobs, lookups return sql.ErrNoRows when nothing matches
+type Store interface {
+	Create(userId string) (*Job, error)
+	Get(id string) (*Job, error)
+	// Running returns the export of the user that is still being built
+	Running(userId string) (*Job, error)
+	// Downloadable returns the job when it is done and not expired yet
+	Downloadable(id string) (*Job, error)
+	Finish(id, blobKey string, size int64, ttl time.Duration) error
+	Fail(id, reason string) error
+	// FailStale gives up on jobs running for longer than olderThan, their
+	// gateway most likely stopped while building them
+	FailStale(olderThan time.Duration) (int64, error)
+	Expired(limit int) ([]*Job, error)
+	Delete(id string) error
+}
+
+type pgStore struct {
+	db *sqlx.DB
+}
+
+// NewStore keeps the jobs in the exports table
+func NewStore(db *sqlx.DB) Store {
+	return &pgStore{db: db}
+}
+
+const jobColumns = `
+	id,
+	user_id,
+	status,
+	blob_key,
+	size,
+	error,
+	created_at,
+	finished_at,
+	expires_at
+`
+
+func (s *pgStore) Create(userId string) (*Job, error) {
+	query := `
+	INSERT INTO exports (
+		id,
+		user_id,
+		status
+	)
+	VALUES ($1, $2, $3)
+	RETURNING` + jobColumns
+
+	var job Job
+	if err := s.db.Get(&job, query, uuid.NewString(), userId, StatusRunning); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (s *pgStore) Get(id string) (*Job, error) {
+	query := `
+	SELECT` + jobColumns + `
+	FROM
+		exports
+	WHERE
+		id = $1
+	`
+	var job Job
+	if err := s.db.Get(&job, query, id); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (s *pgStore) Running(userId string) (*Job, error) {
+	query := `
+	SELECT` + jobColumns + `
+	FROM
+		exports
+	WHERE
+		user_id = $1
+	AND
+		status = $2
+	ORDER BY
+		created_at DESC
+	LIMIT 1
+	`
+	var job Job
+	if err := s.db.Get(&job, query, userId, StatusRunning); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (s *pgStore) Downloadable(id string) (*Job, error) {
+	query := `
+	SELECT` + jobColumns + `
+	FROM
+		exports
+	WHERE
+		id = $1
+	AND
+		status = $2
+	AND
+		expires_at > CURRENT_TIMESTAMP
+	`
+	var job Job
+	if err := s.db.Get(&job, query, id, StatusDone); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func (s *pgStore) Finish(id, blobKey string, size int64, ttl time.Duration) error {
+	query := `
+	UPDATE
+		exports
+	SET
+		status = $2,
+		blob_key = $3,
+		size = $4,
+		finished_at = CURRENT_TIMESTAMP,
+		expires_at = CURRENT_TIMESTAMP + $5 * INTERVAL '1 second'
+	WHERE
+		id = $1
+	`
+	_, err := s.db.Exec(query, id, StatusDone, blobKey, size, int64(ttl.Seconds()))
+	return err
+}
+
+func (s *pgStore) Fail(id, reason string) error {
+	query := `
+	UPDATE
+		exports
+	SET
+		status = $2,
+		error = $3,
+		finished_at = CURRENT_TIMESTAMP
+	WHERE
+		id = $1
+	`
+	_, err := s.db.Exec(query, id, StatusFailed, reason)
+	return err
+}
+
+func (s *pgStore) FailStale(olderThan time.Duration) (int64, error) {
+	query := `
+	UPDATE
+		exports
+	SET
+		status = $1,
+		error = 'interrupted',
+		finished_at = CURRENT_TIMESTAMP
+	WHERE
+		status = $2
+	AND
+		created_at < CURRENT_TIMESTAMP - $3 * INTERVAL '1 second'
+	`
+	res, err := s.db.Exec(query, StatusFailed, StatusRunning, int64(olderThan.Seconds()))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func (s *pgStore) Expired(limit int) ([]*Job, error) {
+	query := `
+	SELECT` + jobColumns + `
+	FROM
+		exports
+	WHERE
+		status = $1
+	AND
+		expires_at <= CURRENT_TIMESTAMP
+	ORDER BY
+		expires_at
+	LIMIT $2
+	`
+	var jobs []*Job
+	if err := s.db.Select(&jobs, query, StatusDone, limit); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (s *pgStore) Delete(id string) error {
+	_, err := s.db.Exec(`DELETE FROM exports WHERE id = $1`, id)
+	return err
+}
+
+// Sweep fails the stale jobs and removes the expired archives
+func Sweep(ctx context.Context, store Store, blobs blobstore.BlobStore, staleAfter time.Duration) error {
+	if _, err := store.FailStale(staleAfter); err != nil {
+		return err
+	}
+	jobs, err := store.Expired(100)
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		if err := blobs.Delete(ctx, job.BlobKey); err != nil {
+			return err
+		}
+		if err := store.Delete(job.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package picture
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"golang.org/x/image/draw"
+)
+
+var (
+	// ErrUnsupported is returned for uploads that do not sniff as JPEG or PNG
+	ErrUnsupported = errors.New("only JPEG and PNG images are accepted")
+	// ErrTooLarge is returned before decoding images with too many pixels
+	ErrTooLarge = errors.New("image dimensions are too large")
+)
+
+// maxPixels bounds the memory a single decode may take
+const maxPixels = 40_000_000
+
+// Image is an encoded picture ready to be stored
+type Image struct {
+	Data        []byte
+	ContentType string
+	Ext         string
+}
+
+// Processed is an upload decoded and encoded again. Encoding from pixels
+// drops EXIF and any other metadata, the orientation stored in EXIF is
+// applied first so the picture does not end up sideways.
+type Processed struct {
+	Main Image
+	// Thumbnails are square center crops keyed by their side in pixels
+	Thumbnails map[int]Image
+}
+
+// Process validates an upload by its content, scales it down so neither
+// side exceeds maxSide and cuts square thumbnails of the given sizes
+func Process(data []byte, maxSide int, thumbSizes []int) (*Processed, error) {
+	contentType := http.DetectContentType(data)
+	if contentType != "image/jpeg" && contentType != "image/png" {
+		return nil, ErrUnsupported
+	}
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || "image/"+format != contentType {
+		return nil, ErrUnsupported
+	}
+	if cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrTooLarge
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupported
+	}
+
+	// orienting is done pixel by pixel, so scale down first
+	img = fit(img, maxSide)
+	if contentType == "image/jpeg" {
+		img = orient(img, exifOrientation(data))
+	}
+
+	main, err := encode(img, contentType)
+	if err != nil {
+		return nil, err
+	}
+	res := &Processed{Main: main, Thumbnails: make(map[int]Image, len(thumbSizes))}
+	for _, size := range thumbSizes {
+		thumb, err := encode(thumbnail(img, size), contentType)
+		if err != nil {
+			return nil, err
+		}
+		res.Thumbnails[size] = thumb
+	}
+	return res, nil
+}
+
+// fit scales img down so that neither side exceeds maxSide
+func fit(img image.Image, maxSide int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= maxSide && h <= maxSide {
+		return img
+	}
+	if w >= h {
+		w, h = maxSide, h*maxSide/w
+	} else {
+		w, h = w*maxSide/h, maxSide
+	}
+	return scale(img, b, max(w, 1), max(h, 1))
+}
+
+// thumbnail crops the largest centered square and scales it to size
+func thumbnail(img image.Image, size int) image.Image {
+	b := img.Bounds()
+	side := min(b.Dx(), b.Dy())
+	x0 := b.Min.X + (b.Dx()-side)/2
+	y0 := b.Min.Y + (b.Dy()-side)/2
+	return scale(img, image.Rect(x0, y0, x0+side, y0+side), size, size)
+}
+
+func scale(img image.Image, src image.Rectangle, w, h int) image.Image {
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, src, draw.Src, nil)
+	return dst
+}
+
+func encode(img image.Image, contentType string) (Image, error) {
+	var buf bytes.Buffer
+	if contentType == "image/png" {
+		if err := png.Encode(&buf, img); err != nil {
+			return Image{}, err
+		}
+		return Image{Data: buf.Bytes(), ContentType: contentType, Ext: ".png"}, nil
+	}
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85}); err != nil {
+		return Image{}, err
+	}
+	return Image{Data: buf.Bytes(), ContentType: contentType, Ext: ".jpg"}, nil
+}
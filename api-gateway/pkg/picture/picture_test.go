@@ -0,0 +1,203 @@
+package picture
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func testImage(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// withOrientation inserts an EXIF segment holding only the orientation tag
+// right after the start of image marker
+func withOrientation(jpg []byte, orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, 0x0112)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3)
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+	payload := append([]byte("Exif\x00\x00"), tiff...)
+
+	segment := []byte{0xFF, 0xE1}
+	segment = binary.BigEndian.AppendUint16(segment, uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	res := append([]byte{}, jpg[:2]...)
+	res = append(res, segment...)
+	return append(res, jpg[2:]...)
+}
+
+func size(t *testing.T, img Image) image.Point {
+	t.Helper()
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(img.Data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return image.Pt(cfg.Width, cfg.Height)
+}
+
+func TestProcess(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		wantType string
+		wantExt  string
+		wantMain image.Point
+	}{
+		{"wide png", encodePNG(t, testImage(300, 150)), "image/png", ".png", image.Pt(100, 50)},
+		{"tall jpeg", encodeJPEG(t, testImage(100, 300)), "image/jpeg", ".jpg", image.Pt(33, 100)},
+		{"small png kept", encodePNG(t, testImage(40, 20)), "image/png", ".png", image.Pt(40, 20)},
+		{"sideways jpeg turned upright", withOrientation(encodeJPEG(t, testImage(80, 40)), 6), "image/jpeg", ".jpg", image.Pt(40, 80)},
+		{"mirrored jpeg keeps its size", withOrientation(encodeJPEG(t, testImage(80, 40)), 2), "image/jpeg", ".jpg", image.Pt(80, 40)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := Process(tt.data, 100, []int{64, 16})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Main.ContentType != tt.wantType || res.Main.Ext != tt.wantExt {
+				t.Errorf("main is %s %s, want %s %s", res.Main.ContentType, res.Main.Ext, tt.wantType, tt.wantExt)
+			}
+			if got := size(t, res.Main); got != tt.wantMain {
+				t.Errorf("main is %v, want %v", got, tt.wantMain)
+			}
+			if bytes.Contains(res.Main.Data, []byte("Exif")) {
+				t.Error("EXIF kept in the stored picture")
+			}
+			if len(res.Thumbnails) != 2 {
+				t.Fatalf("%d thumbnails, want 2", len(res.Thumbnails))
+			}
+			for _, side := range []int{64, 16} {
+				thumb := res.Thumbnails[side]
+				if got := size(t, thumb); got != image.Pt(side, side) || thumb.ContentType != tt.wantType {
+					t.Errorf("thumbnail %d is %v %s", side, got, thumb.ContentType)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessRejects(t *testing.T) {
+	var gifData bytes.Buffer
+	if err := gif.Encode(&gifData, testImage(4, 4), nil); err != nil {
+		t.Fatal(err)
+	}
+	pngData := encodePNG(t, testImage(4, 4))
+
+	// a PNG header claiming 10000x10000 pixels, with the checksum fixed up
+	huge := append([]byte{}, pngData...)
+	binary.BigEndian.PutUint32(huge[16:], 10000)
+	binary.BigEndian.PutUint32(huge[20:], 10000)
+	binary.BigEndian.PutUint32(huge[29:], crc32.ChecksumIEEE(huge[12:29]))
+
+	tests := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"text", []byte("hello, this is not a picture"), ErrUnsupported},
+		{"gif", gifData.Bytes(), ErrUnsupported},
+		{"truncated png", pngData[:40], ErrUnsupported},
+		{"too many pixels", huge, ErrTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Process(tt.data, 100, nil); err != tt.want {
+				t.Errorf("Process error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestExifOrientation(t *testing.T) {
+	jpg := encodeJPEG(t, testImage(4, 4))
+	tests := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{"no exif", jpg, 1},
+		{"rotated", withOrientation(jpg, 6), 6},
+		{"out of range", withOrientation(jpg, 9), 1},
+		{"png", encodePNG(t, testImage(4, 4)), 1},
+		{"cut short", withOrientation(jpg, 6)[:20], 1},
+	}
+	for _, tt := range tests {
+		if got := exifOrientation(tt.data); got != tt.want {
+			t.Errorf("%s: exifOrientation = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestOrient(t *testing.T) {
+	// a 3x2 picture whose pixels are numbered by their red value
+	//   1 2 3
+	//   4 5 6
+	src := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	for i := 0; i < 6; i++ {
+		src.Set(i%3, i/3, color.RGBA{R: uint8(i + 1), A: 255})
+	}
+	tests := []struct {
+		orientation int
+		want        [][]uint8
+	}{
+		{1, [][]uint8{{1, 2, 3}, {4, 5, 6}}},
+		{2, [][]uint8{{3, 2, 1}, {6, 5, 4}}},
+		{3, [][]uint8{{6, 5, 4}, {3, 2, 1}}},
+		{4, [][]uint8{{4, 5, 6}, {1, 2, 3}}},
+		{5, [][]uint8{{1, 4}, {2, 5}, {3, 6}}},
+		{6, [][]uint8{{4, 1}, {5, 2}, {6, 3}}},
+		{7, [][]uint8{{6, 3}, {5, 2}, {4, 1}}},
+		{8, [][]uint8{{3, 6}, {2, 5}, {1, 4}}},
+	}
+	for _, tt := range tests {
+		img := orient(src, tt.orientation)
+		b := img.Bounds()
+		if b.Dx() != len(tt.want[0]) || b.Dy() != len(tt.want) {
+			t.Errorf("orientation %d: size %v", tt.orientation, b.Size())
+			continue
+		}
+		for y, row := range tt.want {
+			for x, want := range row {
+				if r, _, _, _ := img.At(b.Min.X+x, b.Min.Y+y).RGBA(); uint8(r>>8) != want {
+					t.Errorf("orientation %d: pixel (%d, %d) = %d, want %d", tt.orientation, x, y, r>>8, want)
+				}
+			}
+		}
+	}
+}
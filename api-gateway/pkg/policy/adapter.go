@@ -0,0 +1,165 @@
+package policy
+
+import (
+	"strconv"
+
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/jmoiron/sqlx"
+)
+
+// fields is how many values a rule may have, one per v0..v5 column
+const fields = 6
+
+// rule is one row of casbin_rules
+type rule struct {
+	PType string `db:"ptype"`
+	V0    string `db:"v0"`
+	V1    string `db:"v1"`
+	V2    string `db:"v2"`
+	V3    string `db:"v3"`
+	V4    string `db:"v4"`
+	V5    string `db:"v5"`
+}
+
+func newRule(ptype string, values []string) rule {
+	var v [fields]string
+	copy(v[:], values)
+	return rule{PType: ptype, V0: v[0], V1: v[1], V2: v[2], V3: v[3], V4: v[4], V5: v[5]}
+}
+
+// values returns the rule without its trailing empty fields
+func (r rule) values() []string {
+	v := []string{r.V0, r.V1, r.V2, r.V3, r.V4, r.V5}
+	for len(v) > 0 && v[len(v)-1] == "" {
+		v = v[:len(v)-1]
+	}
+	return v
+}
+
+type adapter struct {
+	db *sqlx.DB
+}
+
+// NewAdapter stores casbin policies ("p") and role groupings ("g") in the
+// casbin_rules table
+func NewAdapter(db *sqlx.DB) persist.Adapter {
+	return &adapter{db: db}
+}
+
+func (a *adapter) LoadPolicy(m model.Model) error {
+	query := `
+	SELECT
+		ptype,
+		v0,
+		v1,
+		v2,
+		v3,
+		v4,
+		v5
+	FROM
+		casbin_rules
+	ORDER BY
+		id
+	`
+	var rules []rule
+	if err := a.db.Select(&rules, query); err != nil {
+		return err
+	}
+
+	for _, r := range rules {
+		if err := persist.LoadPolicyArray(append([]string{r.PType}, r.values()...), m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SavePolicy replaces every stored rule with the ones in the model
+func (a *adapter) SavePolicy(m model.Model) error {
+	tx, err := a.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM casbin_rules`); err != nil {
+		return err
+	}
+	for _, sec := range []string{"p", "g"} {
+		for ptype, ast := range m[sec] {
+			for _, values := range ast.Policy {
+				if err := insertRule(tx, newRule(ptype, values)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (a *adapter) AddPolicy(sec string, ptype string, values []string) error {
+	return insertRule(a.db, newRule(ptype, values))
+}
+
+func (a *adapter) RemovePolicy(sec string, ptype string, values []string) error {
+	r := newRule(ptype, values)
+	query := `
+	DELETE FROM
+		casbin_rules
+	WHERE
+		ptype = $1
+	AND
+		v0 = $2
+	AND
+		v1 = $3
+	AND
+		v2 = $4
+	AND
+		v3 = $5
+	AND
+		v4 = $6
+	AND
+		v5 = $7
+	`
+	_, err := a.db.Exec(query, r.PType, r.V0, r.V1, r.V2, r.V3, r.V4, r.V5)
+	return err
+}
+
+// RemoveFilteredPolicy removes the rules whose fields, starting at fieldIndex,
+// equal fieldValues, an empty value matches anything
+func (a *adapter) RemoveFilteredPolicy(sec string, ptype string, fieldIndex int, fieldValues ...string) error {
+	columns := []string{"v0", "v1", "v2", "v3", "v4", "v5"}
+
+	query := `DELETE FROM casbin_rules WHERE ptype = $1`
+	args := []interface{}{ptype}
+	for i, value := range fieldValues {
+		if value == "" || fieldIndex+i >= fields {
+			continue
+		}
+		args = append(args, value)
+		query += " AND " + columns[fieldIndex+i] + " = $" + strconv.Itoa(len(args))
+	}
+
+	_, err := a.db.Exec(query, args...)
+	return err
+}
+
+func insertRule(db sqlx.Execer, r rule) error {
+	query := `
+	INSERT INTO casbin_rules (
+		ptype,
+		v0,
+		v1,
+		v2,
+		v3,
+		v4,
+		v5
+	)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	ON CONFLICT DO NOTHING
+	`
+	_, err := db.Exec(query, r.PType, r.V0, r.V1, r.V2, r.V3, r.V4, r.V5)
+	return err
+}
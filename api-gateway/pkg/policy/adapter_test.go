@@ -0,0 +1,125 @@
+package policy
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"strings"
+	"testing"
+
+	"shared/fakedb"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/jmoiron/sqlx"
+)
+
+// newFakeDB returns a sqlx.DB whose statements get results in order
+func newFakeDB(t *testing.T, results ...fakedb.Result) (*sqlx.DB, *fakedb.DB) {
+	db, fake := fakedb.Open(t, results...)
+	return sqlx.NewDb(db, "postgres"), fake
+}
+
+func TestRuleValues(t *testing.T) {
+	tests := []struct {
+		values []string
+		want   []string
+	}{
+		{[]string{"user", "/v1/*", "GET"}, []string{"user", "/v1/*", "GET"}},
+		{[]string{"user-1", "admin"}, []string{"user-1", "admin"}},
+		{[]string{"a", "", "c"}, []string{"a", "", "c"}},
+		{nil, []string{}},
+	}
+	for _, tt := range tests {
+		r := newRule("p", tt.values)
+		if got := r.values(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("newRule(%q).values() = %q, want %q", tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestLoadPolicy(t *testing.T) {
+	columns := []string{"ptype", "v0", "v1", "v2", "v3", "v4", "v5"}
+	db, _ := newFakeDB(t, fakedb.Result{
+		Columns: columns,
+		Rows: [][]driver.Value{
+			{"p", "user", "/v1/posts", "GET", "", "", ""},
+			{"p", "editor", "/v1/posts/*", "(PUT)|(DELETE)", "", "", ""},
+			{"g", "user-2", "editor", "", "", "", ""},
+		},
+	})
+
+	m, err := model.NewModelFromFile("../../auth.conf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := casbin.NewEnforcer(m, NewAdapter(db))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks := []struct {
+		sub, obj, act string
+		want          bool
+	}{
+		{"user", "/v1/posts", "GET", true},
+		{"user", "/v1/posts", "POST", false},
+		{"user-2", "/v1/posts/post-1", "DELETE", true},
+		{"user-3", "/v1/posts/post-1", "DELETE", false},
+	}
+	for _, c := range checks {
+		if ok, err := e.Enforce(c.sub, c.obj, c.act); err != nil || ok != c.want {
+			t.Errorf("Enforce(%s, %s, %s) = %v, %v, want %v", c.sub, c.obj, c.act, ok, err, c.want)
+		}
+	}
+}
+
+func TestAddAndRemovePolicy(t *testing.T) {
+	db, fake := newFakeDB(t, fakedb.Result{}, fakedb.Result{})
+	a := NewAdapter(db)
+
+	if err := a.AddPolicy("p", "p", []string{"user", "/v1/posts", "GET"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.RemovePolicy("g", "g", []string{"user-1", "admin"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// unused fields are stored empty, so a rule is matched on all of them
+	wantAdd := []driver.Value{"p", "user", "/v1/posts", "GET", "", "", ""}
+	if !reflect.DeepEqual(fake.Args[0], wantAdd) {
+		t.Errorf("insert args = %q, want %q", fake.Args[0], wantAdd)
+	}
+	wantRemove := []driver.Value{"g", "user-1", "admin", "", "", "", ""}
+	if !reflect.DeepEqual(fake.Args[1], wantRemove) {
+		t.Errorf("delete args = %q, want %q", fake.Args[1], wantRemove)
+	}
+}
+
+func TestRemoveFilteredPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		fieldIndex  int
+		fieldValues []string
+		wantWhere   string
+		wantArgs    []driver.Value
+	}{
+		{"all of a user", 0, []string{"user-1"}, "ptype = $1 AND v0 = $2", []driver.Value{"g", "user-1"}},
+		{"empty values match anything", 0, []string{"", "admin"}, "ptype = $1 AND v1 = $2", []driver.Value{"g", "admin"}},
+		{"from a later field", 1, []string{"/v1/posts", "GET"}, "ptype = $1 AND v1 = $2 AND v2 = $3", []driver.Value{"g", "/v1/posts", "GET"}},
+		{"fields past v5 are ignored", 5, []string{"a", "b"}, "ptype = $1 AND v5 = $2", []driver.Value{"g", "a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, fake := newFakeDB(t, fakedb.Result{})
+			if err := NewAdapter(db).RemoveFilteredPolicy("g", "g", tt.fieldIndex, tt.fieldValues...); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasSuffix(fake.Queries[0], "WHERE "+tt.wantWhere) {
+				t.Errorf("query = %q, want it to end in WHERE %s", fake.Queries[0], tt.wantWhere)
+			}
+			if !reflect.DeepEqual(fake.Args[0], tt.wantArgs) {
+				t.Errorf("args = %q, want %q", fake.Args[0], tt.wantArgs)
+			}
+		})
+	}
+}
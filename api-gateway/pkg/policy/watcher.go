@@ -0,0 +1,79 @@
+package policy
+
+import (
+	"sync"
+	"time"
+
+	"api-gateway/pkg/logger"
+
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// channel is the postgres NOTIFY channel policy changes are announced on
+const channel = "casbin_policy"
+
+type watcher struct {
+	db       *sqlx.DB
+	listener *pq.Listener
+	log      logger.Logger
+
+	mu       sync.Mutex
+	callback func(string)
+}
+
+// NewWatcher tells every gateway instance to reload its policies when one of
+// them changes casbin_rules, using postgres LISTEN/NOTIFY
+func NewWatcher(db *sqlx.DB, dsn string, log logger.Logger) (persist.Watcher, error) {
+	w := &watcher{db: db, log: log}
+	w.listener = pq.NewListener(dsn, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Error("policy listener error", logger.Error(err))
+		}
+	})
+	if err := w.listener.Listen(channel); err != nil {
+		w.listener.Close()
+		return nil, err
+	}
+
+	go w.run()
+	return w, nil
+}
+
+func (w *watcher) run() {
+	// a nil notification follows a reconnect, changes may have been missed
+	// meanwhile so reload in that case too
+	for n := range w.listener.Notify {
+		w.mu.Lock()
+		callback := w.callback
+		w.mu.Unlock()
+
+		if callback == nil {
+			continue
+		}
+		if n == nil {
+			callback("")
+		} else {
+			callback(n.Extra)
+		}
+	}
+}
+
+func (w *watcher) SetUpdateCallback(callback func(string)) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.callback = callback
+	return nil
+}
+
+func (w *watcher) Update() error {
+	_, err := w.db.Exec(`NOTIFY ` + channel)
+	return err
+}
+
+func (w *watcher) Close() {
+	if err := w.listener.Close(); err != nil {
+		w.log.Error("failed to close policy listener", logger.Error(err))
+	}
+}
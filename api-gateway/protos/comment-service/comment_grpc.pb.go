@@ -19,22 +19,42 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	CommentService_Create_FullMethodName = "/comment.CommentService/Create"
-	CommentService_Update_FullMethodName = "/comment.CommentService/Update"
-	CommentService_Delete_FullMethodName = "/comment.CommentService/Delete"
-	CommentService_Get_FullMethodName    = "/comment.CommentService/Get"
-	CommentService_GetAll_FullMethodName = "/comment.CommentService/GetAll"
+	CommentService_GetAllUsers_FullMethodName          = "/comment.CommentService/GetAllUsers"
+	CommentService_GetPostById_FullMethodName          = "/comment.CommentService/GetPostById"
+	CommentService_GetUserById_FullMethodName          = "/comment.CommentService/GetUserById"
+	CommentService_CreateComment_FullMethodName        = "/comment.CommentService/CreateComment"
+	CommentService_UpdateComment_FullMethodName        = "/comment.CommentService/UpdateComment"
+	CommentService_DeleteComment_FullMethodName        = "/comment.CommentService/DeleteComment"
+	CommentService_GetComment_FullMethodName           = "/comment.CommentService/GetComment"
+	CommentService_GetAllComment_FullMethodName        = "/comment.CommentService/GetAllComment"
+	CommentService_GetCommentsByPostId_FullMethodName  = "/comment.CommentService/GetCommentsByPostId"
+	CommentService_GetCommentsByOwnerId_FullMethodName = "/comment.CommentService/GetCommentsByOwnerId"
+	CommentService_GetCommentById_FullMethodName       = "/comment.CommentService/GetCommentById"
+	CommentService_GetCoCommenters_FullMethodName      = "/comment.CommentService/GetCoCommenters"
+	CommentService_PurgeComments_FullMethodName        = "/comment.CommentService/PurgeComments"
+	CommentService_ExportComments_FullMethodName       = "/comment.CommentService/ExportComments"
+	CommentService_CountComments_FullMethodName        = "/comment.CommentService/CountComments"
 )
 
 // CommentServiceClient is the client API for CommentService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CommentServiceClient interface {
-	Create(ctx context.Context, in *CreateComment, opts ...grpc.CallOption) (*Comment, error)
-	Update(ctx context.Context, in *UpdateComment, opts ...grpc.CallOption) (*Comment, error)
-	Delete(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*DeleteCommentRes, error)
-	Get(ctx context.Context, in *GetCommentReq, opts ...grpc.CallOption) (*Comment, error)
-	GetAll(ctx context.Context, in *GetAllCommentsReq, opts ...grpc.CallOption) (*GetAllCommentRes, error)
+	GetAllUsers(ctx context.Context, in *GetAllCommentsRequest, opts ...grpc.CallOption) (*GetAllCommentsResponse, error)
+	GetPostById(ctx context.Context, in *GetPostByIdRequest, opts ...grpc.CallOption) (*GetPostByIdResponse, error)
+	GetUserById(ctx context.Context, in *GetUserByIdRequest, opts ...grpc.CallOption) (*GetUserByIdResponse, error)
+	CreateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Comment, error)
+	UpdateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Comment, error)
+	DeleteComment(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (*DeleteResponse, error)
+	GetComment(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (*Comment, error)
+	GetAllComment(ctx context.Context, in *GetAllCommentsRequest, opts ...grpc.CallOption) (*GetAllCommentResponse, error)
+	GetCommentsByPostId(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (*GetAllCommentResponse, error)
+	GetCommentsByOwnerId(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (*GetAllCommentResponse, error)
+	GetCommentById(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (*Comment, error)
+	GetCoCommenters(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (*UserScores, error)
+	PurgeComments(ctx context.Context, in *PurgeCommentsReq, opts ...grpc.CallOption) (*PurgeRes, error)
+	ExportComments(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (CommentService_ExportCommentsClient, error)
+	CountComments(ctx context.Context, in *CountCommentsReq, opts ...grpc.CallOption) (*CommentCounts, error)
 }
 
 type commentServiceClient struct {
@@ -45,50 +65,173 @@ func NewCommentServiceClient(cc grpc.ClientConnInterface) CommentServiceClient {
 	return &commentServiceClient{cc}
 }
 
-func (c *commentServiceClient) Create(ctx context.Context, in *CreateComment, opts ...grpc.CallOption) (*Comment, error) {
+func (c *commentServiceClient) GetAllUsers(ctx context.Context, in *GetAllCommentsRequest, opts ...grpc.CallOption) (*GetAllCommentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllCommentsResponse)
+	err := c.cc.Invoke(ctx, CommentService_GetAllUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) GetPostById(ctx context.Context, in *GetPostByIdRequest, opts ...grpc.CallOption) (*GetPostByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostByIdResponse)
+	err := c.cc.Invoke(ctx, CommentService_GetPostById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) GetUserById(ctx context.Context, in *GetUserByIdRequest, opts ...grpc.CallOption) (*GetUserByIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserByIdResponse)
+	err := c.cc.Invoke(ctx, CommentService_GetUserById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) CreateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Comment, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Comment)
-	err := c.cc.Invoke(ctx, CommentService_Create_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, CommentService_CreateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) UpdateComment(ctx context.Context, in *Comment, opts ...grpc.CallOption) (*Comment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Comment)
+	err := c.cc.Invoke(ctx, CommentService_UpdateComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) DeleteComment(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, CommentService_DeleteComment_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *commentServiceClient) Update(ctx context.Context, in *UpdateComment, opts ...grpc.CallOption) (*Comment, error) {
+func (c *commentServiceClient) GetComment(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (*Comment, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Comment)
-	err := c.cc.Invoke(ctx, CommentService_Update_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, CommentService_GetComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) GetAllComment(ctx context.Context, in *GetAllCommentsRequest, opts ...grpc.CallOption) (*GetAllCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllCommentResponse)
+	err := c.cc.Invoke(ctx, CommentService_GetAllComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) GetCommentsByPostId(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (*GetAllCommentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllCommentResponse)
+	err := c.cc.Invoke(ctx, CommentService_GetCommentsByPostId_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *commentServiceClient) Delete(ctx context.Context, in *DeleteCommentReq, opts ...grpc.CallOption) (*DeleteCommentRes, error) {
+func (c *commentServiceClient) GetCommentsByOwnerId(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (*GetAllCommentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteCommentRes)
-	err := c.cc.Invoke(ctx, CommentService_Delete_FullMethodName, in, out, cOpts...)
+	out := new(GetAllCommentResponse)
+	err := c.cc.Invoke(ctx, CommentService_GetCommentsByOwnerId_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *commentServiceClient) Get(ctx context.Context, in *GetCommentReq, opts ...grpc.CallOption) (*Comment, error) {
+func (c *commentServiceClient) GetCommentById(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (*Comment, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Comment)
-	err := c.cc.Invoke(ctx, CommentService_Get_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, CommentService_GetCommentById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) GetCoCommenters(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (*UserScores, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserScores)
+	err := c.cc.Invoke(ctx, CommentService_GetCoCommenters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) PurgeComments(ctx context.Context, in *PurgeCommentsReq, opts ...grpc.CallOption) (*PurgeRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeRes)
+	err := c.cc.Invoke(ctx, CommentService_PurgeComments_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *commentServiceClient) GetAll(ctx context.Context, in *GetAllCommentsReq, opts ...grpc.CallOption) (*GetAllCommentRes, error) {
+func (c *commentServiceClient) ExportComments(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (CommentService_ExportCommentsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CommentService_ServiceDesc.Streams[0], CommentService_ExportComments_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &commentServiceExportCommentsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CommentService_ExportCommentsClient interface {
+	Recv() (*Comment, error)
+	grpc.ClientStream
+}
+
+type commentServiceExportCommentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *commentServiceExportCommentsClient) Recv() (*Comment, error) {
+	m := new(Comment)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *commentServiceClient) CountComments(ctx context.Context, in *CountCommentsReq, opts ...grpc.CallOption) (*CommentCounts, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAllCommentRes)
-	err := c.cc.Invoke(ctx, CommentService_GetAll_FullMethodName, in, out, cOpts...)
+	out := new(CommentCounts)
+	err := c.cc.Invoke(ctx, CommentService_CountComments_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -99,11 +242,21 @@ func (c *commentServiceClient) GetAll(ctx context.Context, in *GetAllCommentsReq
 // All implementations must embed UnimplementedCommentServiceServer
 // for forward compatibility
 type CommentServiceServer interface {
-	Create(context.Context, *CreateComment) (*Comment, error)
-	Update(context.Context, *UpdateComment) (*Comment, error)
-	Delete(context.Context, *DeleteCommentReq) (*DeleteCommentRes, error)
-	Get(context.Context, *GetCommentReq) (*Comment, error)
-	GetAll(context.Context, *GetAllCommentsReq) (*GetAllCommentRes, error)
+	GetAllUsers(context.Context, *GetAllCommentsRequest) (*GetAllCommentsResponse, error)
+	GetPostById(context.Context, *GetPostByIdRequest) (*GetPostByIdResponse, error)
+	GetUserById(context.Context, *GetUserByIdRequest) (*GetUserByIdResponse, error)
+	CreateComment(context.Context, *Comment) (*Comment, error)
+	UpdateComment(context.Context, *Comment) (*Comment, error)
+	DeleteComment(context.Context, *IdRequst) (*DeleteResponse, error)
+	GetComment(context.Context, *IdRequst) (*Comment, error)
+	GetAllComment(context.Context, *GetAllCommentsRequest) (*GetAllCommentResponse, error)
+	GetCommentsByPostId(context.Context, *IdRequst) (*GetAllCommentResponse, error)
+	GetCommentsByOwnerId(context.Context, *IdRequst) (*GetAllCommentResponse, error)
+	GetCommentById(context.Context, *IdRequst) (*Comment, error)
+	GetCoCommenters(context.Context, *IdRequst) (*UserScores, error)
+	PurgeComments(context.Context, *PurgeCommentsReq) (*PurgeRes, error)
+	ExportComments(*IdRequst, CommentService_ExportCommentsServer) error
+	CountComments(context.Context, *CountCommentsReq) (*CommentCounts, error)
 	mustEmbedUnimplementedCommentServiceServer()
 }
 
@@ -111,20 +264,50 @@ type CommentServiceServer interface {
 type UnimplementedCommentServiceServer struct {
 }
 
-func (UnimplementedCommentServiceServer) Create(context.Context, *CreateComment) (*Comment, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+func (UnimplementedCommentServiceServer) GetAllUsers(context.Context, *GetAllCommentsRequest) (*GetAllCommentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllUsers not implemented")
+}
+func (UnimplementedCommentServiceServer) GetPostById(context.Context, *GetPostByIdRequest) (*GetPostByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostById not implemented")
+}
+func (UnimplementedCommentServiceServer) GetUserById(context.Context, *GetUserByIdRequest) (*GetUserByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserById not implemented")
+}
+func (UnimplementedCommentServiceServer) CreateComment(context.Context, *Comment) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedCommentServiceServer) UpdateComment(context.Context, *Comment) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedCommentServiceServer) DeleteComment(context.Context, *IdRequst) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedCommentServiceServer) GetComment(context.Context, *IdRequst) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetComment not implemented")
+}
+func (UnimplementedCommentServiceServer) GetAllComment(context.Context, *GetAllCommentsRequest) (*GetAllCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllComment not implemented")
+}
+func (UnimplementedCommentServiceServer) GetCommentsByPostId(context.Context, *IdRequst) (*GetAllCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentsByPostId not implemented")
 }
-func (UnimplementedCommentServiceServer) Update(context.Context, *UpdateComment) (*Comment, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+func (UnimplementedCommentServiceServer) GetCommentsByOwnerId(context.Context, *IdRequst) (*GetAllCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentsByOwnerId not implemented")
 }
-func (UnimplementedCommentServiceServer) Delete(context.Context, *DeleteCommentReq) (*DeleteCommentRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+func (UnimplementedCommentServiceServer) GetCommentById(context.Context, *IdRequst) (*Comment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommentById not implemented")
 }
-func (UnimplementedCommentServiceServer) Get(context.Context, *GetCommentReq) (*Comment, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+func (UnimplementedCommentServiceServer) GetCoCommenters(context.Context, *IdRequst) (*UserScores, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoCommenters not implemented")
 }
-func (UnimplementedCommentServiceServer) GetAll(context.Context, *GetAllCommentsReq) (*GetAllCommentRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
+func (UnimplementedCommentServiceServer) PurgeComments(context.Context, *PurgeCommentsReq) (*PurgeRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeComments not implemented")
+}
+func (UnimplementedCommentServiceServer) ExportComments(*IdRequst, CommentService_ExportCommentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportComments not implemented")
+}
+func (UnimplementedCommentServiceServer) CountComments(context.Context, *CountCommentsReq) (*CommentCounts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountComments not implemented")
 }
 func (UnimplementedCommentServiceServer) mustEmbedUnimplementedCommentServiceServer() {}
 
@@ -139,92 +322,275 @@ func RegisterCommentServiceServer(s grpc.ServiceRegistrar, srv CommentServiceSer
 	s.RegisterService(&CommentService_ServiceDesc, srv)
 }
 
-func _CommentService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateComment)
+func _CommentService_GetAllUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetAllUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetAllUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetAllUsers(ctx, req.(*GetAllCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_GetPostById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommentServiceServer).Create(ctx, in)
+		return srv.(CommentServiceServer).GetPostById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CommentService_Create_FullMethodName,
+		FullMethod: CommentService_GetPostById_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommentServiceServer).Create(ctx, req.(*CreateComment))
+		return srv.(CommentServiceServer).GetPostById(ctx, req.(*GetPostByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CommentService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateComment)
+func _CommentService_GetUserById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommentServiceServer).Update(ctx, in)
+		return srv.(CommentServiceServer).GetUserById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CommentService_Update_FullMethodName,
+		FullMethod: CommentService_GetUserById_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommentServiceServer).Update(ctx, req.(*UpdateComment))
+		return srv.(CommentServiceServer).GetUserById(ctx, req.(*GetUserByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CommentService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCommentReq)
+func _CommentService_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Comment)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommentServiceServer).Delete(ctx, in)
+		return srv.(CommentServiceServer).CreateComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CommentService_Delete_FullMethodName,
+		FullMethod: CommentService_CreateComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommentServiceServer).Delete(ctx, req.(*DeleteCommentReq))
+		return srv.(CommentServiceServer).CreateComment(ctx, req.(*Comment))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CommentService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommentReq)
+func _CommentService_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Comment)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommentServiceServer).Get(ctx, in)
+		return srv.(CommentServiceServer).UpdateComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CommentService_Get_FullMethodName,
+		FullMethod: CommentService_UpdateComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommentServiceServer).Get(ctx, req.(*GetCommentReq))
+		return srv.(CommentServiceServer).UpdateComment(ctx, req.(*Comment))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CommentService_GetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllCommentsReq)
+func _CommentService_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequst)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CommentServiceServer).GetAll(ctx, in)
+		return srv.(CommentServiceServer).DeleteComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CommentService_GetAll_FullMethodName,
+		FullMethod: CommentService_DeleteComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CommentServiceServer).GetAll(ctx, req.(*GetAllCommentsReq))
+		return srv.(CommentServiceServer).DeleteComment(ctx, req.(*IdRequst))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_GetComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequst)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetComment(ctx, req.(*IdRequst))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_GetAllComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllCommentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetAllComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetAllComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetAllComment(ctx, req.(*GetAllCommentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_GetCommentsByPostId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequst)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetCommentsByPostId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetCommentsByPostId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetCommentsByPostId(ctx, req.(*IdRequst))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_GetCommentsByOwnerId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequst)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetCommentsByOwnerId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetCommentsByOwnerId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetCommentsByOwnerId(ctx, req.(*IdRequst))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_GetCommentById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequst)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetCommentById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetCommentById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetCommentById(ctx, req.(*IdRequst))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_GetCoCommenters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequst)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetCoCommenters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetCoCommenters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetCoCommenters(ctx, req.(*IdRequst))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_PurgeComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeCommentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).PurgeComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_PurgeComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).PurgeComments(ctx, req.(*PurgeCommentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_ExportComments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(IdRequst)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CommentServiceServer).ExportComments(m, &commentServiceExportCommentsServer{ServerStream: stream})
+}
+
+type CommentService_ExportCommentsServer interface {
+	Send(*Comment) error
+	grpc.ServerStream
+}
+
+type commentServiceExportCommentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *commentServiceExportCommentsServer) Send(m *Comment) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CommentService_CountComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountCommentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).CountComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_CountComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).CountComments(ctx, req.(*CountCommentsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -237,26 +603,68 @@ var CommentService_ServiceDesc = grpc.ServiceDesc{
 	HandlerType: (*CommentServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Create",
-			Handler:    _CommentService_Create_Handler,
+			MethodName: "GetAllUsers",
+			Handler:    _CommentService_GetAllUsers_Handler,
 		},
 		{
-			MethodName: "Update",
-			Handler:    _CommentService_Update_Handler,
+			MethodName: "GetPostById",
+			Handler:    _CommentService_GetPostById_Handler,
 		},
 		{
-			MethodName: "Delete",
-			Handler:    _CommentService_Delete_Handler,
+			MethodName: "GetUserById",
+			Handler:    _CommentService_GetUserById_Handler,
 		},
 		{
-			MethodName: "Get",
-			Handler:    _CommentService_Get_Handler,
+			MethodName: "CreateComment",
+			Handler:    _CommentService_CreateComment_Handler,
 		},
 		{
-			MethodName: "GetAll",
-			Handler:    _CommentService_GetAll_Handler,
+			MethodName: "UpdateComment",
+			Handler:    _CommentService_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _CommentService_DeleteComment_Handler,
+		},
+		{
+			MethodName: "GetComment",
+			Handler:    _CommentService_GetComment_Handler,
+		},
+		{
+			MethodName: "GetAllComment",
+			Handler:    _CommentService_GetAllComment_Handler,
+		},
+		{
+			MethodName: "GetCommentsByPostId",
+			Handler:    _CommentService_GetCommentsByPostId_Handler,
+		},
+		{
+			MethodName: "GetCommentsByOwnerId",
+			Handler:    _CommentService_GetCommentsByOwnerId_Handler,
+		},
+		{
+			MethodName: "GetCommentById",
+			Handler:    _CommentService_GetCommentById_Handler,
+		},
+		{
+			MethodName: "GetCoCommenters",
+			Handler:    _CommentService_GetCoCommenters_Handler,
+		},
+		{
+			MethodName: "PurgeComments",
+			Handler:    _CommentService_PurgeComments_Handler,
+		},
+		{
+			MethodName: "CountComments",
+			Handler:    _CommentService_CountComments_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportComments",
+			Handler:       _CommentService_ExportComments_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/comment-service/comment.proto",
 }
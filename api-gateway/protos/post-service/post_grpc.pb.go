@@ -19,22 +19,48 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	PostService_Create_FullMethodName = "/post.PostService/Create"
-	PostService_Update_FullMethodName = "/post.PostService/Update"
-	PostService_Delete_FullMethodName = "/post.PostService/Delete"
-	PostService_Get_FullMethodName    = "/post.PostService/Get"
-	PostService_GetAll_FullMethodName = "/post.PostService/GetAll"
+	PostService_Create_FullMethodName                = "/post.PostService/Create"
+	PostService_Update_FullMethodName                = "/post.PostService/Update"
+	PostService_Delete_FullMethodName                = "/post.PostService/Delete"
+	PostService_GetPost_FullMethodName               = "/post.PostService/GetPost"
+	PostService_GetAllPosts_FullMethodName           = "/post.PostService/GetAllPosts"
+	PostService_GetPostsByOwnerId_FullMethodName     = "/post.PostService/GetPostsByOwnerId"
+	PostService_GetCategoryNeighbours_FullMethodName = "/post.PostService/GetCategoryNeighbours"
+	PostService_PurgeOwner_FullMethodName            = "/post.PostService/PurgeOwner"
+	PostService_ExportPosts_FullMethodName           = "/post.PostService/ExportPosts"
+	PostService_React_FullMethodName                 = "/post.PostService/React"
+	PostService_Unreact_FullMethodName               = "/post.PostService/Unreact"
+	PostService_ListReactors_FullMethodName          = "/post.PostService/ListReactors"
+	PostService_GetFeed_FullMethodName               = "/post.PostService/GetFeed"
+	PostService_RebuildFeed_FullMethodName           = "/post.PostService/RebuildFeed"
+	PostService_GetTrending_FullMethodName           = "/post.PostService/GetTrending"
+	PostService_GetPostsByTag_FullMethodName         = "/post.PostService/GetPostsByTag"
+	PostService_SearchTags_FullMethodName            = "/post.PostService/SearchTags"
+	PostService_GetTag_FullMethodName                = "/post.PostService/GetTag"
 )
 
 // PostServiceClient is the client API for PostService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PostServiceClient interface {
-	Create(ctx context.Context, in *CreatePost, opts ...grpc.CallOption) (*Post, error)
-	Update(ctx context.Context, in *UpdatePost, opts ...grpc.CallOption) (*Post, error)
-	Delete(ctx context.Context, in *DeletePostReq, opts ...grpc.CallOption) (*DeletePostRes, error)
-	Get(ctx context.Context, in *GetPostReq, opts ...grpc.CallOption) (*Post, error)
-	GetAll(ctx context.Context, in *GetAllPostsReq, opts ...grpc.CallOption) (*GetAllPostsRes, error)
+	Create(ctx context.Context, in *Post, opts ...grpc.CallOption) (*Post, error)
+	Update(ctx context.Context, in *Post, opts ...grpc.CallOption) (*Post, error)
+	Delete(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	GetPost(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*PostResponse, error)
+	GetAllPosts(ctx context.Context, in *GetAllPostsRequest, opts ...grpc.CallOption) (*GetPostsByOwnerIdResponse, error)
+	GetPostsByOwnerId(ctx context.Context, in *GetPostsByOwnerIdRequest, opts ...grpc.CallOption) (*GetPostsByOwnerIdResponse, error)
+	GetCategoryNeighbours(ctx context.Context, in *GetPostsByOwnerIdRequest, opts ...grpc.CallOption) (*UserScores, error)
+	PurgeOwner(ctx context.Context, in *GetPostsByOwnerIdRequest, opts ...grpc.CallOption) (*PurgeRes, error)
+	ExportPosts(ctx context.Context, in *GetPostsByOwnerIdRequest, opts ...grpc.CallOption) (PostService_ExportPostsClient, error)
+	React(ctx context.Context, in *ReactReq, opts ...grpc.CallOption) (*Reactions, error)
+	Unreact(ctx context.Context, in *ReactReq, opts ...grpc.CallOption) (*Reactions, error)
+	ListReactors(ctx context.Context, in *ListReactorsReq, opts ...grpc.CallOption) (*Reactors, error)
+	GetFeed(ctx context.Context, in *GetFeedReq, opts ...grpc.CallOption) (*Feed, error)
+	RebuildFeed(ctx context.Context, in *RebuildFeedReq, opts ...grpc.CallOption) (*RebuildFeedRes, error)
+	GetTrending(ctx context.Context, in *GetTrendingReq, opts ...grpc.CallOption) (*TrendingPosts, error)
+	GetPostsByTag(ctx context.Context, in *PostsByTagReq, opts ...grpc.CallOption) (*Feed, error)
+	SearchTags(ctx context.Context, in *SearchTagsReq, opts ...grpc.CallOption) (*Tags, error)
+	GetTag(ctx context.Context, in *TagReq, opts ...grpc.CallOption) (*Tag, error)
 }
 
 type postServiceClient struct {
@@ -45,7 +71,7 @@ func NewPostServiceClient(cc grpc.ClientConnInterface) PostServiceClient {
 	return &postServiceClient{cc}
 }
 
-func (c *postServiceClient) Create(ctx context.Context, in *CreatePost, opts ...grpc.CallOption) (*Post, error) {
+func (c *postServiceClient) Create(ctx context.Context, in *Post, opts ...grpc.CallOption) (*Post, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Post)
 	err := c.cc.Invoke(ctx, PostService_Create_FullMethodName, in, out, cOpts...)
@@ -55,7 +81,7 @@ func (c *postServiceClient) Create(ctx context.Context, in *CreatePost, opts ...
 	return out, nil
 }
 
-func (c *postServiceClient) Update(ctx context.Context, in *UpdatePost, opts ...grpc.CallOption) (*Post, error) {
+func (c *postServiceClient) Update(ctx context.Context, in *Post, opts ...grpc.CallOption) (*Post, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Post)
 	err := c.cc.Invoke(ctx, PostService_Update_FullMethodName, in, out, cOpts...)
@@ -65,9 +91,9 @@ func (c *postServiceClient) Update(ctx context.Context, in *UpdatePost, opts ...
 	return out, nil
 }
 
-func (c *postServiceClient) Delete(ctx context.Context, in *DeletePostReq, opts ...grpc.CallOption) (*DeletePostRes, error) {
+func (c *postServiceClient) Delete(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*CheckResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeletePostRes)
+	out := new(CheckResponse)
 	err := c.cc.Invoke(ctx, PostService_Delete_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -75,20 +101,173 @@ func (c *postServiceClient) Delete(ctx context.Context, in *DeletePostReq, opts
 	return out, nil
 }
 
-func (c *postServiceClient) Get(ctx context.Context, in *GetPostReq, opts ...grpc.CallOption) (*Post, error) {
+func (c *postServiceClient) GetPost(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*PostResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Post)
-	err := c.cc.Invoke(ctx, PostService_Get_FullMethodName, in, out, cOpts...)
+	out := new(PostResponse)
+	err := c.cc.Invoke(ctx, PostService_GetPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetAllPosts(ctx context.Context, in *GetAllPostsRequest, opts ...grpc.CallOption) (*GetPostsByOwnerIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostsByOwnerIdResponse)
+	err := c.cc.Invoke(ctx, PostService_GetAllPosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetPostsByOwnerId(ctx context.Context, in *GetPostsByOwnerIdRequest, opts ...grpc.CallOption) (*GetPostsByOwnerIdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPostsByOwnerIdResponse)
+	err := c.cc.Invoke(ctx, PostService_GetPostsByOwnerId_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetCategoryNeighbours(ctx context.Context, in *GetPostsByOwnerIdRequest, opts ...grpc.CallOption) (*UserScores, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserScores)
+	err := c.cc.Invoke(ctx, PostService_GetCategoryNeighbours_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) PurgeOwner(ctx context.Context, in *GetPostsByOwnerIdRequest, opts ...grpc.CallOption) (*PurgeRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeRes)
+	err := c.cc.Invoke(ctx, PostService_PurgeOwner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ExportPosts(ctx context.Context, in *GetPostsByOwnerIdRequest, opts ...grpc.CallOption) (PostService_ExportPostsClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PostService_ServiceDesc.Streams[0], PostService_ExportPosts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &postServiceExportPostsClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PostService_ExportPostsClient interface {
+	Recv() (*Post, error)
+	grpc.ClientStream
+}
+
+type postServiceExportPostsClient struct {
+	grpc.ClientStream
+}
+
+func (x *postServiceExportPostsClient) Recv() (*Post, error) {
+	m := new(Post)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *postServiceClient) React(ctx context.Context, in *ReactReq, opts ...grpc.CallOption) (*Reactions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reactions)
+	err := c.cc.Invoke(ctx, PostService_React_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) Unreact(ctx context.Context, in *ReactReq, opts ...grpc.CallOption) (*Reactions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reactions)
+	err := c.cc.Invoke(ctx, PostService_Unreact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListReactors(ctx context.Context, in *ListReactorsReq, opts ...grpc.CallOption) (*Reactors, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reactors)
+	err := c.cc.Invoke(ctx, PostService_ListReactors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetFeed(ctx context.Context, in *GetFeedReq, opts ...grpc.CallOption) (*Feed, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Feed)
+	err := c.cc.Invoke(ctx, PostService_GetFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) RebuildFeed(ctx context.Context, in *RebuildFeedReq, opts ...grpc.CallOption) (*RebuildFeedRes, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebuildFeedRes)
+	err := c.cc.Invoke(ctx, PostService_RebuildFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetTrending(ctx context.Context, in *GetTrendingReq, opts ...grpc.CallOption) (*TrendingPosts, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrendingPosts)
+	err := c.cc.Invoke(ctx, PostService_GetTrending_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetPostsByTag(ctx context.Context, in *PostsByTagReq, opts ...grpc.CallOption) (*Feed, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Feed)
+	err := c.cc.Invoke(ctx, PostService_GetPostsByTag_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *postServiceClient) GetAll(ctx context.Context, in *GetAllPostsReq, opts ...grpc.CallOption) (*GetAllPostsRes, error) {
+func (c *postServiceClient) SearchTags(ctx context.Context, in *SearchTagsReq, opts ...grpc.CallOption) (*Tags, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAllPostsRes)
-	err := c.cc.Invoke(ctx, PostService_GetAll_FullMethodName, in, out, cOpts...)
+	out := new(Tags)
+	err := c.cc.Invoke(ctx, PostService_SearchTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetTag(ctx context.Context, in *TagReq, opts ...grpc.CallOption) (*Tag, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tag)
+	err := c.cc.Invoke(ctx, PostService_GetTag_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -99,11 +278,24 @@ func (c *postServiceClient) GetAll(ctx context.Context, in *GetAllPostsReq, opts
 // All implementations must embed UnimplementedPostServiceServer
 // for forward compatibility
 type PostServiceServer interface {
-	Create(context.Context, *CreatePost) (*Post, error)
-	Update(context.Context, *UpdatePost) (*Post, error)
-	Delete(context.Context, *DeletePostReq) (*DeletePostRes, error)
-	Get(context.Context, *GetPostReq) (*Post, error)
-	GetAll(context.Context, *GetAllPostsReq) (*GetAllPostsRes, error)
+	Create(context.Context, *Post) (*Post, error)
+	Update(context.Context, *Post) (*Post, error)
+	Delete(context.Context, *GetRequest) (*CheckResponse, error)
+	GetPost(context.Context, *GetRequest) (*PostResponse, error)
+	GetAllPosts(context.Context, *GetAllPostsRequest) (*GetPostsByOwnerIdResponse, error)
+	GetPostsByOwnerId(context.Context, *GetPostsByOwnerIdRequest) (*GetPostsByOwnerIdResponse, error)
+	GetCategoryNeighbours(context.Context, *GetPostsByOwnerIdRequest) (*UserScores, error)
+	PurgeOwner(context.Context, *GetPostsByOwnerIdRequest) (*PurgeRes, error)
+	ExportPosts(*GetPostsByOwnerIdRequest, PostService_ExportPostsServer) error
+	React(context.Context, *ReactReq) (*Reactions, error)
+	Unreact(context.Context, *ReactReq) (*Reactions, error)
+	ListReactors(context.Context, *ListReactorsReq) (*Reactors, error)
+	GetFeed(context.Context, *GetFeedReq) (*Feed, error)
+	RebuildFeed(context.Context, *RebuildFeedReq) (*RebuildFeedRes, error)
+	GetTrending(context.Context, *GetTrendingReq) (*TrendingPosts, error)
+	GetPostsByTag(context.Context, *PostsByTagReq) (*Feed, error)
+	SearchTags(context.Context, *SearchTagsReq) (*Tags, error)
+	GetTag(context.Context, *TagReq) (*Tag, error)
 	mustEmbedUnimplementedPostServiceServer()
 }
 
@@ -111,20 +303,59 @@ type PostServiceServer interface {
 type UnimplementedPostServiceServer struct {
 }
 
-func (UnimplementedPostServiceServer) Create(context.Context, *CreatePost) (*Post, error) {
+func (UnimplementedPostServiceServer) Create(context.Context, *Post) (*Post, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedPostServiceServer) Update(context.Context, *UpdatePost) (*Post, error) {
+func (UnimplementedPostServiceServer) Update(context.Context, *Post) (*Post, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
-func (UnimplementedPostServiceServer) Delete(context.Context, *DeletePostReq) (*DeletePostRes, error) {
+func (UnimplementedPostServiceServer) Delete(context.Context, *GetRequest) (*CheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedPostServiceServer) Get(context.Context, *GetPostReq) (*Post, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+func (UnimplementedPostServiceServer) GetPost(context.Context, *GetRequest) (*PostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
+}
+func (UnimplementedPostServiceServer) GetAllPosts(context.Context, *GetAllPostsRequest) (*GetPostsByOwnerIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllPosts not implemented")
+}
+func (UnimplementedPostServiceServer) GetPostsByOwnerId(context.Context, *GetPostsByOwnerIdRequest) (*GetPostsByOwnerIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsByOwnerId not implemented")
+}
+func (UnimplementedPostServiceServer) GetCategoryNeighbours(context.Context, *GetPostsByOwnerIdRequest) (*UserScores, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryNeighbours not implemented")
+}
+func (UnimplementedPostServiceServer) PurgeOwner(context.Context, *GetPostsByOwnerIdRequest) (*PurgeRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeOwner not implemented")
+}
+func (UnimplementedPostServiceServer) ExportPosts(*GetPostsByOwnerIdRequest, PostService_ExportPostsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportPosts not implemented")
+}
+func (UnimplementedPostServiceServer) React(context.Context, *ReactReq) (*Reactions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method React not implemented")
+}
+func (UnimplementedPostServiceServer) Unreact(context.Context, *ReactReq) (*Reactions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unreact not implemented")
+}
+func (UnimplementedPostServiceServer) ListReactors(context.Context, *ListReactorsReq) (*Reactors, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReactors not implemented")
+}
+func (UnimplementedPostServiceServer) GetFeed(context.Context, *GetFeedReq) (*Feed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeed not implemented")
+}
+func (UnimplementedPostServiceServer) RebuildFeed(context.Context, *RebuildFeedReq) (*RebuildFeedRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildFeed not implemented")
+}
+func (UnimplementedPostServiceServer) GetTrending(context.Context, *GetTrendingReq) (*TrendingPosts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrending not implemented")
+}
+func (UnimplementedPostServiceServer) GetPostsByTag(context.Context, *PostsByTagReq) (*Feed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsByTag not implemented")
+}
+func (UnimplementedPostServiceServer) SearchTags(context.Context, *SearchTagsReq) (*Tags, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTags not implemented")
 }
-func (UnimplementedPostServiceServer) GetAll(context.Context, *GetAllPostsReq) (*GetAllPostsRes, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
+func (UnimplementedPostServiceServer) GetTag(context.Context, *TagReq) (*Tag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTag not implemented")
 }
 func (UnimplementedPostServiceServer) mustEmbedUnimplementedPostServiceServer() {}
 
@@ -140,7 +371,7 @@ func RegisterPostServiceServer(s grpc.ServiceRegistrar, srv PostServiceServer) {
 }
 
 func _PostService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePost)
+	in := new(Post)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -152,13 +383,13 @@ func _PostService_Create_Handler(srv interface{}, ctx context.Context, dec func(
 		FullMethod: PostService_Create_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).Create(ctx, req.(*CreatePost))
+		return srv.(PostServiceServer).Create(ctx, req.(*Post))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _PostService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePost)
+	in := new(Post)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -170,13 +401,13 @@ func _PostService_Update_Handler(srv interface{}, ctx context.Context, dec func(
 		FullMethod: PostService_Update_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).Update(ctx, req.(*UpdatePost))
+		return srv.(PostServiceServer).Update(ctx, req.(*Post))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _PostService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePostReq)
+	in := new(GetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -188,43 +419,280 @@ func _PostService_Delete_Handler(srv interface{}, ctx context.Context, dec func(
 		FullMethod: PostService_Delete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).Delete(ctx, req.(*DeletePostReq))
+		return srv.(PostServiceServer).Delete(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPost(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetAllPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetAllPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetAllPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetAllPosts(ctx, req.(*GetAllPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetPostsByOwnerId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsByOwnerIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPostsByOwnerId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetPostsByOwnerId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPostsByOwnerId(ctx, req.(*GetPostsByOwnerIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetCategoryNeighbours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsByOwnerIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetCategoryNeighbours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetCategoryNeighbours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetCategoryNeighbours(ctx, req.(*GetPostsByOwnerIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_PurgeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsByOwnerIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).PurgeOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_PurgeOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).PurgeOwner(ctx, req.(*GetPostsByOwnerIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ExportPosts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetPostsByOwnerIdRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PostServiceServer).ExportPosts(m, &postServiceExportPostsServer{ServerStream: stream})
+}
+
+type PostService_ExportPostsServer interface {
+	Send(*Post) error
+	grpc.ServerStream
+}
+
+type postServiceExportPostsServer struct {
+	grpc.ServerStream
+}
+
+func (x *postServiceExportPostsServer) Send(m *Post) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PostService_React_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).React(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_React_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).React(ctx, req.(*ReactReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_Unreact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).Unreact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_Unreact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).Unreact(ctx, req.(*ReactReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListReactors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReactorsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListReactors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListReactors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListReactors(ctx, req.(*ListReactorsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetFeed(ctx, req.(*GetFeedReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPostReq)
+func _PostService_RebuildFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildFeedReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).Get(ctx, in)
+		return srv.(PostServiceServer).RebuildFeed(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_Get_FullMethodName,
+		FullMethod: PostService_RebuildFeed_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).Get(ctx, req.(*GetPostReq))
+		return srv.(PostServiceServer).RebuildFeed(ctx, req.(*RebuildFeedReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PostService_GetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllPostsReq)
+func _PostService_GetTrending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PostServiceServer).GetAll(ctx, in)
+		return srv.(PostServiceServer).GetTrending(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PostService_GetAll_FullMethodName,
+		FullMethod: PostService_GetTrending_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PostServiceServer).GetAll(ctx, req.(*GetAllPostsReq))
+		return srv.(PostServiceServer).GetTrending(ctx, req.(*GetTrendingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetPostsByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostsByTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPostsByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetPostsByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPostsByTag(ctx, req.(*PostsByTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_SearchTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).SearchTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_SearchTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).SearchTags(ctx, req.(*SearchTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetTag(ctx, req.(*TagReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -249,14 +717,68 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _PostService_Delete_Handler,
 		},
 		{
-			MethodName: "Get",
-			Handler:    _PostService_Get_Handler,
+			MethodName: "GetPost",
+			Handler:    _PostService_GetPost_Handler,
+		},
+		{
+			MethodName: "GetAllPosts",
+			Handler:    _PostService_GetAllPosts_Handler,
+		},
+		{
+			MethodName: "GetPostsByOwnerId",
+			Handler:    _PostService_GetPostsByOwnerId_Handler,
+		},
+		{
+			MethodName: "GetCategoryNeighbours",
+			Handler:    _PostService_GetCategoryNeighbours_Handler,
+		},
+		{
+			MethodName: "PurgeOwner",
+			Handler:    _PostService_PurgeOwner_Handler,
 		},
 		{
-			MethodName: "GetAll",
-			Handler:    _PostService_GetAll_Handler,
+			MethodName: "React",
+			Handler:    _PostService_React_Handler,
+		},
+		{
+			MethodName: "Unreact",
+			Handler:    _PostService_Unreact_Handler,
+		},
+		{
+			MethodName: "ListReactors",
+			Handler:    _PostService_ListReactors_Handler,
+		},
+		{
+			MethodName: "GetFeed",
+			Handler:    _PostService_GetFeed_Handler,
+		},
+		{
+			MethodName: "RebuildFeed",
+			Handler:    _PostService_RebuildFeed_Handler,
+		},
+		{
+			MethodName: "GetTrending",
+			Handler:    _PostService_GetTrending_Handler,
+		},
+		{
+			MethodName: "GetPostsByTag",
+			Handler:    _PostService_GetPostsByTag_Handler,
+		},
+		{
+			MethodName: "SearchTags",
+			Handler:    _PostService_SearchTags_Handler,
+		},
+		{
+			MethodName: "GetTag",
+			Handler:    _PostService_GetTag_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportPosts",
+			Handler:       _PostService_ExportPosts_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/post-service/post.proto",
 }
@@ -8,6 +8,8 @@ import (
 	pbp "api-gateway/protos/post-service"
 	pbu "api-gateway/protos/user-service"
 
+	"shared/auth"
+
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/resolver"
@@ -42,21 +44,27 @@ func NewServiceManager(conf *config.Config) (IServiceManager, error) {
 
 	connUser, err := grpc.NewClient(
 		fmt.Sprintf("%s:%d", conf.UserServiceHost, conf.UserServicePort),
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(auth.ClientInterceptor(conf.InternalSecret)),
+		grpc.WithStreamInterceptor(auth.StreamClientInterceptor(conf.InternalSecret)))
 	if err != nil {
 		return nil, err
 	}
 
 	connPost, err := grpc.NewClient(
 		fmt.Sprintf("%s:%d", conf.PostServiceHost, conf.PostServicePort),
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(auth.ClientInterceptor(conf.InternalSecret)),
+		grpc.WithStreamInterceptor(auth.StreamClientInterceptor(conf.InternalSecret)))
 	if err != nil {
 		return nil, err
 	}
 
 	conncomment, err := grpc.NewClient(
 		fmt.Sprintf("%s:%d", conf.CommentServiceHost, conf.CommentServicePort),
-		grpc.WithTransportCredentials(insecure.NewCredentials()))
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(auth.ClientInterceptor(conf.InternalSecret)),
+		grpc.WithStreamInterceptor(auth.StreamClientInterceptor(conf.InternalSecret)))
 	if err != nil {
 		return nil, err
 	}
@@ -9,6 +9,8 @@ import (
 	grpcclient "comment-service/service/grpc_client"
 	"net"
 
+	"shared/auth"
+
 	"google.golang.org/grpc"
 )
 
@@ -40,7 +42,13 @@ func main() {
 		log.Fatal("Error while listening: %v", logger.Error(err))
 	}
 
-	s := grpc.NewServer()
+	if cfg.InternalSecret == "" {
+		log.Fatal("INTERNAL_SECRET is not set, the service trusts the user metadata of its callers")
+	}
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(auth.ServerInterceptor(cfg.InternalSecret)),
+		grpc.StreamInterceptor(auth.StreamServerInterceptor(cfg.InternalSecret)),
+	)
 	pbc.RegisterCommentServiceServer(s, commentService)
 	log.Info("main: server running",
 		logger.String("port", cfg.RPCPort))
@@ -16,6 +16,9 @@ type Config struct {
 	PostgresPassword string
 	LogLevel         string
 	RPCPort          string
+	// shared with the api-gateway and the other services, calls without it
+	// are rejected
+	InternalSecret string
 	// connect fields
 	UserServiceHost string
 	UserServicePort int
@@ -45,6 +48,7 @@ func Load() Config {
 
 	c.LogLevel = cast.ToString(getOrReturnDefault("LOG_LEVEL", "debug"))
 	c.RPCPort = cast.ToString(getOrReturnDefault("RPC_PORT", ":3333"))
+	c.InternalSecret = cast.ToString(getOrReturnDefault("INTERNAL_SECRET", ""))
 
 	return c
 }
@@ -23,3 +23,7 @@ require (
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240123012728-ef4313101c80 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+require shared v0.0.0-00010101000000-000000000000
+
+replace shared => ../shared
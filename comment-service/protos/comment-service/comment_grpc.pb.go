@@ -30,6 +30,10 @@ const (
 	CommentService_GetCommentsByPostId_FullMethodName  = "/comment.CommentService/GetCommentsByPostId"
 	CommentService_GetCommentsByOwnerId_FullMethodName = "/comment.CommentService/GetCommentsByOwnerId"
 	CommentService_GetCommentById_FullMethodName       = "/comment.CommentService/GetCommentById"
+	CommentService_GetCoCommenters_FullMethodName      = "/comment.CommentService/GetCoCommenters"
+	CommentService_PurgeComments_FullMethodName        = "/comment.CommentService/PurgeComments"
+	CommentService_ExportComments_FullMethodName       = "/comment.CommentService/ExportComments"
+	CommentService_CountComments_FullMethodName        = "/comment.CommentService/CountComments"
 )
 
 // CommentServiceClient is the client API for CommentService service.
@@ -47,6 +51,10 @@ type CommentServiceClient interface {
 	GetCommentsByPostId(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (*GetAllCommentResponse, error)
 	GetCommentsByOwnerId(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (*GetAllCommentResponse, error)
 	GetCommentById(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (*Comment, error)
+	GetCoCommenters(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (*UserScores, error)
+	PurgeComments(ctx context.Context, in *PurgeCommentsReq, opts ...grpc.CallOption) (*PurgeRes, error)
+	ExportComments(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (CommentService_ExportCommentsClient, error)
+	CountComments(ctx context.Context, in *CountCommentsReq, opts ...grpc.CallOption) (*CommentCounts, error)
 }
 
 type commentServiceClient struct {
@@ -156,6 +164,65 @@ func (c *commentServiceClient) GetCommentById(ctx context.Context, in *IdRequst,
 	return out, nil
 }
 
+func (c *commentServiceClient) GetCoCommenters(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (*UserScores, error) {
+	out := new(UserScores)
+	err := c.cc.Invoke(ctx, CommentService_GetCoCommenters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) PurgeComments(ctx context.Context, in *PurgeCommentsReq, opts ...grpc.CallOption) (*PurgeRes, error) {
+	out := new(PurgeRes)
+	err := c.cc.Invoke(ctx, CommentService_PurgeComments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentServiceClient) ExportComments(ctx context.Context, in *IdRequst, opts ...grpc.CallOption) (CommentService_ExportCommentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CommentService_ServiceDesc.Streams[0], CommentService_ExportComments_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &commentServiceExportCommentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CommentService_ExportCommentsClient interface {
+	Recv() (*Comment, error)
+	grpc.ClientStream
+}
+
+type commentServiceExportCommentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *commentServiceExportCommentsClient) Recv() (*Comment, error) {
+	m := new(Comment)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *commentServiceClient) CountComments(ctx context.Context, in *CountCommentsReq, opts ...grpc.CallOption) (*CommentCounts, error) {
+	out := new(CommentCounts)
+	err := c.cc.Invoke(ctx, CommentService_CountComments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommentServiceServer is the server API for CommentService service.
 // All implementations must embed UnimplementedCommentServiceServer
 // for forward compatibility
@@ -171,6 +238,10 @@ type CommentServiceServer interface {
 	GetCommentsByPostId(context.Context, *IdRequst) (*GetAllCommentResponse, error)
 	GetCommentsByOwnerId(context.Context, *IdRequst) (*GetAllCommentResponse, error)
 	GetCommentById(context.Context, *IdRequst) (*Comment, error)
+	GetCoCommenters(context.Context, *IdRequst) (*UserScores, error)
+	PurgeComments(context.Context, *PurgeCommentsReq) (*PurgeRes, error)
+	ExportComments(*IdRequst, CommentService_ExportCommentsServer) error
+	CountComments(context.Context, *CountCommentsReq) (*CommentCounts, error)
 	mustEmbedUnimplementedCommentServiceServer()
 }
 
@@ -211,6 +282,18 @@ func (UnimplementedCommentServiceServer) GetCommentsByOwnerId(context.Context, *
 func (UnimplementedCommentServiceServer) GetCommentById(context.Context, *IdRequst) (*Comment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCommentById not implemented")
 }
+func (UnimplementedCommentServiceServer) GetCoCommenters(context.Context, *IdRequst) (*UserScores, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoCommenters not implemented")
+}
+func (UnimplementedCommentServiceServer) PurgeComments(context.Context, *PurgeCommentsReq) (*PurgeRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeComments not implemented")
+}
+func (UnimplementedCommentServiceServer) ExportComments(*IdRequst, CommentService_ExportCommentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportComments not implemented")
+}
+func (UnimplementedCommentServiceServer) CountComments(context.Context, *CountCommentsReq) (*CommentCounts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountComments not implemented")
+}
 func (UnimplementedCommentServiceServer) mustEmbedUnimplementedCommentServiceServer() {}
 
 // UnsafeCommentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -422,6 +505,81 @@ func _CommentService_GetCommentById_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommentService_GetCoCommenters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdRequst)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).GetCoCommenters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_GetCoCommenters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).GetCoCommenters(ctx, req.(*IdRequst))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_PurgeComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeCommentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).PurgeComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_PurgeComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).PurgeComments(ctx, req.(*PurgeCommentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentService_ExportComments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(IdRequst)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CommentServiceServer).ExportComments(m, &commentServiceExportCommentsServer{stream})
+}
+
+type CommentService_ExportCommentsServer interface {
+	Send(*Comment) error
+	grpc.ServerStream
+}
+
+type commentServiceExportCommentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *commentServiceExportCommentsServer) Send(m *Comment) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CommentService_CountComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountCommentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentServiceServer).CountComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CommentService_CountComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentServiceServer).CountComments(ctx, req.(*CountCommentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CommentService_ServiceDesc is the grpc.ServiceDesc for CommentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -473,7 +631,25 @@ var CommentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCommentById",
 			Handler:    _CommentService_GetCommentById_Handler,
 		},
+		{
+			MethodName: "GetCoCommenters",
+			Handler:    _CommentService_GetCoCommenters_Handler,
+		},
+		{
+			MethodName: "PurgeComments",
+			Handler:    _CommentService_PurgeComments_Handler,
+		},
+		{
+			MethodName: "CountComments",
+			Handler:    _CommentService_CountComments_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportComments",
+			Handler:       _CommentService_ExportComments_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "comment.proto",
 }
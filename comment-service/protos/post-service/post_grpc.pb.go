@@ -19,12 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	PostService_Create_FullMethodName            = "/post.PostService/Create"
-	PostService_Update_FullMethodName            = "/post.PostService/Update"
-	PostService_Delete_FullMethodName            = "/post.PostService/Delete"
-	PostService_GetPost_FullMethodName           = "/post.PostService/GetPost"
-	PostService_GetAllPosts_FullMethodName       = "/post.PostService/GetAllPosts"
-	PostService_GetPostsByOwnerId_FullMethodName = "/post.PostService/GetPostsByOwnerId"
+	PostService_Create_FullMethodName                = "/post.PostService/Create"
+	PostService_Update_FullMethodName                = "/post.PostService/Update"
+	PostService_Delete_FullMethodName                = "/post.PostService/Delete"
+	PostService_GetPost_FullMethodName               = "/post.PostService/GetPost"
+	PostService_GetAllPosts_FullMethodName           = "/post.PostService/GetAllPosts"
+	PostService_GetPostsByOwnerId_FullMethodName     = "/post.PostService/GetPostsByOwnerId"
+	PostService_GetCategoryNeighbours_FullMethodName = "/post.PostService/GetCategoryNeighbours"
+	PostService_PurgeOwner_FullMethodName            = "/post.PostService/PurgeOwner"
+	PostService_ExportPosts_FullMethodName           = "/post.PostService/ExportPosts"
+	PostService_React_FullMethodName                 = "/post.PostService/React"
+	PostService_Unreact_FullMethodName               = "/post.PostService/Unreact"
+	PostService_ListReactors_FullMethodName          = "/post.PostService/ListReactors"
+	PostService_GetFeed_FullMethodName               = "/post.PostService/GetFeed"
+	PostService_RebuildFeed_FullMethodName           = "/post.PostService/RebuildFeed"
+	PostService_GetTrending_FullMethodName           = "/post.PostService/GetTrending"
+	PostService_GetPostsByTag_FullMethodName         = "/post.PostService/GetPostsByTag"
+	PostService_SearchTags_FullMethodName            = "/post.PostService/SearchTags"
+	PostService_GetTag_FullMethodName                = "/post.PostService/GetTag"
 )
 
 // PostServiceClient is the client API for PostService service.
@@ -37,6 +49,18 @@ type PostServiceClient interface {
 	GetPost(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*PostResponse, error)
 	GetAllPosts(ctx context.Context, in *GetAllPostsRequest, opts ...grpc.CallOption) (*GetPostsByOwnerIdResponse, error)
 	GetPostsByOwnerId(ctx context.Context, in *GetPostsByOwnerIdRequest, opts ...grpc.CallOption) (*GetPostsByOwnerIdResponse, error)
+	GetCategoryNeighbours(ctx context.Context, in *GetPostsByOwnerIdRequest, opts ...grpc.CallOption) (*UserScores, error)
+	PurgeOwner(ctx context.Context, in *GetPostsByOwnerIdRequest, opts ...grpc.CallOption) (*PurgeRes, error)
+	ExportPosts(ctx context.Context, in *GetPostsByOwnerIdRequest, opts ...grpc.CallOption) (PostService_ExportPostsClient, error)
+	React(ctx context.Context, in *ReactReq, opts ...grpc.CallOption) (*Reactions, error)
+	Unreact(ctx context.Context, in *ReactReq, opts ...grpc.CallOption) (*Reactions, error)
+	ListReactors(ctx context.Context, in *ListReactorsReq, opts ...grpc.CallOption) (*Reactors, error)
+	GetFeed(ctx context.Context, in *GetFeedReq, opts ...grpc.CallOption) (*Feed, error)
+	RebuildFeed(ctx context.Context, in *RebuildFeedReq, opts ...grpc.CallOption) (*RebuildFeedRes, error)
+	GetTrending(ctx context.Context, in *GetTrendingReq, opts ...grpc.CallOption) (*TrendingPosts, error)
+	GetPostsByTag(ctx context.Context, in *PostsByTagReq, opts ...grpc.CallOption) (*Feed, error)
+	SearchTags(ctx context.Context, in *SearchTagsReq, opts ...grpc.CallOption) (*Tags, error)
+	GetTag(ctx context.Context, in *TagReq, opts ...grpc.CallOption) (*Tag, error)
 }
 
 type postServiceClient struct {
@@ -101,6 +125,137 @@ func (c *postServiceClient) GetPostsByOwnerId(ctx context.Context, in *GetPostsB
 	return out, nil
 }
 
+func (c *postServiceClient) GetCategoryNeighbours(ctx context.Context, in *GetPostsByOwnerIdRequest, opts ...grpc.CallOption) (*UserScores, error) {
+	out := new(UserScores)
+	err := c.cc.Invoke(ctx, PostService_GetCategoryNeighbours_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) PurgeOwner(ctx context.Context, in *GetPostsByOwnerIdRequest, opts ...grpc.CallOption) (*PurgeRes, error) {
+	out := new(PurgeRes)
+	err := c.cc.Invoke(ctx, PostService_PurgeOwner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ExportPosts(ctx context.Context, in *GetPostsByOwnerIdRequest, opts ...grpc.CallOption) (PostService_ExportPostsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PostService_ServiceDesc.Streams[0], PostService_ExportPosts_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &postServiceExportPostsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PostService_ExportPostsClient interface {
+	Recv() (*Post, error)
+	grpc.ClientStream
+}
+
+type postServiceExportPostsClient struct {
+	grpc.ClientStream
+}
+
+func (x *postServiceExportPostsClient) Recv() (*Post, error) {
+	m := new(Post)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *postServiceClient) React(ctx context.Context, in *ReactReq, opts ...grpc.CallOption) (*Reactions, error) {
+	out := new(Reactions)
+	err := c.cc.Invoke(ctx, PostService_React_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) Unreact(ctx context.Context, in *ReactReq, opts ...grpc.CallOption) (*Reactions, error) {
+	out := new(Reactions)
+	err := c.cc.Invoke(ctx, PostService_Unreact_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) ListReactors(ctx context.Context, in *ListReactorsReq, opts ...grpc.CallOption) (*Reactors, error) {
+	out := new(Reactors)
+	err := c.cc.Invoke(ctx, PostService_ListReactors_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetFeed(ctx context.Context, in *GetFeedReq, opts ...grpc.CallOption) (*Feed, error) {
+	out := new(Feed)
+	err := c.cc.Invoke(ctx, PostService_GetFeed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) RebuildFeed(ctx context.Context, in *RebuildFeedReq, opts ...grpc.CallOption) (*RebuildFeedRes, error) {
+	out := new(RebuildFeedRes)
+	err := c.cc.Invoke(ctx, PostService_RebuildFeed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetTrending(ctx context.Context, in *GetTrendingReq, opts ...grpc.CallOption) (*TrendingPosts, error) {
+	out := new(TrendingPosts)
+	err := c.cc.Invoke(ctx, PostService_GetTrending_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetPostsByTag(ctx context.Context, in *PostsByTagReq, opts ...grpc.CallOption) (*Feed, error) {
+	out := new(Feed)
+	err := c.cc.Invoke(ctx, PostService_GetPostsByTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) SearchTags(ctx context.Context, in *SearchTagsReq, opts ...grpc.CallOption) (*Tags, error) {
+	out := new(Tags)
+	err := c.cc.Invoke(ctx, PostService_SearchTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *postServiceClient) GetTag(ctx context.Context, in *TagReq, opts ...grpc.CallOption) (*Tag, error) {
+	out := new(Tag)
+	err := c.cc.Invoke(ctx, PostService_GetTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PostServiceServer is the server API for PostService service.
 // All implementations must embed UnimplementedPostServiceServer
 // for forward compatibility
@@ -111,6 +266,18 @@ type PostServiceServer interface {
 	GetPost(context.Context, *GetRequest) (*PostResponse, error)
 	GetAllPosts(context.Context, *GetAllPostsRequest) (*GetPostsByOwnerIdResponse, error)
 	GetPostsByOwnerId(context.Context, *GetPostsByOwnerIdRequest) (*GetPostsByOwnerIdResponse, error)
+	GetCategoryNeighbours(context.Context, *GetPostsByOwnerIdRequest) (*UserScores, error)
+	PurgeOwner(context.Context, *GetPostsByOwnerIdRequest) (*PurgeRes, error)
+	ExportPosts(*GetPostsByOwnerIdRequest, PostService_ExportPostsServer) error
+	React(context.Context, *ReactReq) (*Reactions, error)
+	Unreact(context.Context, *ReactReq) (*Reactions, error)
+	ListReactors(context.Context, *ListReactorsReq) (*Reactors, error)
+	GetFeed(context.Context, *GetFeedReq) (*Feed, error)
+	RebuildFeed(context.Context, *RebuildFeedReq) (*RebuildFeedRes, error)
+	GetTrending(context.Context, *GetTrendingReq) (*TrendingPosts, error)
+	GetPostsByTag(context.Context, *PostsByTagReq) (*Feed, error)
+	SearchTags(context.Context, *SearchTagsReq) (*Tags, error)
+	GetTag(context.Context, *TagReq) (*Tag, error)
 	mustEmbedUnimplementedPostServiceServer()
 }
 
@@ -136,6 +303,42 @@ func (UnimplementedPostServiceServer) GetAllPosts(context.Context, *GetAllPostsR
 func (UnimplementedPostServiceServer) GetPostsByOwnerId(context.Context, *GetPostsByOwnerIdRequest) (*GetPostsByOwnerIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPostsByOwnerId not implemented")
 }
+func (UnimplementedPostServiceServer) GetCategoryNeighbours(context.Context, *GetPostsByOwnerIdRequest) (*UserScores, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryNeighbours not implemented")
+}
+func (UnimplementedPostServiceServer) PurgeOwner(context.Context, *GetPostsByOwnerIdRequest) (*PurgeRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeOwner not implemented")
+}
+func (UnimplementedPostServiceServer) ExportPosts(*GetPostsByOwnerIdRequest, PostService_ExportPostsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportPosts not implemented")
+}
+func (UnimplementedPostServiceServer) React(context.Context, *ReactReq) (*Reactions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method React not implemented")
+}
+func (UnimplementedPostServiceServer) Unreact(context.Context, *ReactReq) (*Reactions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unreact not implemented")
+}
+func (UnimplementedPostServiceServer) ListReactors(context.Context, *ListReactorsReq) (*Reactors, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReactors not implemented")
+}
+func (UnimplementedPostServiceServer) GetFeed(context.Context, *GetFeedReq) (*Feed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeed not implemented")
+}
+func (UnimplementedPostServiceServer) RebuildFeed(context.Context, *RebuildFeedReq) (*RebuildFeedRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildFeed not implemented")
+}
+func (UnimplementedPostServiceServer) GetTrending(context.Context, *GetTrendingReq) (*TrendingPosts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrending not implemented")
+}
+func (UnimplementedPostServiceServer) GetPostsByTag(context.Context, *PostsByTagReq) (*Feed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostsByTag not implemented")
+}
+func (UnimplementedPostServiceServer) SearchTags(context.Context, *SearchTagsReq) (*Tags, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTags not implemented")
+}
+func (UnimplementedPostServiceServer) GetTag(context.Context, *TagReq) (*Tag, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTag not implemented")
+}
 func (UnimplementedPostServiceServer) mustEmbedUnimplementedPostServiceServer() {}
 
 // UnsafePostServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -257,6 +460,225 @@ func _PostService_GetPostsByOwnerId_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PostService_GetCategoryNeighbours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsByOwnerIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetCategoryNeighbours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetCategoryNeighbours_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetCategoryNeighbours(ctx, req.(*GetPostsByOwnerIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_PurgeOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsByOwnerIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).PurgeOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_PurgeOwner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).PurgeOwner(ctx, req.(*GetPostsByOwnerIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ExportPosts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetPostsByOwnerIdRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PostServiceServer).ExportPosts(m, &postServiceExportPostsServer{stream})
+}
+
+type PostService_ExportPostsServer interface {
+	Send(*Post) error
+	grpc.ServerStream
+}
+
+type postServiceExportPostsServer struct {
+	grpc.ServerStream
+}
+
+func (x *postServiceExportPostsServer) Send(m *Post) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PostService_React_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).React(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_React_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).React(ctx, req.(*ReactReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_Unreact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).Unreact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_Unreact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).Unreact(ctx, req.(*ReactReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_ListReactors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReactorsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).ListReactors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_ListReactors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).ListReactors(ctx, req.(*ListReactorsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetFeed(ctx, req.(*GetFeedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_RebuildFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildFeedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).RebuildFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_RebuildFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).RebuildFeed(ctx, req.(*RebuildFeedReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetTrending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetTrending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetTrending_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetTrending(ctx, req.(*GetTrendingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetPostsByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostsByTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetPostsByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetPostsByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetPostsByTag(ctx, req.(*PostsByTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_SearchTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).SearchTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_SearchTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).SearchTags(ctx, req.(*SearchTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PostService_GetTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PostServiceServer).GetTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PostService_GetTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PostServiceServer).GetTag(ctx, req.(*TagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PostService_ServiceDesc is the grpc.ServiceDesc for PostService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -288,7 +710,57 @@ var PostService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPostsByOwnerId",
 			Handler:    _PostService_GetPostsByOwnerId_Handler,
 		},
+		{
+			MethodName: "GetCategoryNeighbours",
+			Handler:    _PostService_GetCategoryNeighbours_Handler,
+		},
+		{
+			MethodName: "PurgeOwner",
+			Handler:    _PostService_PurgeOwner_Handler,
+		},
+		{
+			MethodName: "React",
+			Handler:    _PostService_React_Handler,
+		},
+		{
+			MethodName: "Unreact",
+			Handler:    _PostService_Unreact_Handler,
+		},
+		{
+			MethodName: "ListReactors",
+			Handler:    _PostService_ListReactors_Handler,
+		},
+		{
+			MethodName: "GetFeed",
+			Handler:    _PostService_GetFeed_Handler,
+		},
+		{
+			MethodName: "RebuildFeed",
+			Handler:    _PostService_RebuildFeed_Handler,
+		},
+		{
+			MethodName: "GetTrending",
+			Handler:    _PostService_GetTrending_Handler,
+		},
+		{
+			MethodName: "GetPostsByTag",
+			Handler:    _PostService_GetPostsByTag_Handler,
+		},
+		{
+			MethodName: "SearchTags",
+			Handler:    _PostService_SearchTags_Handler,
+		},
+		{
+			MethodName: "GetTag",
+			Handler:    _PostService_GetTag_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportPosts",
+			Handler:       _PostService_ExportPosts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "post.proto",
 }
@@ -7,8 +7,14 @@ import (
 	pbu "comment-service/protos/user-service"
 	"comment-service/storage"
 	"context"
+	"database/sql"
+	"errors"
+
+	"shared/auth"
 
 	"github.com/jmoiron/sqlx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	grpcClient "comment-service/service/grpc_client"
 )
@@ -32,7 +38,7 @@ func NewCommentService(db *sqlx.DB, log l.Logger, client grpcClient.IServiceMana
 
 func (s *CommentService) GetAllUsers(ctx context.Context, req *pbc.GetAllCommentsRequest) (*pbc.GetAllCommentsResponse, error) {
 	var response pbc.GetAllCommentsResponse
-	users, err := s.client.UserService().GetAll(ctx, &pbu.GetAllUsersRequest{
+	users, err := s.client.UserService().GetAll(ctx, &pbu.GetAllUsersReq{
 		Page:  req.Page,
 		Limit: req.Limit,
 	})
@@ -44,7 +50,7 @@ func (s *CommentService) GetAllUsers(ctx context.Context, req *pbc.GetAllComment
 	for _, user := range users.AllUsers {
 		userResp := pbc.Users{
 			Id:        user.Id,
-			Name:      user.Name,
+			Name:      user.FirstName,
 			LastName:  user.LastName,
 			Email:     user.Email,
 			Password:  user.Password,
@@ -94,13 +100,21 @@ func (s *CommentService) GetAllUsers(ctx context.Context, req *pbc.GetAllComment
 
 func (s *CommentService) GetPostById(ctx context.Context, req *pbc.GetPostByIdRequest) (*pbc.GetPostByIdResponse, error) {
 	var response pbc.GetPostByIdResponse
+	// post-service does not find a post whose author the caller blocked, was
+	// blocked by or muted, and counts the view only of a post it serves
 	post, err := s.client.PostService().GetPost(ctx, &pbp.GetRequest{
-		PostId: req.PostId,
+		PostId:    req.PostId,
+		ClientIp:  req.ClientIp,
+		CountView: true,
 	})
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
+	hidden, err := s.hiddenUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
 	response.Post = &pbc.Post{
 		Id:        post.Id,
 		UserId:    post.OwnerId,
@@ -111,8 +125,9 @@ func (s *CommentService) GetPostById(ctx context.Context, req *pbc.GetPostByIdRe
 		CreatedAt: post.CreatedAt,
 		UpdatedAt: post.UpdatedAt,
 	}
-	owner, err := s.client.UserService().Get(ctx, &pbu.UserRequest{
-		UserId: post.Owner.Id,
+	owner, err := s.client.UserService().Get(ctx, &pbu.GetUserReq{
+		Field: "id",
+		Value: post.Owner.Id,
 	})
 	if err != nil {
 		s.logger.Error(err.Error())
@@ -121,7 +136,7 @@ func (s *CommentService) GetPostById(ctx context.Context, req *pbc.GetPostByIdRe
 
 	userData := pbc.User{
 		Id:       owner.Id,
-		Name:     owner.Name,
+		Name:     owner.FirstName,
 		LastName: owner.LastName,
 	}
 	response.PostWriter = &userData
@@ -131,6 +146,9 @@ func (s *CommentService) GetPostById(ctx context.Context, req *pbc.GetPostByIdRe
 		return nil, err
 	}
 	for _, comment := range comments {
+		if hidden[comment.OwnerId] {
+			continue
+		}
 		var commentData pbc.Comment
 		commentData.Id = comment.Id
 		commentData.Content = comment.Content
@@ -146,8 +164,9 @@ func (s *CommentService) GetPostById(ctx context.Context, req *pbc.GetPostByIdRe
 
 func (s *CommentService) GetUserById(ctx context.Context, req *pbc.GetUserByIdRequest) (*pbc.GetUserByIdResponse, error) {
 	var response pbc.GetUserByIdResponse
-	owner, err := s.client.UserService().Get(ctx, &pbu.UserRequest{
-		UserId: req.OwnerId,
+	owner, err := s.client.UserService().Get(ctx, &pbu.GetUserReq{
+		Field: "id",
+		Value: req.OwnerId,
 	})
 	if err != nil {
 		s.logger.Error(err.Error())
@@ -156,7 +175,7 @@ func (s *CommentService) GetUserById(ctx context.Context, req *pbc.GetUserByIdRe
 
 	response.OwnerInfo = &pbc.User{
 		Id:        owner.Id,
-		Name:      owner.Name,
+		Name:      owner.FirstName,
 		LastName:  owner.LastName,
 		Email:     owner.Email,
 		Password:  owner.Password,
@@ -185,8 +204,9 @@ func (s *CommentService) GetUserById(ctx context.Context, req *pbc.GetUserByIdRe
 			commentRes.OwnerId = com.OwnerId
 			commentRes.CreatedAt = com.CreatedAt
 			commentRes.UpdatedAt = com.UpdatedAt
-			commentWriterInfo, err := s.client.UserService().Get(ctx, &pbu.UserRequest{
-				UserId: commentRes.OwnerId,
+			commentWriterInfo, err := s.client.UserService().Get(ctx, &pbu.GetUserReq{
+				Field: "id",
+				Value: commentRes.OwnerId,
 			})
 			if err != nil {
 				s.logger.Error(err.Error())
@@ -195,9 +215,9 @@ func (s *CommentService) GetUserById(ctx context.Context, req *pbc.GetUserByIdRe
 
 			commentRes.CommentWriter = &pbc.User{
 				Id:        commentWriterInfo.Id,
-				Name:      commentWriterInfo.Name,
+				Name:      commentWriterInfo.FirstName,
 				LastName:  commentWriterInfo.LastName,
-				Username:  commentWriterInfo.Username,
+				Username:  commentWriterInfo.UserName,
 				Email:     commentWriterInfo.Email,
 				CreatedAt: commentWriterInfo.CreatedAt,
 				UpdatedAt: commentWriterInfo.UpdatedAt,
@@ -221,6 +241,29 @@ func (s *CommentService) GetUserById(ctx context.Context, req *pbc.GetUserByIdRe
 }
 
 func (s *CommentService) CreateComment(ctx context.Context, req *pbc.Comment) (*pbc.Comment, error) {
+	if err := auth.CanModify(ctx, req.OwnerId); err != nil {
+		return nil, err
+	}
+	post, err := s.client.PostService().GetPost(ctx, &pbp.GetRequest{PostId: req.PostId})
+	if status.Code(err) == codes.NotFound {
+		return nil, status.Error(codes.NotFound, "post not found")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	blocked, err := s.client.UserService().IsBlocked(ctx, &pbu.RelationReq{
+		UserId:   post.OwnerId,
+		TargetId: req.OwnerId,
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if blocked.Blocked {
+		return nil, status.Error(codes.PermissionDenied, "the author of the post blocked you")
+	}
+
 	comment, err := s.storage.Comment().CreateCommment(req)
 	if err != nil {
 		s.logger.Error(err.Error())
@@ -230,6 +273,10 @@ func (s *CommentService) CreateComment(ctx context.Context, req *pbc.Comment) (*
 }
 
 func (s *CommentService) UpdateComment(ctx context.Context, req *pbc.Comment) (*pbc.Comment, error) {
+	if err := s.checkOwner(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
 	comment, err := s.storage.Comment().UpdateComment(req)
 	if err != nil {
 		s.logger.Error(err.Error())
@@ -239,6 +286,10 @@ func (s *CommentService) UpdateComment(ctx context.Context, req *pbc.Comment) (*
 }
 
 func (s *CommentService) DeleteComment(ctx context.Context, req *pbc.IdRequst) (*pbc.DeleteResponse, error) {
+	if err := s.checkOwner(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
 	if err := s.storage.Comment().DeleteComment(req.Id); err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
@@ -272,8 +323,20 @@ func (s *CommentService) GetCommentsByPostId(ctx context.Context, req *pbc.IdReq
 		s.logger.Error(err.Error())
 		return nil, err
 	}
+
+	hidden, err := s.hiddenUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	visible := comments[:0]
+	for _, comment := range comments {
+		if !hidden[comment.OwnerId] {
+			visible = append(visible, comment)
+		}
+	}
+
 	return &pbc.GetAllCommentResponse{
-		AllComments: comments,
+		AllComments: visible,
 	}, nil
 }
 
@@ -296,3 +359,92 @@ func (s *CommentService) GetCommentById(ctx context.Context, req *pbc.IdRequst)
 	}
 	return comments, nil
 }
+
+// coCommentersLimit bounds the candidates handed to user-service suggestions
+const coCommentersLimit = 100
+
+// GetCoCommenters is asked by user-service for its suggestions
+func (s *CommentService) GetCoCommenters(ctx context.Context, req *pbc.IdRequst) (*pbc.UserScores, error) {
+	scores, err := s.storage.Comment().CoCommenters(req.Id, coCommentersLimit)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return &pbc.UserScores{Scores: scores}, nil
+}
+
+// PurgeComments is asked by user-service and post-service once an account
+// is removed for good
+func (s *CommentService) PurgeComments(ctx context.Context, req *pbc.PurgeCommentsReq) (*pbc.PurgeRes, error) {
+	if err := auth.RequireService(ctx); err != nil {
+		return nil, err
+	}
+	deleted, err := s.storage.Comment().Purge(req.UserId, req.PostIds)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return &pbc.PurgeRes{Deleted: deleted}, nil
+}
+
+// ExportComments is asked by user-service for a personal data export, it
+// sends the comments one by one so their size is not bound by the message limit
+func (s *CommentService) ExportComments(req *pbc.IdRequst, stream pbc.CommentService_ExportCommentsServer) error {
+	if err := auth.CanModify(stream.Context(), req.Id); err != nil {
+		return err
+	}
+	comments, err := s.storage.Comment().GetAllCommentsByOwnerId(req.Id)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+	for _, comment := range comments {
+		if err := stream.Send(comment); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CountComments is asked by post-service to rank trending posts
+func (s *CommentService) CountComments(ctx context.Context, req *pbc.CountCommentsReq) (*pbc.CommentCounts, error) {
+	counts, err := s.storage.Comment().CountByPosts(req.PostIds)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return &pbc.CommentCounts{Counts: counts}, nil
+}
+
+// checkOwner fails unless the caller wrote the comment or is an admin
+func (s *CommentService) checkOwner(ctx context.Context, commentId string) error {
+	comment, err := s.storage.Comment().GetComment(commentId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "comment not found")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+	return auth.CanModify(ctx, comment.OwnerId)
+}
+
+// hiddenUsers returns the users with a block in either direction with the
+// caller and the ones the caller muted, their comments are left out of lists
+func (s *CommentService) hiddenUsers(ctx context.Context) (map[string]bool, error) {
+	hidden := map[string]bool{}
+	viewerId, _ := auth.Subject(ctx)
+	if viewerId == "" {
+		return hidden, nil
+	}
+
+	res, err := s.client.UserService().GetHiddenUsers(ctx, &pbu.HiddenUsersReq{ViewerId: viewerId})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	for _, id := range append(res.Blocked, res.Muted...) {
+		hidden[id] = true
+	}
+	return hidden, nil
+}
@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	pbc "comment-service/protos/comment-service"
+	pbp "comment-service/protos/post-service"
+
+	"shared/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func asUser(userId string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.UserIdKey, userId, auth.RoleKey, "user"))
+}
+
+func TestCreateComment(t *testing.T) {
+	tests := []struct {
+		name    string
+		caller  string
+		owner   string
+		blocked bool
+		want    codes.Code
+	}{
+		{name: "own comment", caller: "user-2", owner: "user-2", want: codes.OK},
+		{name: "comment for another user", caller: "user-2", owner: "user-3", want: codes.PermissionDenied},
+		{name: "blocked by the author", caller: "user-2", owner: "user-2", blocked: true, want: codes.PermissionDenied},
+		{name: "blocked user names a stranger", caller: "user-2", owner: "user-3", blocked: true, want: codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comments := &fakeCommentRepo{}
+			posts := &fakePostService{posts: map[string]*pbp.PostResponse{
+				"post-1": {Id: "post-1", OwnerId: "user-1"},
+			}}
+			users := &fakeUserService{blocked: map[[2]string]bool{{"user-1", "user-2"}: tt.blocked}}
+			s := newTestService(comments, users, posts)
+
+			_, err := s.CreateComment(asUser(tt.caller), &pbc.Comment{Id: "comment-1", PostId: "post-1", OwnerId: tt.owner, Content: "hi"})
+			if status.Code(err) != tt.want {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+			if stored := len(comments.comments) == 1; stored != (tt.want == codes.OK) {
+				t.Fatalf("stored %d comments", len(comments.comments))
+			}
+		})
+	}
+}
+
+func TestCreateCommentDoesNotCountView(t *testing.T) {
+	posts := &fakePostService{posts: map[string]*pbp.PostResponse{
+		"post-1": {Id: "post-1", OwnerId: "user-1"},
+	}}
+	s := newTestService(&fakeCommentRepo{}, &fakeUserService{}, posts)
+
+	if _, err := s.CreateComment(asUser("user-2"), &pbc.Comment{Id: "comment-1", PostId: "post-1", OwnerId: "user-2", Content: "hi"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(posts.lookups) != 1 || posts.lookups[0].CountView {
+		t.Fatalf("lookups = %v, want one that does not count a view", posts.lookups)
+	}
+}
+
+func TestGetPostByIdLeavesOutHiddenComments(t *testing.T) {
+	comments := &fakeCommentRepo{comments: []*pbc.Comment{
+		{Id: "comment-1", PostId: "post-1", OwnerId: "user-2"},
+		{Id: "comment-2", PostId: "post-1", OwnerId: "user-3"},
+	}}
+	posts := &fakePostService{posts: map[string]*pbp.PostResponse{
+		"post-1": {Id: "post-1", OwnerId: "user-1", Owner: &pbp.Owner{Id: "user-1"}},
+	}}
+	users := &fakeUserService{hidden: map[string][]string{"user-4": {"user-3"}}}
+	s := newTestService(comments, users, posts)
+
+	res, err := s.GetPostById(asUser("user-4"), &pbc.GetPostByIdRequest{PostId: "post-1", ClientIp: "203.0.113.7"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Comments) != 1 || res.Comments[0].Id != "comment-1" {
+		t.Fatalf("comments = %v, want only comment-1", res.Comments)
+	}
+	// post-service leaves out a hidden author's post and counts the view of
+	// the client the gateway saw
+	if len(posts.lookups) != 1 || !posts.lookups[0].CountView || posts.lookups[0].ClientIp != "203.0.113.7" {
+		t.Fatalf("lookups = %v, want one that shows the post to 203.0.113.7", posts.lookups)
+	}
+}
+
+func TestPurgeCommentsNeedsService(t *testing.T) {
+	comments := &fakeCommentRepo{}
+	s := newTestService(comments, &fakeUserService{}, &fakePostService{})
+	req := &pbc.PurgeCommentsReq{UserId: "user-1"}
+
+	for _, ctx := range []context.Context{context.Background(), asUser("user-1")} {
+		if _, err := s.PurgeComments(ctx, req); status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("PurgeComments = %v, want PermissionDenied", err)
+		}
+	}
+	if len(comments.purged) != 0 {
+		t.Fatalf("purged comments of %v without a service call", comments.purged)
+	}
+
+	service := metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.RoleKey, auth.RoleService))
+	if _, err := s.PurgeComments(service, req); err != nil {
+		t.Fatal(err)
+	}
+	if len(comments.purged) != 1 {
+		t.Fatalf("purged comments of %v, want user-1", comments.purged)
+	}
+}
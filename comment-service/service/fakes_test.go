@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	l "comment-service/pkg/logger"
+	pbc "comment-service/protos/comment-service"
+	pbp "comment-service/protos/post-service"
+	pbu "comment-service/protos/user-service"
+	"comment-service/storage/repo"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+type fakeStorage struct {
+	comment repo.CommentStorageI
+}
+
+func (s *fakeStorage) Comment() repo.CommentStorageI { return s.comment }
+
+// fakeCommentRepo keeps the comments it is given, the methods it does not
+// override panic through the nil embedded interface
+type fakeCommentRepo struct {
+	repo.CommentStorageI
+
+	mu       sync.Mutex
+	comments []*pbc.Comment
+	purged   []string
+}
+
+func (r *fakeCommentRepo) CreateCommment(comment *pbc.Comment) (*pbc.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.comments = append(r.comments, proto.Clone(comment).(*pbc.Comment))
+	return proto.Clone(comment).(*pbc.Comment), nil
+}
+
+func (r *fakeCommentRepo) GetAllCommentsByPostId(postId string) ([]*pbc.Comment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var comments []*pbc.Comment
+	for _, c := range r.comments {
+		if c.PostId == postId {
+			comments = append(comments, proto.Clone(c).(*pbc.Comment))
+		}
+	}
+	return comments, nil
+}
+
+// Purge records what it was asked to remove
+func (r *fakeCommentRepo) Purge(userId string, postIds []string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.purged = append(r.purged, userId)
+	return 0, nil
+}
+
+// fakePostService serves posts from a map and records the lookups made
+type fakePostService struct {
+	pbp.PostServiceClient
+
+	mu      sync.Mutex
+	posts   map[string]*pbp.PostResponse
+	lookups []*pbp.GetRequest
+}
+
+func (c *fakePostService) GetPost(ctx context.Context, req *pbp.GetRequest, opts ...grpc.CallOption) (*pbp.PostResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lookups = append(c.lookups, proto.Clone(req).(*pbp.GetRequest))
+	return proto.Clone(c.posts[req.PostId]).(*pbp.PostResponse), nil
+}
+
+// fakeUserService reports the pairs in blocked as blocked and hides the
+// users in hidden from each viewer
+type fakeUserService struct {
+	pbu.UserServiceClient
+
+	blocked map[[2]string]bool
+	hidden  map[string][]string
+}
+
+func (c *fakeUserService) Get(ctx context.Context, req *pbu.GetUserReq, opts ...grpc.CallOption) (*pbu.User, error) {
+	return &pbu.User{Id: req.Value, FirstName: "Name"}, nil
+}
+
+func (c *fakeUserService) GetHiddenUsers(ctx context.Context, req *pbu.HiddenUsersReq, opts ...grpc.CallOption) (*pbu.HiddenUsersRes, error) {
+	return &pbu.HiddenUsersRes{Muted: c.hidden[req.ViewerId]}, nil
+}
+
+func (c *fakeUserService) IsBlocked(ctx context.Context, req *pbu.RelationReq, opts ...grpc.CallOption) (*pbu.IsBlockedRes, error) {
+	return &pbu.IsBlockedRes{Blocked: c.blocked[[2]string{req.UserId, req.TargetId}]}, nil
+}
+
+type fakeServices struct {
+	user *fakeUserService
+	post *fakePostService
+}
+
+func (s *fakeServices) UserService() pbu.UserServiceClient { return s.user }
+func (s *fakeServices) PostService() pbp.PostServiceClient { return s.post }
+
+func newTestService(comments *fakeCommentRepo, users *fakeUserService, posts *fakePostService) *CommentService {
+	return &CommentService{
+		storage: &fakeStorage{comment: comments},
+		logger:  l.New("error", "test"),
+		client:  &fakeServices{user: users, post: posts},
+	}
+}
@@ -6,6 +6,8 @@ import (
 	pbu "comment-service/protos/user-service"
 	"fmt"
 
+	"shared/auth"
+
 	"google.golang.org/grpc"
 )
 
@@ -25,6 +27,8 @@ func New(cfg config.Config) (IServiceManager, error) {
 	connUser, err := grpc.Dial(
 		fmt.Sprintf("%s:%d", cfg.UserServiceHost, cfg.UserServicePort),
 		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(auth.ClientInterceptor(cfg.InternalSecret)),
+		grpc.WithStreamInterceptor(auth.StreamClientInterceptor(cfg.InternalSecret)),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("user service dail host: %s port : %d", cfg.UserServiceHost, cfg.UserServicePort)
@@ -33,6 +37,8 @@ func New(cfg config.Config) (IServiceManager, error) {
 	connPost, err := grpc.Dial(
 		fmt.Sprintf("%s:%d", cfg.PostServiceHost, cfg.PostServicePort),
 		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(auth.ClientInterceptor(cfg.InternalSecret)),
+		grpc.WithStreamInterceptor(auth.StreamClientInterceptor(cfg.InternalSecret)),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("post service dail host: %s port : %d", cfg.PostServiceHost, cfg.PostServicePort)
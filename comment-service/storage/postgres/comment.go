@@ -4,6 +4,7 @@ import (
 	pbc "comment-service/protos/comment-service"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type commentRepo struct {
@@ -258,3 +259,86 @@ func (c *commentRepo) GetCommentsById(id string) (*pbc.Comment, error) {
 
 	return &comment, nil
 }
+
+// CoCommenters returns the users who commented on the same posts as userId,
+// scored by the number of such posts
+func (c *commentRepo) CoCommenters(userId string, limit int64) ([]*pbc.UserScore, error) {
+	query := `
+	SELECT
+		other.user_id,
+		COUNT(DISTINCT other.post_id) AS shared
+	FROM
+		comments mine
+	JOIN
+		comments other ON other.post_id = mine.post_id AND other.user_id <> mine.user_id
+	WHERE
+		mine.user_id = $1
+	GROUP BY
+		other.user_id
+	ORDER BY
+		shared DESC
+	LIMIT $2
+	`
+	rows, err := c.db.Query(query, userId, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var scores []*pbc.UserScore
+	for rows.Next() {
+		var score pbc.UserScore
+		if err := rows.Scan(&score.UserId, &score.Score); err != nil {
+			return nil, err
+		}
+		scores = append(scores, &score)
+	}
+	return scores, rows.Err()
+}
+
+// Purge deletes the comments written by userId and all comments on postIds
+func (c *commentRepo) Purge(userId string, postIds []string) (int64, error) {
+	query := `
+	DELETE FROM
+		comments
+	WHERE
+		user_id = NULLIF($1, '')::UUID
+	OR
+		post_id = ANY($2::UUID[])
+	`
+	res, err := c.db.Exec(query, userId, pq.Array(postIds))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// CountByPosts counts the comments on each of postIds
+func (c *commentRepo) CountByPosts(postIds []string) ([]*pbc.CommentCount, error) {
+	query := `
+	SELECT
+		post_id,
+		COUNT(*)
+	FROM
+		comments
+	WHERE
+		post_id = ANY($1::UUID[])
+	GROUP BY
+		post_id
+	`
+	rows, err := c.db.Query(query, pq.Array(postIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var counts []*pbc.CommentCount
+	for rows.Next() {
+		var count pbc.CommentCount
+		if err := rows.Scan(&count.PostId, &count.Count); err != nil {
+			return nil, err
+		}
+		counts = append(counts, &count)
+	}
+	return counts, rows.Err()
+}
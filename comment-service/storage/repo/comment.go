@@ -14,4 +14,7 @@ type CommentStorageI interface {
 	GetAllCommentsByPostId(postId string) ([]*pbc.Comment, error)
 	GetAllCommentsByOwnerId(ownerId string) ([]*pbc.Comment, error)
 	GetCommentsById(id string) (*pbc.Comment, error)
+	CoCommenters(userId string, limit int64) ([]*pbc.UserScore, error)
+	Purge(userId string, postIds []string) (int64, error)
+	CountByPosts(postIds []string) ([]*pbc.CommentCount, error)
 }
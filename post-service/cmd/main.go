@@ -1,7 +1,12 @@
 package main
 
 import (
+	"context"
 	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
 	"post-service/config"
 	"post-service/pkg/db"
 	"post-service/pkg/logger"
@@ -9,6 +14,8 @@ import (
 	"post-service/service"
 	grpcclient "post-service/service/grpc_client"
 
+	"shared/auth"
+
 	"google.golang.org/grpc"
 )
 
@@ -32,19 +39,48 @@ func main() {
 	if err != nil {
 		log.Fatal("sqlx connection to postgres error", logger.Error(err))
 	}
-	postService := service.NewPostService(connDB, log, grpcClient)
+	postService := service.NewPostService(connDB, log, grpcClient, cfg)
+
+	// the server stops on SIGINT or SIGTERM, views counted until then are
+	// flushed once the last request is done
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	flushCtx, stopFlush := context.WithCancel(context.Background())
+	flushed := make(chan struct{})
+	go func() {
+		defer close(flushed)
+		postService.FlushViews(flushCtx)
+	}()
+	go postService.RankTrending(ctx)
 
 	lis, err := net.Listen("tcp", cfg.RPCPort)
 	if err != nil {
 		log.Fatal("Error while listening: %v", logger.Error(err))
 	}
 
-	s := grpc.NewServer()
+	if cfg.InternalSecret == "" {
+		log.Fatal("INTERNAL_SECRET is not set, the service trusts the user metadata of its callers")
+	}
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(auth.ServerInterceptor(cfg.InternalSecret)),
+		grpc.StreamInterceptor(auth.StreamServerInterceptor(cfg.InternalSecret)),
+	)
 	pb.RegisterPostServiceServer(s, postService)
 	log.Info("main: server running",
 		logger.String("port", cfg.RPCPort))
 
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		<-ctx.Done()
+		log.Info("main: shutting down")
+		s.GracefulStop()
+	}()
+
 	if err := s.Serve(lis); err != nil {
 		log.Fatal("Error while listening: %v", logger.Error(err))
 	}
+	<-stopped
+	stopFlush()
+	<-flushed
 }
@@ -16,11 +16,30 @@ type Config struct {
 	PostgresPassword string
 	LogLevel         string
 	RPCPort          string
+	// shared with the api-gateway and the other services, calls without it
+	// are rejected
+	InternalSecret string
 	// connect fields
 	UserServiceHost    string
 	UserServicePort    int
 	CommentServiceHost string
 	CommentServicePort int
+	// a viewer is counted once per post within ViewWindow minutes, counted
+	// views are written to the database every ViewFlushInterval seconds
+	ViewWindow        int
+	ViewFlushInterval int
+	// posts of authors with more followers than FeedFanoutLimit are read
+	// when a feed is served instead of being copied into every timeline
+	FeedFanoutLimit int
+	// trending posts are ranked every TrendingInterval minutes out of the
+	// posts of the last TrendingMaxAge hours, TrendingGravity sets how fast
+	// a post's score decays with its age. A dislike is worth
+	// TrendingDislikePoints, zero ignores dislikes and less counts them
+	// against the post.
+	TrendingInterval      int
+	TrendingMaxAge        int
+	TrendingGravity       float64
+	TrendingDislikePoints float64
 }
 
 // Load loads environment vars and inflates Config
@@ -43,9 +62,20 @@ func Load() Config {
 	c.CommentServiceHost = cast.ToString(getOrReturnDefault("COMMENT_SERVICE_HOST", "localhost"))
 	c.CommentServicePort = cast.ToInt(getOrReturnDefault("COMMENT_SERVICE_PORT", "3333"))
 
+	c.ViewWindow = cast.ToInt(getOrReturnDefault("VIEW_WINDOW", 30))
+	c.ViewFlushInterval = cast.ToInt(getOrReturnDefault("VIEW_FLUSH_INTERVAL", 10))
+
+	c.FeedFanoutLimit = cast.ToInt(getOrReturnDefault("FEED_FANOUT_LIMIT", 1000))
+
+	c.TrendingInterval = cast.ToInt(getOrReturnDefault("TRENDING_INTERVAL", 10))
+	c.TrendingMaxAge = cast.ToInt(getOrReturnDefault("TRENDING_MAX_AGE", 168))
+	c.TrendingGravity = cast.ToFloat64(getOrReturnDefault("TRENDING_GRAVITY", 1.8))
+	c.TrendingDislikePoints = cast.ToFloat64(getOrReturnDefault("TRENDING_DISLIKE_POINTS", 0))
+
 	c.LogLevel = cast.ToString(getOrReturnDefault("LOG_LEVEL", "debug"))
 
 	c.RPCPort = cast.ToString(getOrReturnDefault("RPC_PORT", ":2222"))
+	c.InternalSecret = cast.ToString(getOrReturnDefault("INTERNAL_SECRET", ""))
 
 	return c
 }
@@ -19,3 +19,7 @@ require (
 	golang.org/x/text v0.14.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240318140521-94a12d6c2237 // indirect
 )
+
+require shared v0.0.0-00010101000000-000000000000
+
+replace shared => ../shared
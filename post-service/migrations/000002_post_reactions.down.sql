@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS post_reactions;
+ALTER TABLE posts
+    ALTER COLUMN likes DROP NOT NULL,
+    ALTER COLUMN likes DROP DEFAULT,
+    ALTER COLUMN dislikes DROP NOT NULL,
+    ALTER COLUMN dislikes DROP DEFAULT;
\ No newline at end of file
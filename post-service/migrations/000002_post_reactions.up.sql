@@ -0,0 +1,18 @@
+-- likes and dislikes are the counts of post_reactions, kept in step with it
+UPDATE posts SET likes = COALESCE(likes, 0), dislikes = COALESCE(dislikes, 0);
+ALTER TABLE posts
+    ALTER COLUMN likes SET DEFAULT 0,
+    ALTER COLUMN likes SET NOT NULL,
+    ALTER COLUMN dislikes SET DEFAULT 0,
+    ALTER COLUMN dislikes SET NOT NULL;
+
+CREATE TABLE post_reactions (
+    post_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    reaction VARCHAR(10) NOT NULL CHECK (reaction IN ('like', 'dislike')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (post_id, user_id)
+);
+
+CREATE INDEX post_reactions_post_created_idx ON post_reactions (post_id, created_at DESC, user_id DESC);
+CREATE INDEX post_reactions_user_idx ON post_reactions (user_id);
@@ -0,0 +1,4 @@
+ALTER TABLE posts
+    ALTER COLUMN views DROP NOT NULL,
+    ALTER COLUMN views DROP DEFAULT,
+    ALTER COLUMN views TYPE INT;
\ No newline at end of file
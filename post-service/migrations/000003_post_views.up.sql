@@ -0,0 +1,6 @@
+-- views are only counted by the service now
+UPDATE posts SET views = COALESCE(views, 0);
+ALTER TABLE posts
+    ALTER COLUMN views TYPE BIGINT,
+    ALTER COLUMN views SET DEFAULT 0,
+    ALTER COLUMN views SET NOT NULL;
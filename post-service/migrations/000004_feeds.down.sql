@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS posts_owner_created_idx;
+DROP TABLE IF EXISTS fanin_authors;
+DROP TABLE IF EXISTS timelines;
\ No newline at end of file
@@ -0,0 +1,20 @@
+-- timelines hold the posts copied to each follower when they are written.
+-- Authors with too many followers are listed in fanin_authors instead, their
+-- posts are read from posts when a feed is served.
+CREATE TABLE timelines (
+    user_id UUID NOT NULL,
+    post_id UUID NOT NULL,
+    author_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, post_id)
+);
+
+CREATE INDEX timelines_user_created_idx ON timelines (user_id, created_at DESC, post_id DESC);
+CREATE INDEX timelines_author_idx ON timelines (author_id);
+
+CREATE TABLE fanin_authors (
+    author_id UUID PRIMARY KEY,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX posts_owner_created_idx ON posts (owner_id, created_at DESC, id DESC);
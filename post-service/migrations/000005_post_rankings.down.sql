@@ -0,0 +1 @@
+DROP TABLE IF EXISTS post_rankings;
\ No newline at end of file
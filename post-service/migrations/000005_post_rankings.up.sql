@@ -0,0 +1,12 @@
+-- post_rankings holds the trending scores of the last ranking run, the job
+-- replaces the whole table every time
+CREATE TABLE post_rankings (
+    post_id UUID PRIMARY KEY,
+    category VARCHAR(100),
+    score DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    ranked_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX post_rankings_score_idx ON post_rankings (score DESC);
+CREATE INDEX post_rankings_category_score_idx ON post_rankings (category, score DESC);
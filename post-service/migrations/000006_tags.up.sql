@@ -0,0 +1,18 @@
+-- hashtags parsed from the post contents, post_tags keeps the post's
+-- created_at so tag pages are read from the index alone
+CREATE TABLE tags (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX tags_name_prefix_idx ON tags (name text_pattern_ops);
+
+CREATE TABLE post_tags (
+    post_id UUID NOT NULL,
+    tag_id INT NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (post_id, tag_id)
+);
+
+CREATE INDEX post_tags_tag_created_idx ON post_tags (tag_id, created_at DESC, post_id DESC);
@@ -0,0 +1,70 @@
+package hashtag
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// MaxLength is the longest tag kept, longer ones are ignored
+	MaxLength = 100
+	// MaxPerPost bounds how many tags one post is filed under
+	MaxPerPost = 30
+)
+
+// a tag starts after the beginning of the text or a character that can not
+// be part of a word, so "a#b" and "&#39;" are not tags
+var pattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_#&])#([\p{L}\p{N}_]+)`)
+
+// links are left out before looking for tags, so the fragment of
+// "https://example.com/#top" is not one
+var linkPattern = regexp.MustCompile(`(?i)\b(?:[a-z][a-z0-9+.-]*://|www\.)\S+`)
+
+// Extract returns the distinct hashtags of the text, lowercased and in the
+// order they first appear. Tags need at least one letter, "#1" is not one.
+func Extract(text string) []string {
+	tags := []string{}
+	seen := map[string]bool{}
+	text = linkPattern.ReplaceAllString(text, " ")
+	for _, m := range pattern.FindAllStringSubmatch(text, -1) {
+		tag := Normalize(m[1])
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+		if len(tags) == MaxPerPost {
+			break
+		}
+	}
+	return tags
+}
+
+// Normalize lowercases a tag and strips a leading #, it returns "" for
+// anything that is not a valid tag
+func Normalize(tag string) string {
+	tag = NormalizePrefix(tag)
+	for _, r := range tag {
+		if unicode.IsLetter(r) {
+			return tag
+		}
+	}
+	return ""
+}
+
+// NormalizePrefix is Normalize for the start of a tag being typed, which may
+// not have its letter yet
+func NormalizePrefix(prefix string) string {
+	prefix = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(prefix), "#"))
+	if prefix == "" || utf8.RuneCountInString(prefix) > MaxLength {
+		return ""
+	}
+	for _, r := range prefix {
+		if !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '_' {
+			return ""
+		}
+	}
+	return prefix
+}
@@ -0,0 +1,83 @@
+package hashtag
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"none", "no tags here", []string{}},
+		{"start and middle", "#go is fun, #golang too", []string{"go", "golang"}},
+		{"unicode letters", "#café #Привет #東京 #straße", []string{"café", "привет", "東京", "straße"}},
+		{"digits and underscores", "#go_1 #web3 #2024_recap", []string{"go_1", "web3", "2024_recap"}},
+		{"trailing punctuation", "#go. #rust, #zig! #c? (#lua) #js: #ts;", []string{"go", "rust", "zig", "c", "lua", "js", "ts"}},
+		{"hyphen ends a tag", "#well-known", []string{"well"}},
+		{"apostrophe ends a tag", "#go's", []string{"go"}},
+		{"duplicates keep the first", "#go #rust #go #GO", []string{"go", "rust"}},
+		{"case folding", "#GoLang #GOLANG #golang", []string{"golang"}},
+		{"unicode case folding", "#ÉTÉ #été", []string{"été"}},
+		{"needs a letter", "#1 #2024 #_ #1a", []string{"1a"}},
+		{"inside a word", "a#b c#d", []string{}},
+		{"html entity", "it&#39;s", []string{}},
+		{"double hash", "##go", []string{}},
+		{"newlines", "line\n#go\n#rust", []string{"go", "rust"}},
+		{"url fragment", "see https://example.com/page#section", []string{}},
+		{"url fragment after a slash", "see https://example.com/#section and #go", []string{"go"}},
+		{"url without scheme", "www.example.com/#top", []string{}},
+		{"url query", "http://example.com/?q=#tag", []string{}},
+		{"tag right after a url", "https://example.com #go", []string{"go"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Extract(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Extract(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractLimits(t *testing.T) {
+	long := strings.Repeat("a", MaxLength)
+	if got := Extract("#" + long); !reflect.DeepEqual(got, []string{long}) {
+		t.Fatalf("a tag of MaxLength was not kept: %q", got)
+	}
+	if got := Extract("#" + long + "a #go"); !reflect.DeepEqual(got, []string{"go"}) {
+		t.Fatalf("a tag over MaxLength was kept: %q", got)
+	}
+
+	var text []string
+	for i := 0; i < MaxPerPost+5; i++ {
+		text = append(text, fmt.Sprintf("#tag%d", i))
+	}
+	got := Extract(strings.Join(text, " "))
+	if len(got) != MaxPerPost || got[0] != "tag0" || got[MaxPerPost-1] != fmt.Sprintf("tag%d", MaxPerPost-1) {
+		t.Fatalf("Extract kept %d tags: %q", len(got), got)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := map[string]string{
+		"#Go":     "go",
+		" go ":    "go",
+		"ÉTÉ":     "été",
+		"#":       "",
+		"123":     "",
+		"go-lang": "",
+		"go lang": "",
+		"#web3":   "web3",
+		"##go":    "",
+		"":        "",
+	}
+	for in, want := range tests {
+		if got := Normalize(in); got != want {
+			t.Errorf("Normalize(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -19,26 +19,100 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	UserService_Create_FullMethodName         = "/user.UserService/Create"
-	UserService_Update_FullMethodName         = "/user.UserService/Update"
-	UserService_Delete_FullMethodName         = "/user.UserService/Delete"
-	UserService_Get_FullMethodName            = "/user.UserService/Get"
-	UserService_GetAll_FullMethodName         = "/user.UserService/GetAll"
-	UserService_CheckUniques_FullMethodName   = "/user.UserService/CheckUniques"
-	UserService_GetUserByEmail_FullMethodName = "/user.UserService/GetUserByEmail"
+	UserService_Register_FullMethodName              = "/user.UserService/Register"
+	UserService_Login_FullMethodName                 = "/user.UserService/Login"
+	UserService_Authorization_FullMethodName         = "/user.UserService/Authorization"
+	UserService_Create_FullMethodName                = "/user.UserService/Create"
+	UserService_Update_FullMethodName                = "/user.UserService/Update"
+	UserService_UpdatePassword_FullMethodName        = "/user.UserService/UpdatePassword"
+	UserService_ForgotPassword_FullMethodName        = "/user.UserService/ForgotPassword"
+	UserService_ResetPassword_FullMethodName         = "/user.UserService/ResetPassword"
+	UserService_RequestEmailChange_FullMethodName    = "/user.UserService/RequestEmailChange"
+	UserService_ConfirmEmailChange_FullMethodName    = "/user.UserService/ConfirmEmailChange"
+	UserService_Delete_FullMethodName                = "/user.UserService/Delete"
+	UserService_Get_FullMethodName                   = "/user.UserService/Get"
+	UserService_GetAll_FullMethodName                = "/user.UserService/GetAll"
+	UserService_SearchUsers_FullMethodName           = "/user.UserService/SearchUsers"
+	UserService_CheckUniques_FullMethodName          = "/user.UserService/CheckUniques"
+	UserService_UnlockUser_FullMethodName            = "/user.UserService/UnlockUser"
+	UserService_Follow_FullMethodName                = "/user.UserService/Follow"
+	UserService_Unfollow_FullMethodName              = "/user.UserService/Unfollow"
+	UserService_IsFollowing_FullMethodName           = "/user.UserService/IsFollowing"
+	UserService_ListFollowers_FullMethodName         = "/user.UserService/ListFollowers"
+	UserService_ListFollowing_FullMethodName         = "/user.UserService/ListFollowing"
+	UserService_SetPrivacy_FullMethodName            = "/user.UserService/SetPrivacy"
+	UserService_ApproveFollowRequest_FullMethodName  = "/user.UserService/ApproveFollowRequest"
+	UserService_RejectFollowRequest_FullMethodName   = "/user.UserService/RejectFollowRequest"
+	UserService_ListFollowRequests_FullMethodName    = "/user.UserService/ListFollowRequests"
+	UserService_FilterVisibleOwners_FullMethodName   = "/user.UserService/FilterVisibleOwners"
+	UserService_GetFollowerIds_FullMethodName        = "/user.UserService/GetFollowerIds"
+	UserService_GetFolloweeIds_FullMethodName        = "/user.UserService/GetFolloweeIds"
+	UserService_SuggestUsers_FullMethodName          = "/user.UserService/SuggestUsers"
+	UserService_ExportUserData_FullMethodName        = "/user.UserService/ExportUserData"
+	UserService_Block_FullMethodName                 = "/user.UserService/Block"
+	UserService_Unblock_FullMethodName               = "/user.UserService/Unblock"
+	UserService_Mute_FullMethodName                  = "/user.UserService/Mute"
+	UserService_Unmute_FullMethodName                = "/user.UserService/Unmute"
+	UserService_IsBlocked_FullMethodName             = "/user.UserService/IsBlocked"
+	UserService_GetHiddenUsers_FullMethodName        = "/user.UserService/GetHiddenUsers"
+	UserService_SaveRefreshToken_FullMethodName      = "/user.UserService/SaveRefreshToken"
+	UserService_RotateRefreshToken_FullMethodName    = "/user.UserService/RotateRefreshToken"
+	UserService_RevokeRefreshToken_FullMethodName    = "/user.UserService/RevokeRefreshToken"
+	UserService_EnrollTotp_FullMethodName            = "/user.UserService/EnrollTotp"
+	UserService_ConfirmTotp_FullMethodName           = "/user.UserService/ConfirmTotp"
+	UserService_GenerateRecoveryCodes_FullMethodName = "/user.UserService/GenerateRecoveryCodes"
+	UserService_DisableTotp_FullMethodName           = "/user.UserService/DisableTotp"
+	UserService_VerifyLoginChallenge_FullMethodName  = "/user.UserService/VerifyLoginChallenge"
 )
 
 // UserServiceClient is the client API for UserService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UserServiceClient interface {
-	Create(ctx context.Context, in *User, opts ...grpc.CallOption) (*User, error)
-	Update(ctx context.Context, in *User, opts ...grpc.CallOption) (*User, error)
-	Delete(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*CheckUniquesResponse, error)
-	Get(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*User, error)
-	GetAll(ctx context.Context, in *GetAllUsersRequest, opts ...grpc.CallOption) (*GetAllUsersResponse, error)
-	CheckUniques(ctx context.Context, in *CheckUniquesRequest, opts ...grpc.CallOption) (*CheckUniquesResponse, error)
-	GetUserByEmail(ctx context.Context, in *ByEmail, opts ...grpc.CallOption) (*User, error)
+	Register(ctx context.Context, in *CreateUserReq, opts ...grpc.CallOption) (*RegisterRes, error)
+	Login(ctx context.Context, in *LoginUserReq, opts ...grpc.CallOption) (*AuthRes, error)
+	Authorization(ctx context.Context, in *AuthUser, opts ...grpc.CallOption) (*AuthRes, error)
+	Create(ctx context.Context, in *CreateUserReq, opts ...grpc.CallOption) (*User, error)
+	Update(ctx context.Context, in *UpdateUserReq, opts ...grpc.CallOption) (*User, error)
+	UpdatePassword(ctx context.Context, in *UpdatePasswordReq, opts ...grpc.CallOption) (*User, error)
+	ForgotPassword(ctx context.Context, in *ForgotPasswordReq, opts ...grpc.CallOption) (*Status, error)
+	ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*Status, error)
+	RequestEmailChange(ctx context.Context, in *EmailChangeReq, opts ...grpc.CallOption) (*Status, error)
+	ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeReq, opts ...grpc.CallOption) (*EmailChangeStatus, error)
+	Delete(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*Status, error)
+	Get(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*User, error)
+	GetAll(ctx context.Context, in *GetAllUsersReq, opts ...grpc.CallOption) (*GetAllUsersRes, error)
+	SearchUsers(ctx context.Context, in *SearchUsersReq, opts ...grpc.CallOption) (*GetAllUsersRes, error)
+	CheckUniques(ctx context.Context, in *CheckUniqReq, opts ...grpc.CallOption) (*CheckUniqResp, error)
+	UnlockUser(ctx context.Context, in *UnlockUserReq, opts ...grpc.CallOption) (*Status, error)
+	Follow(ctx context.Context, in *FollowReq, opts ...grpc.CallOption) (*Status, error)
+	Unfollow(ctx context.Context, in *FollowReq, opts ...grpc.CallOption) (*Status, error)
+	IsFollowing(ctx context.Context, in *FollowReq, opts ...grpc.CallOption) (*IsFollowingRes, error)
+	ListFollowers(ctx context.Context, in *FollowListReq, opts ...grpc.CallOption) (*FollowListRes, error)
+	ListFollowing(ctx context.Context, in *FollowListReq, opts ...grpc.CallOption) (*FollowListRes, error)
+	SetPrivacy(ctx context.Context, in *SetPrivacyReq, opts ...grpc.CallOption) (*User, error)
+	ApproveFollowRequest(ctx context.Context, in *FollowReq, opts ...grpc.CallOption) (*Status, error)
+	RejectFollowRequest(ctx context.Context, in *FollowReq, opts ...grpc.CallOption) (*Status, error)
+	ListFollowRequests(ctx context.Context, in *FollowListReq, opts ...grpc.CallOption) (*FollowListRes, error)
+	FilterVisibleOwners(ctx context.Context, in *VisibleOwnersReq, opts ...grpc.CallOption) (*VisibleOwnersRes, error)
+	GetFollowerIds(ctx context.Context, in *FollowIdsReq, opts ...grpc.CallOption) (*FollowIds, error)
+	GetFolloweeIds(ctx context.Context, in *FollowIdsReq, opts ...grpc.CallOption) (*FollowIds, error)
+	SuggestUsers(ctx context.Context, in *SuggestUsersReq, opts ...grpc.CallOption) (*SuggestUsersRes, error)
+	ExportUserData(ctx context.Context, in *ExportUserDataReq, opts ...grpc.CallOption) (UserService_ExportUserDataClient, error)
+	Block(ctx context.Context, in *RelationReq, opts ...grpc.CallOption) (*Status, error)
+	Unblock(ctx context.Context, in *RelationReq, opts ...grpc.CallOption) (*Status, error)
+	Mute(ctx context.Context, in *RelationReq, opts ...grpc.CallOption) (*Status, error)
+	Unmute(ctx context.Context, in *RelationReq, opts ...grpc.CallOption) (*Status, error)
+	IsBlocked(ctx context.Context, in *RelationReq, opts ...grpc.CallOption) (*IsBlockedRes, error)
+	GetHiddenUsers(ctx context.Context, in *HiddenUsersReq, opts ...grpc.CallOption) (*HiddenUsersRes, error)
+	SaveRefreshToken(ctx context.Context, in *RefreshToken, opts ...grpc.CallOption) (*Status, error)
+	RotateRefreshToken(ctx context.Context, in *RotateRefreshTokenReq, opts ...grpc.CallOption) (*AuthRes, error)
+	RevokeRefreshToken(ctx context.Context, in *RefreshToken, opts ...grpc.CallOption) (*Status, error)
+	EnrollTotp(ctx context.Context, in *TotpReq, opts ...grpc.CallOption) (*TotpEnrollment, error)
+	ConfirmTotp(ctx context.Context, in *TotpReq, opts ...grpc.CallOption) (*RecoveryCodes, error)
+	GenerateRecoveryCodes(ctx context.Context, in *TotpReq, opts ...grpc.CallOption) (*RecoveryCodes, error)
+	DisableTotp(ctx context.Context, in *TotpReq, opts ...grpc.CallOption) (*Status, error)
+	VerifyLoginChallenge(ctx context.Context, in *LoginChallengeReq, opts ...grpc.CallOption) (*AuthRes, error)
 }
 
 type userServiceClient struct {
@@ -49,7 +123,34 @@ func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
 	return &userServiceClient{cc}
 }
 
-func (c *userServiceClient) Create(ctx context.Context, in *User, opts ...grpc.CallOption) (*User, error) {
+func (c *userServiceClient) Register(ctx context.Context, in *CreateUserReq, opts ...grpc.CallOption) (*RegisterRes, error) {
+	out := new(RegisterRes)
+	err := c.cc.Invoke(ctx, UserService_Register_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Login(ctx context.Context, in *LoginUserReq, opts ...grpc.CallOption) (*AuthRes, error) {
+	out := new(AuthRes)
+	err := c.cc.Invoke(ctx, UserService_Login_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Authorization(ctx context.Context, in *AuthUser, opts ...grpc.CallOption) (*AuthRes, error) {
+	out := new(AuthRes)
+	err := c.cc.Invoke(ctx, UserService_Authorization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Create(ctx context.Context, in *CreateUserReq, opts ...grpc.CallOption) (*User, error) {
 	out := new(User)
 	err := c.cc.Invoke(ctx, UserService_Create_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -58,234 +159,1380 @@ func (c *userServiceClient) Create(ctx context.Context, in *User, opts ...grpc.C
 	return out, nil
 }
 
-func (c *userServiceClient) Update(ctx context.Context, in *User, opts ...grpc.CallOption) (*User, error) {
+func (c *userServiceClient) Update(ctx context.Context, in *UpdateUserReq, opts ...grpc.CallOption) (*User, error) {
 	out := new(User)
 	err := c.cc.Invoke(ctx, UserService_Update_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *userServiceClient) UpdatePassword(ctx context.Context, in *UpdatePasswordReq, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_UpdatePassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ForgotPassword(ctx context.Context, in *ForgotPasswordReq, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, UserService_ForgotPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, UserService_ResetPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RequestEmailChange(ctx context.Context, in *EmailChangeReq, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, UserService_RequestEmailChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeReq, opts ...grpc.CallOption) (*EmailChangeStatus, error) {
+	out := new(EmailChangeStatus)
+	err := c.cc.Invoke(ctx, UserService_ConfirmEmailChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Delete(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, UserService_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Get(ctx context.Context, in *GetUserReq, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_Get_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetAll(ctx context.Context, in *GetAllUsersReq, opts ...grpc.CallOption) (*GetAllUsersRes, error) {
+	out := new(GetAllUsersRes)
+	err := c.cc.Invoke(ctx, UserService_GetAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SearchUsers(ctx context.Context, in *SearchUsersReq, opts ...grpc.CallOption) (*GetAllUsersRes, error) {
+	out := new(GetAllUsersRes)
+	err := c.cc.Invoke(ctx, UserService_SearchUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CheckUniques(ctx context.Context, in *CheckUniqReq, opts ...grpc.CallOption) (*CheckUniqResp, error) {
+	out := new(CheckUniqResp)
+	err := c.cc.Invoke(ctx, UserService_CheckUniques_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnlockUser(ctx context.Context, in *UnlockUserReq, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, UserService_UnlockUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Follow(ctx context.Context, in *FollowReq, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, UserService_Follow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Unfollow(ctx context.Context, in *FollowReq, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, UserService_Unfollow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) IsFollowing(ctx context.Context, in *FollowReq, opts ...grpc.CallOption) (*IsFollowingRes, error) {
+	out := new(IsFollowingRes)
+	err := c.cc.Invoke(ctx, UserService_IsFollowing_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListFollowers(ctx context.Context, in *FollowListReq, opts ...grpc.CallOption) (*FollowListRes, error) {
+	out := new(FollowListRes)
+	err := c.cc.Invoke(ctx, UserService_ListFollowers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListFollowing(ctx context.Context, in *FollowListReq, opts ...grpc.CallOption) (*FollowListRes, error) {
+	out := new(FollowListRes)
+	err := c.cc.Invoke(ctx, UserService_ListFollowing_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetPrivacy(ctx context.Context, in *SetPrivacyReq, opts ...grpc.CallOption) (*User, error) {
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_SetPrivacy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ApproveFollowRequest(ctx context.Context, in *FollowReq, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, UserService_ApproveFollowRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RejectFollowRequest(ctx context.Context, in *FollowReq, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, UserService_RejectFollowRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListFollowRequests(ctx context.Context, in *FollowListReq, opts ...grpc.CallOption) (*FollowListRes, error) {
+	out := new(FollowListRes)
+	err := c.cc.Invoke(ctx, UserService_ListFollowRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) FilterVisibleOwners(ctx context.Context, in *VisibleOwnersReq, opts ...grpc.CallOption) (*VisibleOwnersRes, error) {
+	out := new(VisibleOwnersRes)
+	err := c.cc.Invoke(ctx, UserService_FilterVisibleOwners_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetFollowerIds(ctx context.Context, in *FollowIdsReq, opts ...grpc.CallOption) (*FollowIds, error) {
+	out := new(FollowIds)
+	err := c.cc.Invoke(ctx, UserService_GetFollowerIds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetFolloweeIds(ctx context.Context, in *FollowIdsReq, opts ...grpc.CallOption) (*FollowIds, error) {
+	out := new(FollowIds)
+	err := c.cc.Invoke(ctx, UserService_GetFolloweeIds_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SuggestUsers(ctx context.Context, in *SuggestUsersReq, opts ...grpc.CallOption) (*SuggestUsersRes, error) {
+	out := new(SuggestUsersRes)
+	err := c.cc.Invoke(ctx, UserService_SuggestUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataReq, opts ...grpc.CallOption) (UserService_ExportUserDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &UserService_ServiceDesc.Streams[0], UserService_ExportUserData_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userServiceExportUserDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserService_ExportUserDataClient interface {
+	Recv() (*ExportChunk, error)
+	grpc.ClientStream
+}
+
+type userServiceExportUserDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceExportUserDataClient) Recv() (*ExportChunk, error) {
+	m := new(ExportChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userServiceClient) Block(ctx context.Context, in *RelationReq, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, UserService_Block_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Unblock(ctx context.Context, in *RelationReq, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, UserService_Unblock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Mute(ctx context.Context, in *RelationReq, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, UserService_Mute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Unmute(ctx context.Context, in *RelationReq, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, UserService_Unmute_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) IsBlocked(ctx context.Context, in *RelationReq, opts ...grpc.CallOption) (*IsBlockedRes, error) {
+	out := new(IsBlockedRes)
+	err := c.cc.Invoke(ctx, UserService_IsBlocked_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetHiddenUsers(ctx context.Context, in *HiddenUsersReq, opts ...grpc.CallOption) (*HiddenUsersRes, error) {
+	out := new(HiddenUsersRes)
+	err := c.cc.Invoke(ctx, UserService_GetHiddenUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SaveRefreshToken(ctx context.Context, in *RefreshToken, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, UserService_SaveRefreshToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RotateRefreshToken(ctx context.Context, in *RotateRefreshTokenReq, opts ...grpc.CallOption) (*AuthRes, error) {
+	out := new(AuthRes)
+	err := c.cc.Invoke(ctx, UserService_RotateRefreshToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeRefreshToken(ctx context.Context, in *RefreshToken, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, UserService_RevokeRefreshToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) EnrollTotp(ctx context.Context, in *TotpReq, opts ...grpc.CallOption) (*TotpEnrollment, error) {
+	out := new(TotpEnrollment)
+	err := c.cc.Invoke(ctx, UserService_EnrollTotp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConfirmTotp(ctx context.Context, in *TotpReq, opts ...grpc.CallOption) (*RecoveryCodes, error) {
+	out := new(RecoveryCodes)
+	err := c.cc.Invoke(ctx, UserService_ConfirmTotp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GenerateRecoveryCodes(ctx context.Context, in *TotpReq, opts ...grpc.CallOption) (*RecoveryCodes, error) {
+	out := new(RecoveryCodes)
+	err := c.cc.Invoke(ctx, UserService_GenerateRecoveryCodes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DisableTotp(ctx context.Context, in *TotpReq, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, UserService_DisableTotp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) VerifyLoginChallenge(ctx context.Context, in *LoginChallengeReq, opts ...grpc.CallOption) (*AuthRes, error) {
+	out := new(AuthRes)
+	err := c.cc.Invoke(ctx, UserService_VerifyLoginChallenge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServiceServer is the server API for UserService service.
+// All implementations must embed UnimplementedUserServiceServer
+// for forward compatibility
+type UserServiceServer interface {
+	Register(context.Context, *CreateUserReq) (*RegisterRes, error)
+	Login(context.Context, *LoginUserReq) (*AuthRes, error)
+	Authorization(context.Context, *AuthUser) (*AuthRes, error)
+	Create(context.Context, *CreateUserReq) (*User, error)
+	Update(context.Context, *UpdateUserReq) (*User, error)
+	UpdatePassword(context.Context, *UpdatePasswordReq) (*User, error)
+	ForgotPassword(context.Context, *ForgotPasswordReq) (*Status, error)
+	ResetPassword(context.Context, *ResetPasswordReq) (*Status, error)
+	RequestEmailChange(context.Context, *EmailChangeReq) (*Status, error)
+	ConfirmEmailChange(context.Context, *ConfirmEmailChangeReq) (*EmailChangeStatus, error)
+	Delete(context.Context, *DeleteUserReq) (*Status, error)
+	Get(context.Context, *GetUserReq) (*User, error)
+	GetAll(context.Context, *GetAllUsersReq) (*GetAllUsersRes, error)
+	SearchUsers(context.Context, *SearchUsersReq) (*GetAllUsersRes, error)
+	CheckUniques(context.Context, *CheckUniqReq) (*CheckUniqResp, error)
+	UnlockUser(context.Context, *UnlockUserReq) (*Status, error)
+	Follow(context.Context, *FollowReq) (*Status, error)
+	Unfollow(context.Context, *FollowReq) (*Status, error)
+	IsFollowing(context.Context, *FollowReq) (*IsFollowingRes, error)
+	ListFollowers(context.Context, *FollowListReq) (*FollowListRes, error)
+	ListFollowing(context.Context, *FollowListReq) (*FollowListRes, error)
+	SetPrivacy(context.Context, *SetPrivacyReq) (*User, error)
+	ApproveFollowRequest(context.Context, *FollowReq) (*Status, error)
+	RejectFollowRequest(context.Context, *FollowReq) (*Status, error)
+	ListFollowRequests(context.Context, *FollowListReq) (*FollowListRes, error)
+	FilterVisibleOwners(context.Context, *VisibleOwnersReq) (*VisibleOwnersRes, error)
+	GetFollowerIds(context.Context, *FollowIdsReq) (*FollowIds, error)
+	GetFolloweeIds(context.Context, *FollowIdsReq) (*FollowIds, error)
+	SuggestUsers(context.Context, *SuggestUsersReq) (*SuggestUsersRes, error)
+	ExportUserData(*ExportUserDataReq, UserService_ExportUserDataServer) error
+	Block(context.Context, *RelationReq) (*Status, error)
+	Unblock(context.Context, *RelationReq) (*Status, error)
+	Mute(context.Context, *RelationReq) (*Status, error)
+	Unmute(context.Context, *RelationReq) (*Status, error)
+	IsBlocked(context.Context, *RelationReq) (*IsBlockedRes, error)
+	GetHiddenUsers(context.Context, *HiddenUsersReq) (*HiddenUsersRes, error)
+	SaveRefreshToken(context.Context, *RefreshToken) (*Status, error)
+	RotateRefreshToken(context.Context, *RotateRefreshTokenReq) (*AuthRes, error)
+	RevokeRefreshToken(context.Context, *RefreshToken) (*Status, error)
+	EnrollTotp(context.Context, *TotpReq) (*TotpEnrollment, error)
+	ConfirmTotp(context.Context, *TotpReq) (*RecoveryCodes, error)
+	GenerateRecoveryCodes(context.Context, *TotpReq) (*RecoveryCodes, error)
+	DisableTotp(context.Context, *TotpReq) (*Status, error)
+	VerifyLoginChallenge(context.Context, *LoginChallengeReq) (*AuthRes, error)
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+// UnimplementedUserServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedUserServiceServer struct {
+}
+
+func (UnimplementedUserServiceServer) Register(context.Context, *CreateUserReq) (*RegisterRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedUserServiceServer) Login(context.Context, *LoginUserReq) (*AuthRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedUserServiceServer) Authorization(context.Context, *AuthUser) (*AuthRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authorization not implemented")
+}
+func (UnimplementedUserServiceServer) Create(context.Context, *CreateUserReq) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedUserServiceServer) Update(context.Context, *UpdateUserReq) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedUserServiceServer) UpdatePassword(context.Context, *UpdatePasswordReq) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePassword not implemented")
+}
+func (UnimplementedUserServiceServer) ForgotPassword(context.Context, *ForgotPasswordReq) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForgotPassword not implemented")
+}
+func (UnimplementedUserServiceServer) ResetPassword(context.Context, *ResetPasswordReq) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedUserServiceServer) RequestEmailChange(context.Context, *EmailChangeReq) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEmailChange not implemented")
+}
+func (UnimplementedUserServiceServer) ConfirmEmailChange(context.Context, *ConfirmEmailChangeReq) (*EmailChangeStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmEmailChange not implemented")
+}
+func (UnimplementedUserServiceServer) Delete(context.Context, *DeleteUserReq) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedUserServiceServer) Get(context.Context, *GetUserReq) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedUserServiceServer) GetAll(context.Context, *GetAllUsersReq) (*GetAllUsersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
+}
+func (UnimplementedUserServiceServer) SearchUsers(context.Context, *SearchUsersReq) (*GetAllUsersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (UnimplementedUserServiceServer) CheckUniques(context.Context, *CheckUniqReq) (*CheckUniqResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckUniques not implemented")
+}
+func (UnimplementedUserServiceServer) UnlockUser(context.Context, *UnlockUserReq) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUser not implemented")
+}
+func (UnimplementedUserServiceServer) Follow(context.Context, *FollowReq) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Follow not implemented")
+}
+func (UnimplementedUserServiceServer) Unfollow(context.Context, *FollowReq) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unfollow not implemented")
+}
+func (UnimplementedUserServiceServer) IsFollowing(context.Context, *FollowReq) (*IsFollowingRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsFollowing not implemented")
+}
+func (UnimplementedUserServiceServer) ListFollowers(context.Context, *FollowListReq) (*FollowListRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowers not implemented")
+}
+func (UnimplementedUserServiceServer) ListFollowing(context.Context, *FollowListReq) (*FollowListRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowing not implemented")
+}
+func (UnimplementedUserServiceServer) SetPrivacy(context.Context, *SetPrivacyReq) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrivacy not implemented")
+}
+func (UnimplementedUserServiceServer) ApproveFollowRequest(context.Context, *FollowReq) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFollowRequest not implemented")
+}
+func (UnimplementedUserServiceServer) RejectFollowRequest(context.Context, *FollowReq) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFollowRequest not implemented")
+}
+func (UnimplementedUserServiceServer) ListFollowRequests(context.Context, *FollowListReq) (*FollowListRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowRequests not implemented")
+}
+func (UnimplementedUserServiceServer) FilterVisibleOwners(context.Context, *VisibleOwnersReq) (*VisibleOwnersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FilterVisibleOwners not implemented")
+}
+func (UnimplementedUserServiceServer) GetFollowerIds(context.Context, *FollowIdsReq) (*FollowIds, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowerIds not implemented")
+}
+func (UnimplementedUserServiceServer) GetFolloweeIds(context.Context, *FollowIdsReq) (*FollowIds, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFolloweeIds not implemented")
+}
+func (UnimplementedUserServiceServer) SuggestUsers(context.Context, *SuggestUsersReq) (*SuggestUsersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestUsers not implemented")
+}
+func (UnimplementedUserServiceServer) ExportUserData(*ExportUserDataReq, UserService_ExportUserDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedUserServiceServer) Block(context.Context, *RelationReq) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+}
+func (UnimplementedUserServiceServer) Unblock(context.Context, *RelationReq) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unblock not implemented")
+}
+func (UnimplementedUserServiceServer) Mute(context.Context, *RelationReq) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mute not implemented")
+}
+func (UnimplementedUserServiceServer) Unmute(context.Context, *RelationReq) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unmute not implemented")
+}
+func (UnimplementedUserServiceServer) IsBlocked(context.Context, *RelationReq) (*IsBlockedRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsBlocked not implemented")
+}
+func (UnimplementedUserServiceServer) GetHiddenUsers(context.Context, *HiddenUsersReq) (*HiddenUsersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHiddenUsers not implemented")
+}
+func (UnimplementedUserServiceServer) SaveRefreshToken(context.Context, *RefreshToken) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveRefreshToken not implemented")
+}
+func (UnimplementedUserServiceServer) RotateRefreshToken(context.Context, *RotateRefreshTokenReq) (*AuthRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateRefreshToken not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeRefreshToken(context.Context, *RefreshToken) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRefreshToken not implemented")
+}
+func (UnimplementedUserServiceServer) EnrollTotp(context.Context, *TotpReq) (*TotpEnrollment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTotp not implemented")
+}
+func (UnimplementedUserServiceServer) ConfirmTotp(context.Context, *TotpReq) (*RecoveryCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTotp not implemented")
+}
+func (UnimplementedUserServiceServer) GenerateRecoveryCodes(context.Context, *TotpReq) (*RecoveryCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateRecoveryCodes not implemented")
+}
+func (UnimplementedUserServiceServer) DisableTotp(context.Context, *TotpReq) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTotp not implemented")
+}
+func (UnimplementedUserServiceServer) VerifyLoginChallenge(context.Context, *LoginChallengeReq) (*AuthRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyLoginChallenge not implemented")
+}
+func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	s.RegisterService(&UserService_ServiceDesc, srv)
+}
+
+func _UserService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Register(ctx, req.(*CreateUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Login(ctx, req.(*LoginUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Authorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthUser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Authorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Authorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Authorization(ctx, req.(*AuthUser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Create(ctx, req.(*CreateUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Update(ctx, req.(*UpdateUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdatePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdatePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdatePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdatePassword(ctx, req.(*UpdatePasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ForgotPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgotPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ForgotPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ForgotPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ForgotPassword(ctx, req.(*ForgotPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResetPassword(ctx, req.(*ResetPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RequestEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmailChangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RequestEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RequestEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RequestEmailChange(ctx, req.(*EmailChangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ConfirmEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmailChangeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ConfirmEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ConfirmEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ConfirmEmailChange(ctx, req.(*ConfirmEmailChangeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Delete(ctx, req.(*DeleteUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Get(ctx, req.(*GetUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetAll(ctx, req.(*GetAllUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SearchUsers(ctx, req.(*SearchUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CheckUniques_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckUniqReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CheckUniques(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CheckUniques_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CheckUniques(ctx, req.(*CheckUniqReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnlockUser(ctx, req.(*UnlockUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Follow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Follow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Follow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Follow(ctx, req.(*FollowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Unfollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Unfollow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Unfollow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Unfollow(ctx, req.(*FollowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_IsFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).IsFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_IsFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).IsFollowing(ctx, req.(*FollowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListFollowers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListFollowers(ctx, req.(*FollowListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListFollowing_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListFollowing(ctx, req.(*FollowListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetPrivacy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrivacyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetPrivacy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetPrivacy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetPrivacy(ctx, req.(*SetPrivacyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ApproveFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ApproveFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ApproveFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ApproveFollowRequest(ctx, req.(*FollowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RejectFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RejectFollowRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RejectFollowRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RejectFollowRequest(ctx, req.(*FollowReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListFollowRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListFollowRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListFollowRequests(ctx, req.(*FollowListReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userServiceClient) Delete(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*CheckUniquesResponse, error) {
-	out := new(CheckUniquesResponse)
-	err := c.cc.Invoke(ctx, UserService_Delete_FullMethodName, in, out, opts...)
-	if err != nil {
+func _UserService_FilterVisibleOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VisibleOwnersReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserServiceServer).FilterVisibleOwners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_FilterVisibleOwners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).FilterVisibleOwners(ctx, req.(*VisibleOwnersReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userServiceClient) Get(ctx context.Context, in *UserRequest, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, UserService_Get_FullMethodName, in, out, opts...)
-	if err != nil {
+func _UserService_GetFollowerIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowIdsReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetFollowerIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetFollowerIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetFollowerIds(ctx, req.(*FollowIdsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userServiceClient) GetAll(ctx context.Context, in *GetAllUsersRequest, opts ...grpc.CallOption) (*GetAllUsersResponse, error) {
-	out := new(GetAllUsersResponse)
-	err := c.cc.Invoke(ctx, UserService_GetAll_FullMethodName, in, out, opts...)
-	if err != nil {
+func _UserService_GetFolloweeIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowIdsReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetFolloweeIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetFolloweeIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetFolloweeIds(ctx, req.(*FollowIdsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userServiceClient) CheckUniques(ctx context.Context, in *CheckUniquesRequest, opts ...grpc.CallOption) (*CheckUniquesResponse, error) {
-	out := new(CheckUniquesResponse)
-	err := c.cc.Invoke(ctx, UserService_CheckUniques_FullMethodName, in, out, opts...)
-	if err != nil {
+func _UserService_SuggestUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestUsersReq)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(UserServiceServer).SuggestUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SuggestUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SuggestUsers(ctx, req.(*SuggestUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *userServiceClient) GetUserByEmail(ctx context.Context, in *ByEmail, opts ...grpc.CallOption) (*User, error) {
-	out := new(User)
-	err := c.cc.Invoke(ctx, UserService_GetUserByEmail_FullMethodName, in, out, opts...)
-	if err != nil {
-		return nil, err
+func _UserService_ExportUserData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUserDataReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(UserServiceServer).ExportUserData(m, &userServiceExportUserDataServer{stream})
 }
 
-// UserServiceServer is the server API for UserService service.
-// All implementations must embed UnimplementedUserServiceServer
-// for forward compatibility
-type UserServiceServer interface {
-	Create(context.Context, *User) (*User, error)
-	Update(context.Context, *User) (*User, error)
-	Delete(context.Context, *UserRequest) (*CheckUniquesResponse, error)
-	Get(context.Context, *UserRequest) (*User, error)
-	GetAll(context.Context, *GetAllUsersRequest) (*GetAllUsersResponse, error)
-	CheckUniques(context.Context, *CheckUniquesRequest) (*CheckUniquesResponse, error)
-	GetUserByEmail(context.Context, *ByEmail) (*User, error)
-	mustEmbedUnimplementedUserServiceServer()
+type UserService_ExportUserDataServer interface {
+	Send(*ExportChunk) error
+	grpc.ServerStream
 }
 
-// UnimplementedUserServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedUserServiceServer struct {
+type userServiceExportUserDataServer struct {
+	grpc.ServerStream
 }
 
-func (UnimplementedUserServiceServer) Create(context.Context, *User) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
-}
-func (UnimplementedUserServiceServer) Update(context.Context, *User) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+func (x *userServiceExportUserDataServer) Send(m *ExportChunk) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedUserServiceServer) Delete(context.Context, *UserRequest) (*CheckUniquesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+
+func _UserService_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Block_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Block(ctx, req.(*RelationReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) Get(context.Context, *UserRequest) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+
+func _UserService_Unblock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Unblock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Unblock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Unblock(ctx, req.(*RelationReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) GetAll(context.Context, *GetAllUsersRequest) (*GetAllUsersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
+
+func _UserService_Mute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Mute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Mute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Mute(ctx, req.(*RelationReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) CheckUniques(context.Context, *CheckUniquesRequest) (*CheckUniquesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckUniques not implemented")
+
+func _UserService_Unmute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Unmute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Unmute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Unmute(ctx, req.(*RelationReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) GetUserByEmail(context.Context, *ByEmail) (*User, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserByEmail not implemented")
+
+func _UserService_IsBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).IsBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_IsBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).IsBlocked(ctx, req.(*RelationReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
-// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to UserServiceServer will
-// result in compilation errors.
-type UnsafeUserServiceServer interface {
-	mustEmbedUnimplementedUserServiceServer()
+func _UserService_GetHiddenUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HiddenUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetHiddenUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetHiddenUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetHiddenUsers(ctx, req.(*HiddenUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
-	s.RegisterService(&UserService_ServiceDesc, srv)
+func _UserService_SaveRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SaveRefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SaveRefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SaveRefreshToken(ctx, req.(*RefreshToken))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(User)
+func _UserService_RotateRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateRefreshTokenReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).Create(ctx, in)
+		return srv.(UserServiceServer).RotateRefreshToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_Create_FullMethodName,
+		FullMethod: UserService_RotateRefreshToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).Create(ctx, req.(*User))
+		return srv.(UserServiceServer).RotateRefreshToken(ctx, req.(*RotateRefreshTokenReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(User)
+func _UserService_RevokeRefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshToken)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).Update(ctx, in)
+		return srv.(UserServiceServer).RevokeRefreshToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_Update_FullMethodName,
+		FullMethod: UserService_RevokeRefreshToken_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).Update(ctx, req.(*User))
+		return srv.(UserServiceServer).RevokeRefreshToken(ctx, req.(*RefreshToken))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserRequest)
+func _UserService_EnrollTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotpReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).Delete(ctx, in)
+		return srv.(UserServiceServer).EnrollTotp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_Delete_FullMethodName,
+		FullMethod: UserService_EnrollTotp_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).Delete(ctx, req.(*UserRequest))
+		return srv.(UserServiceServer).EnrollTotp(ctx, req.(*TotpReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UserRequest)
+func _UserService_ConfirmTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotpReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).Get(ctx, in)
+		return srv.(UserServiceServer).ConfirmTotp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_Get_FullMethodName,
+		FullMethod: UserService_ConfirmTotp_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).Get(ctx, req.(*UserRequest))
+		return srv.(UserServiceServer).ConfirmTotp(ctx, req.(*TotpReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllUsersRequest)
+func _UserService_GenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotpReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetAll(ctx, in)
+		return srv.(UserServiceServer).GenerateRecoveryCodes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetAll_FullMethodName,
+		FullMethod: UserService_GenerateRecoveryCodes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetAll(ctx, req.(*GetAllUsersRequest))
+		return srv.(UserServiceServer).GenerateRecoveryCodes(ctx, req.(*TotpReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_CheckUniques_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckUniquesRequest)
+func _UserService_DisableTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotpReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).CheckUniques(ctx, in)
+		return srv.(UserServiceServer).DisableTotp(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_CheckUniques_FullMethodName,
+		FullMethod: UserService_DisableTotp_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).CheckUniques(ctx, req.(*CheckUniquesRequest))
+		return srv.(UserServiceServer).DisableTotp(ctx, req.(*TotpReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUserByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ByEmail)
+func _UserService_VerifyLoginChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginChallengeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUserByEmail(ctx, in)
+		return srv.(UserServiceServer).VerifyLoginChallenge(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUserByEmail_FullMethodName,
+		FullMethod: UserService_VerifyLoginChallenge_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUserByEmail(ctx, req.(*ByEmail))
+		return srv.(UserServiceServer).VerifyLoginChallenge(ctx, req.(*LoginChallengeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -297,6 +1544,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "user.UserService",
 	HandlerType: (*UserServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _UserService_Register_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _UserService_Login_Handler,
+		},
+		{
+			MethodName: "Authorization",
+			Handler:    _UserService_Authorization_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _UserService_Create_Handler,
@@ -305,6 +1564,26 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Update",
 			Handler:    _UserService_Update_Handler,
 		},
+		{
+			MethodName: "UpdatePassword",
+			Handler:    _UserService_UpdatePassword_Handler,
+		},
+		{
+			MethodName: "ForgotPassword",
+			Handler:    _UserService_ForgotPassword_Handler,
+		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _UserService_ResetPassword_Handler,
+		},
+		{
+			MethodName: "RequestEmailChange",
+			Handler:    _UserService_RequestEmailChange_Handler,
+		},
+		{
+			MethodName: "ConfirmEmailChange",
+			Handler:    _UserService_ConfirmEmailChange_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _UserService_Delete_Handler,
@@ -317,15 +1596,133 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAll",
 			Handler:    _UserService_GetAll_Handler,
 		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _UserService_SearchUsers_Handler,
+		},
 		{
 			MethodName: "CheckUniques",
 			Handler:    _UserService_CheckUniques_Handler,
 		},
 		{
-			MethodName: "GetUserByEmail",
-			Handler:    _UserService_GetUserByEmail_Handler,
+			MethodName: "UnlockUser",
+			Handler:    _UserService_UnlockUser_Handler,
+		},
+		{
+			MethodName: "Follow",
+			Handler:    _UserService_Follow_Handler,
+		},
+		{
+			MethodName: "Unfollow",
+			Handler:    _UserService_Unfollow_Handler,
+		},
+		{
+			MethodName: "IsFollowing",
+			Handler:    _UserService_IsFollowing_Handler,
+		},
+		{
+			MethodName: "ListFollowers",
+			Handler:    _UserService_ListFollowers_Handler,
+		},
+		{
+			MethodName: "ListFollowing",
+			Handler:    _UserService_ListFollowing_Handler,
+		},
+		{
+			MethodName: "SetPrivacy",
+			Handler:    _UserService_SetPrivacy_Handler,
+		},
+		{
+			MethodName: "ApproveFollowRequest",
+			Handler:    _UserService_ApproveFollowRequest_Handler,
+		},
+		{
+			MethodName: "RejectFollowRequest",
+			Handler:    _UserService_RejectFollowRequest_Handler,
+		},
+		{
+			MethodName: "ListFollowRequests",
+			Handler:    _UserService_ListFollowRequests_Handler,
+		},
+		{
+			MethodName: "FilterVisibleOwners",
+			Handler:    _UserService_FilterVisibleOwners_Handler,
+		},
+		{
+			MethodName: "GetFollowerIds",
+			Handler:    _UserService_GetFollowerIds_Handler,
+		},
+		{
+			MethodName: "GetFolloweeIds",
+			Handler:    _UserService_GetFolloweeIds_Handler,
+		},
+		{
+			MethodName: "SuggestUsers",
+			Handler:    _UserService_SuggestUsers_Handler,
+		},
+		{
+			MethodName: "Block",
+			Handler:    _UserService_Block_Handler,
+		},
+		{
+			MethodName: "Unblock",
+			Handler:    _UserService_Unblock_Handler,
+		},
+		{
+			MethodName: "Mute",
+			Handler:    _UserService_Mute_Handler,
+		},
+		{
+			MethodName: "Unmute",
+			Handler:    _UserService_Unmute_Handler,
+		},
+		{
+			MethodName: "IsBlocked",
+			Handler:    _UserService_IsBlocked_Handler,
+		},
+		{
+			MethodName: "GetHiddenUsers",
+			Handler:    _UserService_GetHiddenUsers_Handler,
+		},
+		{
+			MethodName: "SaveRefreshToken",
+			Handler:    _UserService_SaveRefreshToken_Handler,
+		},
+		{
+			MethodName: "RotateRefreshToken",
+			Handler:    _UserService_RotateRefreshToken_Handler,
+		},
+		{
+			MethodName: "RevokeRefreshToken",
+			Handler:    _UserService_RevokeRefreshToken_Handler,
+		},
+		{
+			MethodName: "EnrollTotp",
+			Handler:    _UserService_EnrollTotp_Handler,
+		},
+		{
+			MethodName: "ConfirmTotp",
+			Handler:    _UserService_ConfirmTotp_Handler,
+		},
+		{
+			MethodName: "GenerateRecoveryCodes",
+			Handler:    _UserService_GenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "DisableTotp",
+			Handler:    _UserService_DisableTotp_Handler,
+		},
+		{
+			MethodName: "VerifyLoginChallenge",
+			Handler:    _UserService_VerifyLoginChallenge_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportUserData",
+			Handler:       _UserService_ExportUserData_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
 }
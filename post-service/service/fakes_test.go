@@ -0,0 +1,267 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync"
+	"time"
+
+	"post-service/config"
+	l "post-service/pkg/logger"
+	pbc "post-service/protos/comment-service"
+	pbp "post-service/protos/post-service"
+	pbu "post-service/protos/user-service"
+	"post-service/storage/repo"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeStorage serves the repos a test sets, using one it did not set panics
+type fakeStorage struct {
+	post     repo.PostStorageI
+	reaction repo.ReactionStorageI
+	feed     repo.FeedStorageI
+	trending repo.TrendingStorageI
+	tag      repo.TagStorageI
+}
+
+func (s *fakeStorage) Post() repo.PostStorageI         { return s.post }
+func (s *fakeStorage) Reaction() repo.ReactionStorageI { return s.reaction }
+func (s *fakeStorage) Feed() repo.FeedStorageI         { return s.feed }
+func (s *fakeStorage) Trending() repo.TrendingStorageI { return s.trending }
+func (s *fakeStorage) Tag() repo.TagStorageI           { return s.tag }
+
+// fakePostRepo keeps posts in a map, the methods it does not override panic
+// through the nil embedded interface
+type fakePostRepo struct {
+	repo.PostStorageI
+
+	mu      sync.Mutex
+	posts   map[string]*pbp.Post
+	created int
+	// flushes holds the views of every AddViews call, addViewsErr fails them
+	flushes     []map[string]int64
+	addViewsErr error
+	purged      []string
+}
+
+func newFakePostRepo(posts ...*pbp.Post) *fakePostRepo {
+	r := &fakePostRepo{posts: map[string]*pbp.Post{}}
+	for _, p := range posts {
+		r.posts[p.Id] = p
+	}
+	return r
+}
+
+func (r *fakePostRepo) Create(post *pbp.Post, tags []string) (*pbp.Post, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.created++
+	stored := proto.Clone(post).(*pbp.Post)
+	r.posts[stored.Id] = stored
+	return proto.Clone(stored).(*pbp.Post), nil
+}
+
+func (r *fakePostRepo) GetPost(req *pbp.GetRequest) (*pbp.Post, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.posts[req.PostId]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return proto.Clone(p).(*pbp.Post), nil
+}
+
+func (r *fakePostRepo) GetPostsByOwnerId(req *pbp.GetPostsByOwnerIdRequest) (*pbp.GetPostsByOwnerIdResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	res := &pbp.GetPostsByOwnerIdResponse{Posts: []*pbp.Post{}}
+	for _, p := range r.posts {
+		if p.OwnerId == req.OwnerId {
+			res.Posts = append(res.Posts, proto.Clone(p).(*pbp.Post))
+		}
+	}
+	return res, nil
+}
+
+func (r *fakePostRepo) OwnerPostIds(ownerId string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var ids []string
+	for _, p := range r.posts {
+		if p.OwnerId == ownerId {
+			ids = append(ids, p.Id)
+		}
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// Purge removes the owner's posts, the owners purged are kept in purged
+func (r *fakePostRepo) Purge(ownerId string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.purged = append(r.purged, ownerId)
+	var deleted int64
+	for id, p := range r.posts {
+		if p.OwnerId == ownerId {
+			delete(r.posts, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (r *fakePostRepo) AddViews(views map[string]int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	batch := map[string]int64{}
+	for id, n := range views {
+		batch[id] = n
+	}
+	r.flushes = append(r.flushes, batch)
+	if r.addViewsErr != nil {
+		return r.addViewsErr
+	}
+	for id, n := range views {
+		if p, ok := r.posts[id]; ok {
+			p.Views += n
+		}
+	}
+	return nil
+}
+
+// fakeFeedRepo records what fanOut and RebuildFeed asked for and serves
+// feed, newest first, as every user's feed
+type fakeFeedRepo struct {
+	repo.FeedStorageI
+
+	mu        sync.Mutex
+	fannedOut map[string][]string
+	fanIn     []string
+	feed      []*repo.FeedPost
+	// followees holds the followee ids of the last Feed or Rebuild call
+	followees   []string
+	rebuildSize int64
+}
+
+func newFakeFeedRepo() *fakeFeedRepo {
+	return &fakeFeedRepo{fannedOut: map[string][]string{}}
+}
+
+func (r *fakeFeedRepo) FanOut(postId string, followerIds []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.fannedOut[postId] = append([]string(nil), followerIds...)
+	return nil
+}
+
+func (r *fakeFeedRepo) MarkFanIn(authorId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.fanIn = append(r.fanIn, authorId)
+	return nil
+}
+
+func (r *fakeFeedRepo) Feed(userId string, followeeIds []string, after *repo.Cursor, limit int64) ([]*repo.FeedPost, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.followees = followeeIds
+	var page []*repo.FeedPost
+	for _, p := range r.feed {
+		if after != nil && !(p.CreatedAt.Before(after.CreatedAt) ||
+			p.CreatedAt.Equal(after.CreatedAt) && p.Post.Id < after.Id) {
+			continue
+		}
+		if int64(len(page)) == limit {
+			break
+		}
+		page = append(page, p)
+	}
+	return page, nil
+}
+
+func (r *fakeFeedRepo) Rebuild(userId string, followeeIds []string, size int64) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.followees, r.rebuildSize = followeeIds, size
+	return int64(len(r.feed)), nil
+}
+
+// fakeUserService answers the user-service calls post-service makes, every
+// owner is public. followers and followees hold the follows of each user,
+// blocked and muted who each user blocked and muted.
+type fakeUserService struct {
+	pbu.UserServiceClient
+
+	followers map[string][]string
+	followees map[string][]string
+	blocked   map[string][]string
+	muted     map[string][]string
+}
+
+func (c *fakeUserService) Get(ctx context.Context, req *pbu.GetUserReq, opts ...grpc.CallOption) (*pbu.User, error) {
+	return &pbu.User{Id: req.Value, FirstName: "Name", UserName: "user_" + req.Value}, nil
+}
+
+func (c *fakeUserService) FilterVisibleOwners(ctx context.Context, req *pbu.VisibleOwnersReq, opts ...grpc.CallOption) (*pbu.VisibleOwnersRes, error) {
+	return &pbu.VisibleOwnersRes{OwnerIds: req.OwnerIds}, nil
+}
+
+func (c *fakeUserService) GetFollowerIds(ctx context.Context, req *pbu.FollowIdsReq, opts ...grpc.CallOption) (*pbu.FollowIds, error) {
+	ids := c.followers[req.UserId]
+	if req.Limit > 0 && int64(len(ids)) > req.Limit {
+		ids = ids[:req.Limit]
+	}
+	return &pbu.FollowIds{Ids: ids}, nil
+}
+
+func (c *fakeUserService) GetFolloweeIds(ctx context.Context, req *pbu.FollowIdsReq, opts ...grpc.CallOption) (*pbu.FollowIds, error) {
+	return &pbu.FollowIds{Ids: c.followees[req.UserId]}, nil
+}
+
+func (c *fakeUserService) GetHiddenUsers(ctx context.Context, req *pbu.HiddenUsersReq, opts ...grpc.CallOption) (*pbu.HiddenUsersRes, error) {
+	return &pbu.HiddenUsersRes{Blocked: c.blocked[req.ViewerId], Muted: c.muted[req.ViewerId]}, nil
+}
+
+// fakeCommentService records the post ids PurgeComments was asked for
+type fakeCommentService struct {
+	pbc.CommentServiceClient
+
+	purged [][]string
+}
+
+func (c *fakeCommentService) PurgeComments(ctx context.Context, req *pbc.PurgeCommentsReq, opts ...grpc.CallOption) (*pbc.PurgeRes, error) {
+	c.purged = append(c.purged, req.PostIds)
+	return &pbc.PurgeRes{}, nil
+}
+
+type fakeServices struct {
+	user    *fakeUserService
+	comment *fakeCommentService
+}
+
+func (s *fakeServices) UserService() pbu.UserServiceClient       { return s.user }
+func (s *fakeServices) CommentService() pbc.CommentServiceClient { return s.comment }
+
+func newTestService(st *fakeStorage, users *fakeUserService, cfg config.Config) *PostService {
+	return &PostService{
+		storage: st,
+		logger:  l.New("error", "test"),
+		client:  &fakeServices{user: users, comment: &fakeCommentService{}},
+		cfg:     cfg,
+		views:   newViewCounter(time.Hour),
+	}
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+
+	l "post-service/pkg/logger"
+	pbp "post-service/protos/post-service"
+	pbu "post-service/protos/user-service"
+	"post-service/storage/repo"
+
+	"shared/auth"
+	"shared/paging"
+)
+
+// feedRebuildSize is how many posts a rebuilt timeline starts with
+const feedRebuildSize = 500
+
+// GetFeed returns the posts of the accounts the user follows, newest first.
+// Most posts were copied into the user's timeline when they were written,
+// the posts of authors with too many followers for that are read here.
+func (s *PostService) GetFeed(ctx context.Context, req *pbp.GetFeedReq) (*pbp.Feed, error) {
+	if err := auth.CanModify(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	after, err := paging.DecodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	followees, err := s.client.UserService().GetFolloweeIds(ctx, &pbu.FollowIdsReq{UserId: req.UserId})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	limit := paging.Size(req.Limit)
+	posts, err := s.storage.Feed().Feed(req.UserId, followees.Ids, after, limit)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	hidden, err := s.hiddenUsers(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	res := &pbp.Feed{Posts: []*pbp.Post{}}
+	for _, p := range posts {
+		if !hidden[p.Post.OwnerId] {
+			res.Posts = append(res.Posts, p.Post)
+		}
+	}
+	// the cursor follows what was read, so hidden posts do not end the feed early
+	if int64(len(posts)) == limit {
+		last := posts[len(posts)-1]
+		res.NextCursor = paging.EncodeCursor(repo.Cursor{CreatedAt: last.CreatedAt, Id: last.Post.Id})
+	}
+	return res, nil
+}
+
+// RebuildFeed is asked by user-service when the follows of a user change, it
+// replaces the timeline with the latest posts of the accounts followed now
+func (s *PostService) RebuildFeed(ctx context.Context, req *pbp.RebuildFeedReq) (*pbp.RebuildFeedRes, error) {
+	followees, err := s.client.UserService().GetFolloweeIds(ctx, &pbu.FollowIdsReq{UserId: req.UserId})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	added, err := s.storage.Feed().Rebuild(req.UserId, followees.Ids, feedRebuildSize)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return &pbp.RebuildFeedRes{Posts: added}, nil
+}
+
+// fanOut copies a new post into the timelines of its author's followers.
+// Authors with more than FeedFanoutLimit followers are marked fan-in and
+// their posts are read when feeds are served. The post is written already,
+// so failures are only logged, the next rebuild of a feed picks it up.
+func (s *PostService) fanOut(ctx context.Context, post *pbp.Post) {
+	limit := s.cfg.FeedFanoutLimit
+	followers, err := s.client.UserService().GetFollowerIds(ctx, &pbu.FollowIdsReq{
+		UserId: post.OwnerId,
+		Limit:  int64(limit) + 1,
+	})
+	if err != nil {
+		s.logger.Error("failed to get followers to fan out to", l.String("post_id", post.Id), l.Error(err))
+		return
+	}
+
+	if len(followers.Ids) > limit {
+		err = s.storage.Feed().MarkFanIn(post.OwnerId)
+	} else {
+		err = s.storage.Feed().FanOut(post.Id, followers.Ids)
+	}
+	if err != nil {
+		s.logger.Error("failed to fan out post", l.String("post_id", post.Id), l.Error(err))
+	}
+}
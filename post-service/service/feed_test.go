@@ -0,0 +1,148 @@
+package service
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"post-service/config"
+	pbp "post-service/protos/post-service"
+	"post-service/storage/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFanOutThreshold(t *testing.T) {
+	const limit = 3
+	tests := []struct {
+		followers  int
+		wantFanOut bool
+		wantMarked bool
+	}{
+		{followers: 0, wantFanOut: true},
+		{followers: limit - 1, wantFanOut: true},
+		{followers: limit, wantFanOut: true},
+		{followers: limit + 1, wantMarked: true},
+		{followers: 10 * limit, wantMarked: true},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.followers, " followers"), func(t *testing.T) {
+			var followers []string
+			for i := 0; i < tt.followers; i++ {
+				followers = append(followers, fmt.Sprint("follower-", i))
+			}
+			feed := newFakeFeedRepo()
+			users := &fakeUserService{followers: map[string][]string{"user-1": followers}}
+			s := newTestService(&fakeStorage{post: newFakePostRepo(), feed: feed}, users, config.Config{FeedFanoutLimit: limit})
+
+			if _, err := s.Create(asUser("user-1"), &pbp.Post{Id: "post-1", OwnerId: "user-1", Title: "t"}); err != nil {
+				t.Fatal(err)
+			}
+
+			fannedOut, ok := feed.fannedOut["post-1"]
+			if ok != tt.wantFanOut {
+				t.Fatalf("fanned out %v, want fan-out %v", fannedOut, tt.wantFanOut)
+			}
+			if tt.wantFanOut && len(fannedOut) != tt.followers {
+				t.Errorf("fanned out to %d followers, want %d", len(fannedOut), tt.followers)
+			}
+			if marked := reflect.DeepEqual(feed.fanIn, []string{"user-1"}); marked != tt.wantMarked {
+				t.Errorf("marked fan-in %v, want %v", feed.fanIn, tt.wantMarked)
+			}
+		})
+	}
+}
+
+// feedFixture is a feed of five posts a minute apart, post-3 and post-2
+// share a time so their order comes from the id. user-2 wrote post-4.
+func feedFixture() []*repo.FeedPost {
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	at := map[string]time.Time{
+		"post-5": start.Add(4 * time.Minute),
+		"post-4": start.Add(3 * time.Minute),
+		"post-3": start.Add(2 * time.Minute),
+		"post-2": start.Add(2 * time.Minute),
+		"post-1": start,
+	}
+	var feed []*repo.FeedPost
+	for _, id := range []string{"post-5", "post-4", "post-3", "post-2", "post-1"} {
+		owner := "user-3"
+		if id == "post-4" {
+			owner = "user-2"
+		}
+		feed = append(feed, &repo.FeedPost{Post: &pbp.Post{Id: id, OwnerId: owner}, CreatedAt: at[id]})
+	}
+	return feed
+}
+
+func TestGetFeedPages(t *testing.T) {
+	feed := newFakeFeedRepo()
+	feed.feed = feedFixture()
+	users := &fakeUserService{
+		followees: map[string][]string{"user-1": {"user-2", "user-3"}},
+		blocked:   map[string][]string{"user-1": {"user-2"}},
+	}
+	s := newTestService(&fakeStorage{feed: feed}, users, config.Config{})
+
+	var (
+		pages  [][]string
+		cursor string
+	)
+	for i := 0; i < 5; i++ {
+		res, err := s.GetFeed(asUser("user-1"), &pbp.GetFeedReq{UserId: "user-1", Limit: 2, Cursor: cursor})
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids := []string{}
+		for _, p := range res.Posts {
+			ids = append(ids, p.Id)
+		}
+		pages = append(pages, ids)
+		if res.NextCursor == "" {
+			break
+		}
+		cursor = res.NextCursor
+	}
+
+	// post-4 of the blocked user-2 is left out, the page it was on is short
+	// but the feed goes on
+	want := [][]string{{"post-5"}, {"post-3", "post-2"}, {"post-1"}}
+	if !reflect.DeepEqual(pages, want) {
+		t.Errorf("pages = %v, want %v", pages, want)
+	}
+	if !reflect.DeepEqual(feed.followees, []string{"user-2", "user-3"}) {
+		t.Errorf("feed read for followees %v", feed.followees)
+	}
+}
+
+func TestGetFeedErrors(t *testing.T) {
+	s := newTestService(&fakeStorage{feed: newFakeFeedRepo()}, &fakeUserService{}, config.Config{})
+
+	if _, err := s.GetFeed(asUser("user-2"), &pbp.GetFeedReq{UserId: "user-1"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("feed of another user = %v, want PermissionDenied", err)
+	}
+	if _, err := s.GetFeed(asUser("user-1"), &pbp.GetFeedReq{UserId: "user-1", Cursor: "garbage!"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("invalid cursor = %v, want InvalidArgument", err)
+	}
+	res, err := s.GetFeed(asUser("user-1"), &pbp.GetFeedReq{UserId: "user-1"})
+	if err != nil || res.Posts == nil || len(res.Posts) != 0 || res.NextCursor != "" {
+		t.Errorf("empty feed = %v, %v", res, err)
+	}
+}
+
+func TestRebuildFeed(t *testing.T) {
+	feed := newFakeFeedRepo()
+	feed.feed = feedFixture()
+	users := &fakeUserService{followees: map[string][]string{"user-1": {"user-2", "user-3"}}}
+	s := newTestService(&fakeStorage{feed: feed}, users, config.Config{})
+
+	res, err := s.RebuildFeed(asUser("user-1"), &pbp.RebuildFeedReq{UserId: "user-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Posts != 5 || feed.rebuildSize != feedRebuildSize || !reflect.DeepEqual(feed.followees, []string{"user-2", "user-3"}) {
+		t.Errorf("rebuilt %d posts of %v with size %d", res.Posts, feed.followees, feed.rebuildSize)
+	}
+}
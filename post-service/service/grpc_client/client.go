@@ -6,6 +6,8 @@ import (
 	pbc "post-service/protos/comment-service"
 	pbu "post-service/protos/user-service"
 
+	"shared/auth"
+
 	"google.golang.org/grpc"
 )
 
@@ -25,6 +27,8 @@ func New(cfg config.Config) (IServiceManager, error) {
 	connUser, err := grpc.Dial(
 		fmt.Sprintf("%s:%d", cfg.UserServiceHost, cfg.UserServicePort),
 		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(auth.ClientInterceptor(cfg.InternalSecret)),
+		grpc.WithStreamInterceptor(auth.StreamClientInterceptor(cfg.InternalSecret)),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("user service dail host: %s port : %d", cfg.UserServiceHost, cfg.UserServicePort)
@@ -33,6 +37,8 @@ func New(cfg config.Config) (IServiceManager, error) {
 	connComment, err := grpc.Dial(
 		fmt.Sprintf("%s:%d", cfg.CommentServiceHost, cfg.CommentServicePort),
 		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(auth.ClientInterceptor(cfg.InternalSecret)),
+		grpc.WithStreamInterceptor(auth.StreamClientInterceptor(cfg.InternalSecret)),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("comment service dail host: %s port : %d", cfg.CommentServiceHost, cfg.CommentServicePort)
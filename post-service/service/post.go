@@ -2,12 +2,23 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"post-service/config"
+	"post-service/pkg/hashtag"
 	l "post-service/pkg/logger"
+	pbc "post-service/protos/comment-service"
 	pbp "post-service/protos/post-service"
 	pbu "post-service/protos/user-service"
 	"post-service/storage"
 
+	"shared/auth"
+
 	"github.com/jmoiron/sqlx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	grpcClient "post-service/service/grpc_client"
 )
@@ -18,28 +29,43 @@ type PostService struct {
 	storage storage.IStorage
 	logger  l.Logger
 	client  grpcClient.IServiceManager
+	cfg     config.Config
+	views   *viewCounter
 }
 
 // NewPostService ...
-func NewPostService(db *sqlx.DB, log l.Logger, client grpcClient.IServiceManager) *PostService {
+func NewPostService(db *sqlx.DB, log l.Logger, client grpcClient.IServiceManager, cfg config.Config) *PostService {
 	return &PostService{
 		storage: storage.NewStoragePg(db),
 		logger:  log,
 		client:  client,
+		cfg:     cfg,
+		views:   newViewCounter(time.Duration(cfg.ViewWindow) * time.Minute),
 	}
 }
 
 func (s *PostService) Create(ctx context.Context, req *pbp.Post) (*pbp.Post, error) {
-	post, err := s.storage.Post().Create(req)
+	if err := auth.CanModify(ctx, req.OwnerId); err != nil {
+		return nil, err
+	}
+	post, err := s.storage.Post().Create(req, hashtag.Extract(req.Content))
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
+	s.fanOut(ctx, post)
 	return post, nil
 }
 
 func (s *PostService) Update(ctx context.Context, req *pbp.Post) (*pbp.Post, error) {
-	post, err := s.storage.Post().Update(req)
+	existing, err := s.ownedPost(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	// ownership can not be handed over with an update
+	req.OwnerId = existing.OwnerId
+
+	post, err := s.storage.Post().Update(req, hashtag.Extract(req.Content))
 
 	if err != nil {
 		s.logger.Error(err.Error())
@@ -49,8 +75,11 @@ func (s *PostService) Update(ctx context.Context, req *pbp.Post) (*pbp.Post, err
 }
 
 func (s *PostService) Delete(ctx context.Context, req *pbp.GetRequest) (*pbp.CheckResponse, error) {
+	if _, err := s.ownedPost(ctx, req.PostId); err != nil {
+		return nil, err
+	}
+
 	user, err := s.storage.Post().Delete(req)
-	s.client.UserService()
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
@@ -60,31 +89,59 @@ func (s *PostService) Delete(ctx context.Context, req *pbp.GetRequest) (*pbp.Che
 
 func (s *PostService) GetPost(ctx context.Context, req *pbp.GetRequest) (*pbp.PostResponse, error) {
 	post, err := s.storage.Post().GetPost(req)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "post not found")
+	}
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
+	visible, err := s.visibleOwners(ctx, []string{post.OwnerId})
+	if err != nil {
+		return nil, err
+	}
+	// a private account's post looks the same as a missing one to outsiders
+	if !visible[post.OwnerId] {
+		return nil, status.Error(codes.NotFound, "post not found")
+	}
+	if req.CountView {
+		// the post is shown to the viewer, so not when they blocked, were
+		// blocked by or muted its author, and only then is the view counted
+		viewerId, _ := auth.Subject(ctx)
+		hidden, err := s.hiddenUsers(ctx, viewerId)
+		if err != nil {
+			return nil, err
+		}
+		if hidden[post.OwnerId] {
+			return nil, status.Error(codes.NotFound, "post not found")
+		}
+	}
 
-	user, err := s.client.UserService().Get(ctx, &pbu.UserRequest{UserId: post.OwnerId})
+	user, err := s.client.UserService().Get(ctx, &pbu.GetUserReq{Field: "id", Value: post.OwnerId})
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
+	if req.CountView {
+		s.countView(ctx, post, req.ClientIp)
+	}
 
 	return &pbp.PostResponse{
 		Id:        post.Id,
 		Content:   post.Content,
 		Title:     post.Title,
-		Views:     post.Views,
+		Views:     post.Views + s.views.pendingFor(post.Id),
 		Category:  post.Category,
 		OwnerId:   post.OwnerId,
 		CreatedAt: post.CreatedAt,
 		UpdatedAt: post.UpdatedAt,
+		Likes:     post.Likes,
+		Dislikes:  post.Dislikes,
 		Owner: &pbp.Owner{
 			Id:        user.Id,
-			Name:      user.Name,
+			Name:      user.FirstName,
 			LastName:  user.LastName,
-			Username:  user.Username,
+			Username:  user.UserName,
 			Email:     user.Email,
 			Password:  user.Password,
 			CreatedAt: user.CreatedAt,
@@ -100,14 +157,173 @@ func (s *PostService) GetAllPosts(ctx context.Context, req *pbp.GetAllPostsReque
 		return nil, err
 	}
 
+	viewerId, _ := auth.Subject(ctx)
+	hidden, err := s.hiddenUsers(ctx, viewerId)
+	if err != nil {
+		return nil, err
+	}
+	owners := make([]string, 0, len(posts.Posts))
+	for _, post := range posts.Posts {
+		owners = append(owners, post.OwnerId)
+	}
+	visible, err := s.visibleOwners(ctx, owners)
+	if err != nil {
+		return nil, err
+	}
+
+	shown := posts.Posts[:0]
+	for _, post := range posts.Posts {
+		if !hidden[post.OwnerId] && visible[post.OwnerId] {
+			shown = append(shown, post)
+		}
+	}
+	posts.Posts = shown
+
 	return posts, nil
 }
 
 func (s *PostService) GetPostsByOwnerId(ctx context.Context, req *pbp.GetPostsByOwnerIdRequest) (*pbp.GetPostsByOwnerIdResponse, error) {
+	viewerId, _ := auth.Subject(ctx)
+	hidden, err := s.hiddenUsers(ctx, viewerId)
+	if err != nil {
+		return nil, err
+	}
+	visible, err := s.visibleOwners(ctx, []string{req.OwnerId})
+	if err != nil {
+		return nil, err
+	}
+	if hidden[req.OwnerId] || !visible[req.OwnerId] {
+		return &pbp.GetPostsByOwnerIdResponse{Posts: []*pbp.Post{}}, nil
+	}
+
 	posts, err := s.storage.Post().GetPostsByOwnerId(&pbp.GetPostsByOwnerIdRequest{OwnerId: req.OwnerId})
 	if err != nil {
+		s.logger.Error(err.Error())
 		return nil, err
 	}
 
 	return posts, nil
 }
+
+// categoryNeighboursLimit bounds the candidates handed to user-service suggestions
+const categoryNeighboursLimit = 100
+
+// GetCategoryNeighbours is asked by user-service for its suggestions
+func (s *PostService) GetCategoryNeighbours(ctx context.Context, req *pbp.GetPostsByOwnerIdRequest) (*pbp.UserScores, error) {
+	scores, err := s.storage.Post().CategoryNeighbours(req.OwnerId, categoryNeighboursLimit)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return &pbp.UserScores{Scores: scores}, nil
+}
+
+// PurgeOwner is asked by user-service once an account is removed for good.
+// The comments on the posts go first, so a failed call can simply be retried.
+func (s *PostService) PurgeOwner(ctx context.Context, req *pbp.GetPostsByOwnerIdRequest) (*pbp.PurgeRes, error) {
+	if err := auth.RequireService(ctx); err != nil {
+		return nil, err
+	}
+	ids, err := s.storage.Post().OwnerPostIds(req.OwnerId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if len(ids) > 0 {
+		if _, err := s.client.CommentService().PurgeComments(ctx, &pbc.PurgeCommentsReq{PostIds: ids}); err != nil {
+			s.logger.Error("failed to purge comments: " + err.Error())
+			return nil, err
+		}
+	}
+
+	// an owner without posts may still have reactions and timeline entries
+	deleted, err := s.storage.Post().Purge(req.OwnerId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return &pbp.PurgeRes{Deleted: deleted}, nil
+}
+
+// ExportPosts is asked by user-service for a personal data export, it sends
+// the posts one by one so their size is not bound by the message limit
+func (s *PostService) ExportPosts(req *pbp.GetPostsByOwnerIdRequest, stream pbp.PostService_ExportPostsServer) error {
+	if err := auth.CanModify(stream.Context(), req.OwnerId); err != nil {
+		return err
+	}
+	posts, err := s.storage.Post().GetPostsByOwnerId(&pbp.GetPostsByOwnerIdRequest{OwnerId: req.OwnerId})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+	for _, post := range posts.Posts {
+		if err := stream.Send(post); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ownedPost returns the post if the caller may change it
+func (s *PostService) ownedPost(ctx context.Context, postId string) (*pbp.Post, error) {
+	post, err := s.storage.Post().GetPost(&pbp.GetRequest{PostId: postId})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "post not found")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if err := auth.CanModify(ctx, post.OwnerId); err != nil {
+		return nil, err
+	}
+	return post, nil
+}
+
+// hiddenUsers returns the users the viewer blocked, muted or was blocked by,
+// their posts are left out of lists
+func (s *PostService) hiddenUsers(ctx context.Context, viewerId string) (map[string]bool, error) {
+	hidden := map[string]bool{}
+	if viewerId == "" {
+		return hidden, nil
+	}
+
+	res, err := s.client.UserService().GetHiddenUsers(ctx, &pbu.HiddenUsersReq{ViewerId: viewerId})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	for _, id := range append(res.Blocked, res.Muted...) {
+		hidden[id] = true
+	}
+	return hidden, nil
+}
+
+// visibleOwners returns which of the owners the caller may see posts of,
+// private accounts are only visible to their approved followers and admins
+func (s *PostService) visibleOwners(ctx context.Context, ownerIds []string) (map[string]bool, error) {
+	visible := map[string]bool{}
+	if auth.IsAdmin(ctx) {
+		for _, id := range ownerIds {
+			visible[id] = true
+		}
+		return visible, nil
+	}
+	if len(ownerIds) == 0 {
+		return visible, nil
+	}
+
+	viewerId, _ := auth.Subject(ctx)
+	res, err := s.client.UserService().FilterVisibleOwners(ctx, &pbu.VisibleOwnersReq{
+		ViewerId: viewerId,
+		OwnerIds: ownerIds,
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	for _, id := range res.OwnerIds {
+		visible[id] = true
+	}
+	return visible, nil
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"post-service/config"
+	pbp "post-service/protos/post-service"
+
+	"shared/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func asUser(userId string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.UserIdKey, userId, auth.RoleKey, "user"))
+}
+
+// asService is the context of a call another service makes on its own
+func asService() context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.RoleKey, auth.RoleService))
+}
+
+func TestCreateChecksOwner(t *testing.T) {
+	posts := newFakePostRepo()
+	s := newTestService(&fakeStorage{post: posts, feed: newFakeFeedRepo()}, &fakeUserService{}, config.Config{FeedFanoutLimit: 10})
+
+	_, err := s.Create(asUser("user-1"), &pbp.Post{Id: "post-1", OwnerId: "user-2", Title: "t"})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("creating a post for another user: got %v, want PermissionDenied", err)
+	}
+	if posts.created != 0 {
+		t.Fatalf("a rejected post was stored")
+	}
+
+	if _, err := s.Create(asUser("user-1"), &pbp.Post{Id: "post-1", OwnerId: "user-1", Title: "t"}); err != nil {
+		t.Fatalf("creating an own post: %v", err)
+	}
+	if posts.created != 1 {
+		t.Fatalf("stored %d posts, want 1", posts.created)
+	}
+}
+
+func TestGetPostCountsViewOnlyWhenAsked(t *testing.T) {
+	posts := newFakePostRepo(&pbp.Post{Id: "post-1", OwnerId: "user-1", Views: 5})
+	s := newTestService(&fakeStorage{post: posts}, &fakeUserService{}, config.Config{})
+
+	res, err := s.GetPost(asUser("user-2"), &pbp.GetRequest{PostId: "post-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Views != 5 || s.views.pendingFor("post-1") != 0 {
+		t.Fatalf("a lookup without count_view counted a view: views %d, pending %d", res.Views, s.views.pendingFor("post-1"))
+	}
+
+	res, err = s.GetPost(asUser("user-2"), &pbp.GetRequest{PostId: "post-1", CountView: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Views != 6 {
+		t.Fatalf("views = %d, want 6", res.Views)
+	}
+}
+
+func TestGetPostHidesBlockedAndMutedAuthors(t *testing.T) {
+	users := &fakeUserService{
+		blocked: map[string][]string{"user-2": {"user-1"}},
+		muted:   map[string][]string{"user-3": {"user-1"}},
+	}
+	for _, viewer := range []string{"user-2", "user-3"} {
+		t.Run(viewer, func(t *testing.T) {
+			posts := newFakePostRepo(&pbp.Post{Id: "post-1", OwnerId: "user-1"})
+			s := newTestService(&fakeStorage{post: posts}, users, config.Config{})
+
+			_, err := s.GetPost(asUser(viewer), &pbp.GetRequest{PostId: "post-1", CountView: true})
+			if status.Code(err) != codes.NotFound {
+				t.Fatalf("showing a hidden author's post: got %v, want NotFound", err)
+			}
+			if n := s.views.pendingFor("post-1"); n != 0 {
+				t.Fatalf("a post not shown counted %d views", n)
+			}
+			// a lookup that does not show the post, like commenting on it, still finds it
+			if _, err := s.GetPost(asUser(viewer), &pbp.GetRequest{PostId: "post-1"}); err != nil {
+				t.Fatalf("lookup without count_view: %v", err)
+			}
+		})
+	}
+}
+
+func TestGetPostsByOwnerIdHidesBlockedAndMutedAuthors(t *testing.T) {
+	posts := newFakePostRepo(&pbp.Post{Id: "post-1", OwnerId: "user-1"}, &pbp.Post{Id: "post-2", OwnerId: "user-2"})
+	users := &fakeUserService{
+		blocked: map[string][]string{"user-3": {"user-1"}},
+		muted:   map[string][]string{"user-3": {"user-2"}},
+	}
+	s := newTestService(&fakeStorage{post: posts}, users, config.Config{})
+
+	tests := []struct {
+		viewer, owner string
+		want          int
+	}{
+		{"user-3", "user-1", 0},
+		{"user-3", "user-2", 0},
+		{"user-4", "user-1", 1},
+		{"user-1", "user-1", 1},
+	}
+	for _, tt := range tests {
+		res, err := s.GetPostsByOwnerId(asUser(tt.viewer), &pbp.GetPostsByOwnerIdRequest{OwnerId: tt.owner})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Posts) != tt.want {
+			t.Errorf("%s sees %d posts of %s, want %d", tt.viewer, len(res.Posts), tt.owner, tt.want)
+		}
+	}
+}
+
+func TestPurgeOwnerNeedsService(t *testing.T) {
+	posts := newFakePostRepo(&pbp.Post{Id: "post-1", OwnerId: "user-1"})
+	s := newTestService(&fakeStorage{post: posts}, &fakeUserService{}, config.Config{})
+	comments := s.client.(*fakeServices).comment
+
+	for _, ctx := range []context.Context{context.Background(), asUser("user-1"), asUser("user-2")} {
+		if _, err := s.PurgeOwner(ctx, &pbp.GetPostsByOwnerIdRequest{OwnerId: "user-1"}); status.Code(err) != codes.PermissionDenied {
+			t.Fatalf("PurgeOwner = %v, want PermissionDenied", err)
+		}
+	}
+	if len(posts.purged) != 0 || len(comments.purged) != 0 {
+		t.Fatalf("purged posts of %v and comments of %v without a service call", posts.purged, comments.purged)
+	}
+
+	res, err := s.PurgeOwner(asService(), &pbp.GetPostsByOwnerIdRequest{OwnerId: "user-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Deleted != 1 || len(comments.purged) != 1 || comments.purged[0][0] != "post-1" {
+		t.Fatalf("deleted %d posts, purged comments of %v", res.Deleted, comments.purged)
+	}
+}
+
+func TestPurgeOwnerWithoutPosts(t *testing.T) {
+	posts := newFakePostRepo(&pbp.Post{Id: "post-1", OwnerId: "user-1"})
+	s := newTestService(&fakeStorage{post: posts}, &fakeUserService{}, config.Config{})
+	comments := s.client.(*fakeServices).comment
+
+	// user-2 only reacted, the reactions go with the purge all the same
+	res, err := s.PurgeOwner(asService(), &pbp.GetPostsByOwnerIdRequest{OwnerId: "user-2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Deleted != 0 || len(posts.purged) != 1 || posts.purged[0] != "user-2" {
+		t.Fatalf("deleted %d posts, purged %v, want user-2 purged", res.Deleted, posts.purged)
+	}
+	if len(comments.purged) != 0 {
+		t.Fatalf("purged comments of %v without posts", comments.purged)
+	}
+}
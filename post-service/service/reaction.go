@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	pbp "post-service/protos/post-service"
+	pbu "post-service/protos/user-service"
+	"post-service/storage/repo"
+
+	"shared/auth"
+	"shared/paging"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// React likes or dislikes a post for the user, a second reaction replaces
+// the first one
+func (s *PostService) React(ctx context.Context, req *pbp.ReactReq) (*pbp.Reactions, error) {
+	if req.Reaction != repo.ReactionLike && req.Reaction != repo.ReactionDislike {
+		return nil, status.Error(codes.InvalidArgument, "reaction must be like or dislike")
+	}
+	if err := auth.CanModify(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	if err := s.checkReachable(ctx, req.PostId); err != nil {
+		return nil, err
+	}
+
+	res, err := s.storage.Reaction().React(req.PostId, req.UserId, req.Reaction)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "post not found")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return res, nil
+}
+
+// Unreact takes the user's reaction off a post, the post does not have to be
+// visible to them any more
+func (s *PostService) Unreact(ctx context.Context, req *pbp.ReactReq) (*pbp.Reactions, error) {
+	if err := auth.CanModify(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	res, err := s.storage.Reaction().Unreact(req.PostId, req.UserId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "post not found")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return res, nil
+}
+
+// ListReactors lists who reacted to a post, newest first
+func (s *PostService) ListReactors(ctx context.Context, req *pbp.ListReactorsReq) (*pbp.Reactors, error) {
+	if req.Reaction != "" && req.Reaction != repo.ReactionLike && req.Reaction != repo.ReactionDislike {
+		return nil, status.Error(codes.InvalidArgument, "reaction must be like or dislike")
+	}
+	after, err := paging.DecodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkReachable(ctx, req.PostId); err != nil {
+		return nil, err
+	}
+
+	limit := paging.Size(req.Limit)
+	reactions, err := s.storage.Reaction().List(req.PostId, req.Reaction, after, limit)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	res := &pbp.Reactors{Reactors: []*pbp.Reactor{}}
+	for _, r := range reactions {
+		res.Reactors = append(res.Reactors, &pbp.Reactor{
+			UserId:    r.UserId,
+			Reaction:  r.Reaction,
+			ReactedAt: r.ReactedAt.Format(time.RFC3339),
+		})
+	}
+	// a full page means there may be more
+	if int64(len(reactions)) == limit {
+		last := reactions[len(reactions)-1]
+		res.NextCursor = paging.EncodeCursor(repo.Cursor{CreatedAt: last.ReactedAt, Id: last.UserId})
+	}
+	return res, nil
+}
+
+// checkReachable fails with NotFound unless the caller may see the post and
+// is not blocked by or blocking its owner
+func (s *PostService) checkReachable(ctx context.Context, postId string) error {
+	post, err := s.storage.Post().GetPost(&pbp.GetRequest{PostId: postId})
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "post not found")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+	visible, err := s.visibleOwners(ctx, []string{post.OwnerId})
+	if err != nil {
+		return err
+	}
+	if !visible[post.OwnerId] {
+		return status.Error(codes.NotFound, "post not found")
+	}
+
+	viewerId, _ := auth.Subject(ctx)
+	if viewerId == "" || viewerId == post.OwnerId {
+		return nil
+	}
+	res, err := s.client.UserService().GetHiddenUsers(ctx, &pbu.HiddenUsersReq{ViewerId: viewerId})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+	for _, id := range res.Blocked {
+		if id == post.OwnerId {
+			return status.Error(codes.NotFound, "post not found")
+		}
+	}
+	return nil
+}
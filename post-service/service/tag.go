@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"post-service/pkg/hashtag"
+	pbp "post-service/protos/post-service"
+	"post-service/storage/repo"
+
+	"shared/auth"
+	"shared/paging"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPostsByTag lists the posts filed under a hashtag, newest first
+func (s *PostService) GetPostsByTag(ctx context.Context, req *pbp.PostsByTagReq) (*pbp.Feed, error) {
+	tag := hashtag.Normalize(req.Tag)
+	if tag == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid tag")
+	}
+	after, err := paging.DecodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := paging.Size(req.Limit)
+	posts, err := s.storage.Tag().PostsByTag(tag, after, limit)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	viewerId, _ := auth.Subject(ctx)
+	hidden, err := s.hiddenUsers(ctx, viewerId)
+	if err != nil {
+		return nil, err
+	}
+	owners := make([]string, 0, len(posts))
+	for _, p := range posts {
+		owners = append(owners, p.Post.OwnerId)
+	}
+	visible, err := s.visibleOwners(ctx, owners)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pbp.Feed{Posts: []*pbp.Post{}}
+	for _, p := range posts {
+		if !hidden[p.Post.OwnerId] && visible[p.Post.OwnerId] {
+			res.Posts = append(res.Posts, p.Post)
+		}
+	}
+	// the cursor follows what was read, so hidden posts do not end the page early
+	if int64(len(posts)) == limit {
+		last := posts[len(posts)-1]
+		res.NextCursor = paging.EncodeCursor(repo.Cursor{CreatedAt: last.CreatedAt, Id: last.Post.Id})
+	}
+	return res, nil
+}
+
+// SearchTags autocompletes hashtags by prefix, the most used first
+func (s *PostService) SearchTags(ctx context.Context, req *pbp.SearchTagsReq) (*pbp.Tags, error) {
+	prefix := hashtag.NormalizePrefix(req.Prefix)
+	if prefix == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid tag prefix")
+	}
+
+	tags, err := s.storage.Tag().Search(prefix, paging.Size(req.Limit))
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if tags == nil {
+		tags = []*pbp.Tag{}
+	}
+	return &pbp.Tags{Tags: tags}, nil
+}
+
+// GetTag returns how many posts use a hashtag
+func (s *PostService) GetTag(ctx context.Context, req *pbp.TagReq) (*pbp.Tag, error) {
+	name := hashtag.Normalize(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid tag")
+	}
+
+	tag, err := s.storage.Tag().Get(name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "tag not found")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return tag, nil
+}
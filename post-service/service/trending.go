@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	l "post-service/pkg/logger"
+	pbc "post-service/protos/comment-service"
+	pbp "post-service/protos/post-service"
+	"post-service/storage/repo"
+
+	"shared/auth"
+	"shared/paging"
+)
+
+const (
+	// trendingCandidates bounds how many of the newest posts are ranked
+	trendingCandidates = 10000
+	// trendingCountBatch is how many posts one comment count call asks for
+	trendingCountBatch = 500
+	// trendingTimeout bounds one ranking run
+	trendingTimeout = 5 * time.Minute
+)
+
+// weights of the kinds of engagement in a post's points
+const (
+	viewPoints     = 1
+	reactionPoints = 3
+	commentPoints  = 5
+)
+
+// GetTrending returns the posts of the last ranking run, best first
+func (s *PostService) GetTrending(ctx context.Context, req *pbp.GetTrendingReq) (*pbp.TrendingPosts, error) {
+	// only posts younger than TrendingMaxAge are ranked, wider windows are
+	// the whole ranking
+	window := time.Duration(req.WindowHours) * time.Hour
+	if maxAge := time.Duration(s.cfg.TrendingMaxAge) * time.Hour; window < 0 || window >= maxAge {
+		window = 0
+	}
+	limit := paging.Size(req.Limit)
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+
+	posts, rankedAt, err := s.storage.Trending().Trending(req.Category, window, limit, limit*(page-1))
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	viewerId, _ := auth.Subject(ctx)
+	hidden, err := s.hiddenUsers(ctx, viewerId)
+	if err != nil {
+		return nil, err
+	}
+	owners := make([]string, 0, len(posts))
+	for _, p := range posts {
+		owners = append(owners, p.Post.OwnerId)
+	}
+	visible, err := s.visibleOwners(ctx, owners)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pbp.TrendingPosts{Posts: []*pbp.TrendingPost{}}
+	for _, p := range posts {
+		if !hidden[p.Post.OwnerId] && visible[p.Post.OwnerId] {
+			res.Posts = append(res.Posts, p)
+		}
+	}
+	if rankedAt != nil {
+		res.RankedAt = rankedAt.UTC().Format(time.RFC3339)
+	}
+	return res, nil
+}
+
+// RankTrending scores the recent posts every TrendingInterval minutes, until
+// ctx is done
+func (s *PostService) RankTrending(ctx context.Context) {
+	interval := time.Duration(s.cfg.TrendingInterval) * time.Minute
+	if interval <= 0 {
+		s.logger.Info("trending job is disabled")
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.rankTrending(ctx); err != nil {
+			s.logger.Error("failed to rank trending posts", l.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// rankTrending keeps the previous ranking when any step fails
+func (s *PostService) rankTrending(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, trendingTimeout)
+	defer cancel()
+
+	maxAge := time.Duration(s.cfg.TrendingMaxAge) * time.Hour
+	candidates, err := s.storage.Trending().Candidates(maxAge, trendingCandidates)
+	if err != nil {
+		return err
+	}
+
+	comments := map[string]int64{}
+	for start := 0; start < len(candidates); start += trendingCountBatch {
+		end := start + trendingCountBatch
+		if end > len(candidates) {
+			end = len(candidates)
+		}
+		ids := make([]string, 0, end-start)
+		for _, c := range candidates[start:end] {
+			ids = append(ids, c.PostId)
+		}
+		res, err := s.client.CommentService().CountComments(ctx, &pbc.CountCommentsReq{PostIds: ids})
+		if err != nil {
+			return err
+		}
+		for _, count := range res.Counts {
+			comments[count.PostId] = count.Count
+		}
+	}
+
+	now := time.Now()
+	scores := make([]*repo.PostScore, 0, len(candidates))
+	for _, c := range candidates {
+		scores = append(scores, &repo.PostScore{
+			PostId: c.PostId,
+			Score:  trendingScore(c, comments[c.PostId], s.cfg.TrendingDislikePoints, s.cfg.TrendingGravity, now),
+		})
+	}
+	return s.storage.Trending().Replace(scores)
+}
+
+// trendingScore is the gravity formula of Hacker News: the post's points are
+// divided by its age in hours plus two to the power of gravity, so newer
+// posts need less engagement to rank as high. Dislikes are worth
+// dislikePoints each, which is zero or less, and a post never has less than
+// zero points.
+func trendingScore(c *repo.TrendingCandidate, comments int64, dislikePoints, gravity float64, now time.Time) float64 {
+	points := float64(c.Views*viewPoints+c.Likes*reactionPoints+comments*commentPoints) +
+		float64(c.Dislikes)*math.Min(dislikePoints, 0)
+	if points < 0 {
+		points = 0
+	}
+	age := now.Sub(c.CreatedAt).Hours()
+	if age < 0 {
+		age = 0
+	}
+	return points / math.Pow(age+2, gravity)
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"post-service/storage/repo"
+)
+
+func TestTrendingScore(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		candidate     repo.TrendingCandidate
+		comments      int64
+		dislikePoints float64
+		gravity       float64
+		want          float64
+	}{
+		{
+			name:      "points over age plus two",
+			candidate: repo.TrendingCandidate{Views: 10, Likes: 2, CreatedAt: now.Add(-2 * time.Hour)},
+			comments:  1,
+			gravity:   1,
+			// 10*1 + 2*3 + 1*5 over (2+2)^1
+			want: 21.0 / 4,
+		},
+		{
+			name:      "gravity",
+			candidate: repo.TrendingCandidate{Views: 64, CreatedAt: now.Add(-6 * time.Hour)},
+			gravity:   2,
+			want:      1,
+		},
+		{
+			name:      "dislikes are ignored by default",
+			candidate: repo.TrendingCandidate{Likes: 1, Dislikes: 50, CreatedAt: now},
+			gravity:   1,
+			want:      3.0 / 2,
+		},
+		{
+			name:          "dislikes count against the post",
+			candidate:     repo.TrendingCandidate{Views: 10, Likes: 1, Dislikes: 2, CreatedAt: now},
+			dislikePoints: -3,
+			gravity:       1,
+			want:          7.0 / 2,
+		},
+		{
+			name:          "a positive dislike weight is treated as zero",
+			candidate:     repo.TrendingCandidate{Likes: 1, Dislikes: 2, CreatedAt: now},
+			dislikePoints: 5,
+			gravity:       1,
+			want:          3.0 / 2,
+		},
+		{
+			name:          "never below zero",
+			candidate:     repo.TrendingCandidate{Views: 1, Dislikes: 10, CreatedAt: now.Add(-time.Hour)},
+			dislikePoints: -3,
+			gravity:       1.8,
+			want:          0,
+		},
+		{
+			name:      "posts from the future are as old as new ones",
+			candidate: repo.TrendingCandidate{Views: 4, CreatedAt: now.Add(time.Hour)},
+			gravity:   1,
+			want:      2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := trendingScore(&tt.candidate, tt.comments, tt.dislikePoints, tt.gravity, now)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Fatalf("trendingScore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrendingScoreRanksDislikedBelowLiked(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	liked := &repo.TrendingCandidate{Views: 100, Likes: 20, CreatedAt: now.Add(-time.Hour)}
+	disliked := &repo.TrendingCandidate{Views: 100, Likes: 2, Dislikes: 18, CreatedAt: now.Add(-time.Hour)}
+
+	for _, dislikePoints := range []float64{0, -1, -3} {
+		if trendingScore(disliked, 0, dislikePoints, 1.8, now) >= trendingScore(liked, 0, dislikePoints, 1.8, now) {
+			t.Errorf("with dislikes worth %v a disliked post ranks as high as a liked one", dislikePoints)
+		}
+	}
+}
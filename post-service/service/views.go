@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	l "post-service/pkg/logger"
+	pbp "post-service/protos/post-service"
+
+	"shared/auth"
+)
+
+// viewCounter counts each viewer once per post within a window and keeps
+// the counted views in memory until they are flushed, so a hot post costs
+// one write per flush instead of one per view. The viewers seen are only
+// known to this instance, with several replicas a viewer may be counted
+// once by each.
+type viewCounter struct {
+	mu      sync.Mutex
+	window  time.Duration
+	now     func() time.Time
+	seen    map[string]time.Time
+	pending map[string]int64
+}
+
+func newViewCounter(window time.Duration) *viewCounter {
+	return &viewCounter{
+		window:  window,
+		now:     time.Now,
+		seen:    map[string]time.Time{},
+		pending: map[string]int64{},
+	}
+}
+
+// count adds a view of the post unless the viewer was counted on it within
+// the window
+func (v *viewCounter) count(postId, viewer string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	key := postId + "|" + viewer
+	now := v.now()
+	if at, ok := v.seen[key]; ok && now.Sub(at) < v.window {
+		return
+	}
+	v.seen[key] = now
+	v.pending[postId]++
+}
+
+// pendingFor returns the views of the post not flushed yet
+func (v *viewCounter) pendingFor(postId string) int64 {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	return v.pending[postId]
+}
+
+// take hands out the views counted since the last flush and forgets the
+// viewers whose window is over
+func (v *viewCounter) take() map[string]int64 {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	now := v.now()
+	for key, at := range v.seen {
+		if now.Sub(at) >= v.window {
+			delete(v.seen, key)
+		}
+	}
+	views := v.pending
+	v.pending = map[string]int64{}
+	return views
+}
+
+// putBack returns views whose flush failed, they go out with the next one
+func (v *viewCounter) putBack(views map[string]int64) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for id, n := range views {
+		v.pending[id] += n
+	}
+}
+
+// countView counts the caller's view of a post. Owners viewing their own
+// posts and calls that carry neither a user nor an ip are not counted.
+func (s *PostService) countView(ctx context.Context, post *pbp.Post, clientIp string) {
+	viewer := ""
+	if userId, _ := auth.Subject(ctx); userId != "" {
+		if userId == post.OwnerId {
+			return
+		}
+		viewer = "user:" + userId
+	} else if clientIp != "" {
+		viewer = "ip:" + clientIp
+	}
+	if viewer == "" {
+		return
+	}
+	s.views.count(post.Id, viewer)
+}
+
+// FlushViews writes the counted views to the database every
+// ViewFlushInterval seconds, and once more when ctx is done
+func (s *PostService) FlushViews(ctx context.Context) {
+	interval := time.Duration(s.cfg.ViewFlushInterval) * time.Second
+	if interval <= 0 {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.flushViews()
+			return
+		case <-ticker.C:
+			s.flushViews()
+		}
+	}
+}
+
+func (s *PostService) flushViews() {
+	views := s.views.take()
+	if len(views) == 0 {
+		return
+	}
+	if err := s.storage.Post().AddViews(views); err != nil {
+		s.logger.Error("failed to flush views", l.Int("posts", len(views)), l.Error(err))
+		s.views.putBack(views)
+	}
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"post-service/config"
+	pbp "post-service/protos/post-service"
+)
+
+// testClock is a clock the test moves by hand
+type testClock struct {
+	at time.Time
+}
+
+func (c *testClock) now() time.Time          { return c.at }
+func (c *testClock) advance(d time.Duration) { c.at = c.at.Add(d) }
+
+func newTestViewCounter(window time.Duration) (*viewCounter, *testClock) {
+	clock := &testClock{at: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)}
+	v := newViewCounter(window)
+	v.now = clock.now
+	return v, clock
+}
+
+func TestViewCounterWindow(t *testing.T) {
+	v, clock := newTestViewCounter(10 * time.Minute)
+
+	v.count("post-1", "user:a")
+	v.count("post-1", "user:a")
+	v.count("post-1", "ip:10.0.0.1")
+	v.count("post-2", "user:a")
+	if got := v.pendingFor("post-1"); got != 2 {
+		t.Fatalf("post-1 has %d views, want 2", got)
+	}
+
+	// just inside the window the viewer is still known
+	clock.advance(10*time.Minute - time.Nanosecond)
+	v.count("post-1", "user:a")
+	if got := v.pendingFor("post-1"); got != 2 {
+		t.Fatalf("a repeat view inside the window was counted, %d views", got)
+	}
+
+	// the window runs from the counted view, not from the repeats
+	clock.advance(time.Nanosecond)
+	v.count("post-1", "user:a")
+	if got := v.pendingFor("post-1"); got != 3 {
+		t.Fatalf("a view after the window was not counted, %d views", got)
+	}
+}
+
+func TestViewCounterTakeForgetsExpiredViewers(t *testing.T) {
+	v, clock := newTestViewCounter(10 * time.Minute)
+
+	v.count("post-1", "user:a")
+	clock.advance(5 * time.Minute)
+	v.count("post-1", "user:b")
+
+	if got := v.take(); !reflect.DeepEqual(got, map[string]int64{"post-1": 2}) {
+		t.Fatalf("take() = %v", got)
+	}
+	if got := v.take(); len(got) != 0 {
+		t.Fatalf("second take() = %v, want nothing", got)
+	}
+
+	clock.advance(5 * time.Minute)
+	v.take()
+	if _, ok := v.seen["post-1|user:a"]; ok {
+		t.Fatal("a viewer whose window is over is still kept")
+	}
+	if _, ok := v.seen["post-1|user:b"]; !ok {
+		t.Fatal("a viewer inside the window was forgotten")
+	}
+	// which keeps counting b out until its own window is over
+	v.count("post-1", "user:b")
+	v.count("post-1", "user:a")
+	if got := v.pendingFor("post-1"); got != 1 {
+		t.Fatalf("%d views, want 1", got)
+	}
+}
+
+func TestFlushViewsBatches(t *testing.T) {
+	posts := newFakePostRepo(&pbp.Post{Id: "post-1", Views: 10}, &pbp.Post{Id: "post-2"})
+	s := newTestService(&fakeStorage{post: posts}, &fakeUserService{}, config.Config{})
+
+	for _, viewer := range []string{"user:a", "user:b", "user:c"} {
+		s.views.count("post-1", viewer)
+	}
+	s.views.count("post-2", "user:a")
+
+	s.flushViews()
+	s.flushViews()
+	if len(posts.flushes) != 1 {
+		t.Fatalf("%d writes, want 1 for all the views and none without views", len(posts.flushes))
+	}
+	if want := map[string]int64{"post-1": 3, "post-2": 1}; !reflect.DeepEqual(posts.flushes[0], want) {
+		t.Fatalf("flushed %v, want %v", posts.flushes[0], want)
+	}
+	if posts.posts["post-1"].Views != 13 {
+		t.Fatalf("post-1 has %d views, want 13", posts.posts["post-1"].Views)
+	}
+}
+
+func TestFlushViewsKeepsFailedBatch(t *testing.T) {
+	posts := newFakePostRepo(&pbp.Post{Id: "post-1"})
+	posts.addViewsErr = errors.New("connection reset")
+	s := newTestService(&fakeStorage{post: posts}, &fakeUserService{}, config.Config{})
+
+	s.views.count("post-1", "user:a")
+	s.flushViews()
+	if got := s.views.pendingFor("post-1"); got != 1 {
+		t.Fatalf("%d views pending after a failed flush, want 1", got)
+	}
+
+	s.views.count("post-1", "user:b")
+	posts.addViewsErr = nil
+	s.flushViews()
+	if last := posts.flushes[len(posts.flushes)-1]; last["post-1"] != 2 {
+		t.Fatalf("retried flush wrote %v, want 2 views", last)
+	}
+	if got := s.views.pendingFor("post-1"); got != 0 {
+		t.Fatalf("%d views still pending", got)
+	}
+}
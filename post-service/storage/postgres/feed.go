@@ -0,0 +1,202 @@
+package postgres
+
+import (
+	"time"
+
+	pbp "post-service/protos/post-service"
+	"post-service/storage/repo"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type feedRepo struct {
+	db *sqlx.DB
+}
+
+// NewFeedRepo ...
+func NewFeedRepo(db *sqlx.DB) *feedRepo {
+	return &feedRepo{db: db}
+}
+
+func (r *feedRepo) FanOut(postId string, followerIds []string) error {
+	if len(followerIds) == 0 {
+		return nil
+	}
+	query := `
+	INSERT INTO timelines (
+		user_id,
+		post_id,
+		author_id,
+		created_at
+	)
+	SELECT
+		f.id,
+		p.id,
+		p.owner_id,
+		p.created_at
+	FROM
+		posts p,
+		unnest($2::UUID[]) AS f(id)
+	WHERE
+		p.id = $1
+	AND
+		p.deleted_at IS NULL
+	ON CONFLICT DO NOTHING
+	`
+	_, err := r.db.Exec(query, postId, pq.Array(followerIds))
+	return err
+}
+
+func (r *feedRepo) MarkFanIn(authorId string) error {
+	query := `
+	INSERT INTO fanin_authors (author_id) VALUES ($1) ON CONFLICT DO NOTHING
+	`
+	_, err := r.db.Exec(query, authorId)
+	return err
+}
+
+// Feed reads one page from each source and keeps the newest of both. A post
+// of an author who became fan-in after it was fanned out is in both, the
+// UNION keeps it once.
+func (r *feedRepo) Feed(userId string, followeeIds []string, after *repo.Cursor, limit int64) ([]*repo.FeedPost, error) {
+	args := []interface{}{userId, pq.Array(followeeIds), limit}
+	timelineKeyset, postsKeyset := "", ""
+	if after != nil {
+		args = append(args, after.CreatedAt, after.Id)
+		timelineKeyset = "AND (t.created_at, t.post_id) < ($4, $5)"
+		postsKeyset = "AND (f.created_at, f.id) < ($4, $5)"
+	}
+
+	query := `
+	SELECT
+		p.id,
+		p.content,
+		p.title,
+		p.views,
+		p.category,
+		p.owner_id,
+		p.created_at,
+		p.updated_at,
+		p.likes,
+		p.dislikes
+	FROM
+		posts p
+	WHERE
+		p.deleted_at IS NULL
+	AND
+		p.id IN (
+			(
+				SELECT
+					t.post_id
+				FROM
+					timelines t
+				JOIN
+					posts tp ON tp.id = t.post_id AND tp.deleted_at IS NULL
+				WHERE
+					t.user_id = $1
+				` + timelineKeyset + `
+				ORDER BY
+					t.created_at DESC,
+					t.post_id DESC
+				LIMIT $3
+			)
+			UNION
+			(
+				SELECT
+					f.id
+				FROM
+					posts f
+				WHERE
+					f.owner_id IN (
+						SELECT author_id FROM fanin_authors WHERE author_id = ANY($2::UUID[])
+					)
+				AND
+					f.deleted_at IS NULL
+				` + postsKeyset + `
+				ORDER BY
+					f.created_at DESC,
+					f.id DESC
+				LIMIT $3
+			)
+		)
+	ORDER BY
+		p.created_at DESC,
+		p.id DESC
+	LIMIT $3
+	`
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var posts []*repo.FeedPost
+	for rows.Next() {
+		var (
+			post      pbp.Post
+			createdAt time.Time
+		)
+		if err := rows.Scan(
+			&post.Id,
+			&post.Content,
+			&post.Title,
+			&post.Views,
+			&post.Category,
+			&post.OwnerId,
+			&createdAt,
+			&post.UpdatedAt,
+			&post.Likes,
+			&post.Dislikes,
+		); err != nil {
+			return nil, err
+		}
+		post.CreatedAt = timestampText(createdAt)
+		posts = append(posts, &repo.FeedPost{Post: &post, CreatedAt: createdAt})
+	}
+	return posts, rows.Err()
+}
+
+func (r *feedRepo) Rebuild(userId string, followeeIds []string, size int64) (int64, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM timelines WHERE user_id = $1`, userId); err != nil {
+		return 0, err
+	}
+	res, err := tx.Exec(`
+	INSERT INTO timelines (
+		user_id,
+		post_id,
+		author_id,
+		created_at
+	)
+	SELECT
+		$1::UUID,
+		id,
+		owner_id,
+		created_at
+	FROM
+		posts
+	WHERE
+		owner_id = ANY($2::UUID[])
+	AND
+		owner_id NOT IN (SELECT author_id FROM fanin_authors)
+	AND
+		deleted_at IS NULL
+	ORDER BY
+		created_at DESC
+	LIMIT $3
+	`, userId, pq.Array(followeeIds), size)
+	if err != nil {
+		return 0, err
+	}
+	added, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return added, tx.Commit()
+}
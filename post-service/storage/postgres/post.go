@@ -1,12 +1,22 @@
 package postgres
 
 import (
+	"time"
+
 	pbp "post-service/protos/post-service"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
+// timestampText is how a timestamp read as a time.Time is put in a post. It
+// is the format database/sql writes a timestamp scanned into a string in,
+// so posts look the same whichever query they come from.
+func timestampText(t time.Time) string {
+	return t.Format(time.RFC3339Nano)
+}
+
 type postRepo struct {
 	db *sqlx.DB
 }
@@ -16,18 +26,23 @@ func NewPostRepo(db *sqlx.DB) *postRepo {
 	return &postRepo{db: db}
 }
 
-func (r *postRepo) Create(post *pbp.Post) (*pbp.Post, error) {
+// Create writes the post and files it under tags in one transaction
+func (r *postRepo) Create(post *pbp.Post, tags []string) (*pbp.Post, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
 
 	query := `
 		INSERT INTO posts (
 			id, 
 			content, 
 			title, 
-			views,
 			category,
 			owner_id
 		) 
-		VALUES ($1, $2, $3, $4, $5, $6)
+		VALUES ($1, $2, $3, $4, $5)
 		RETURNING 
 			id, 
 			content, 
@@ -36,26 +51,42 @@ func (r *postRepo) Create(post *pbp.Post) (*pbp.Post, error) {
 			category,
 			owner_id,
 			created_at,
-			updated_at
+			updated_at,
+			likes,
+			dislikes
 	`
 
 	id := uuid.New().String()
-	if err := r.db.QueryRow(query, id, post.Content, post.Title, 0, post.Views, post.Category, post.OwnerId).Scan(
-		&post.Id,
-		&post.Content,
-		&post.Title,
-		&post.Views,
-		&post.Category,
-		&post.OwnerId,
-		&post.CreatedAt,
-		&post.UpdatedAt,
+	var response pbp.Post
+	if err := tx.QueryRow(query, id, post.Content, post.Title, post.Category, post.OwnerId).Scan(
+		&response.Id,
+		&response.Content,
+		&response.Title,
+		&response.Views,
+		&response.Category,
+		&response.OwnerId,
+		&response.CreatedAt,
+		&response.UpdatedAt,
+		&response.Likes,
+		&response.Dislikes,
 	); err != nil {
 		return nil, err
 	}
-	return post, nil
+	if err := setPostTags(tx, response.Id, tags); err != nil {
+		return nil, err
+	}
+	return &response, tx.Commit()
 }
 
-func (r *postRepo) Update(post *pbp.Post) (*pbp.Post, error) {
+// Update changes the post and replaces the tags it is filed under in one
+// transaction
+func (r *postRepo) Update(post *pbp.Post, tags []string) (*pbp.Post, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
 	query := `
 	UPDATE
     	posts
@@ -63,11 +94,10 @@ func (r *postRepo) Update(post *pbp.Post) (*pbp.Post, error) {
     	updated_at = CURRENT_TIMESTAMP,
     	content = $1, 
     	title = $2, 
-    	views = $3,
-    	category = $4,
-    	owner_id = $5
+    	category = $3,
+    	owner_id = $4
 	WHERE
-    	id = $6
+    	id = $5
     AND 
 		deleted_at IS NULL
 	RETURNING
@@ -78,10 +108,12 @@ func (r *postRepo) Update(post *pbp.Post) (*pbp.Post, error) {
     	category,
     	owner_id,
     	created_at,
-    	updated_at
+    	updated_at,
+    	likes,
+    	dislikes
 	`
 	var response pbp.Post
-	if err := r.db.QueryRow(query, post.Content, post.Title, post.Views, post.Category, post.OwnerId, post.Id).Scan(
+	if err := tx.QueryRow(query, post.Content, post.Title, post.Category, post.OwnerId, post.Id).Scan(
 		&response.Id,
 		&response.Content,
 		&response.Title,
@@ -90,10 +122,15 @@ func (r *postRepo) Update(post *pbp.Post) (*pbp.Post, error) {
 		&response.OwnerId,
 		&response.CreatedAt,
 		&response.UpdatedAt,
+		&response.Likes,
+		&response.Dislikes,
 	); err != nil {
 		return nil, err
 	}
-	return &response, nil
+	if err := setPostTags(tx, response.Id, tags); err != nil {
+		return nil, err
+	}
+	return &response, tx.Commit()
 }
 
 func (r *postRepo) Delete(post *pbp.GetRequest) (*pbp.CheckResponse, error) {
@@ -135,7 +172,9 @@ func (r *postRepo) GetPost(post *pbp.GetRequest) (*pbp.Post, error) {
 		category,
 		owner_id,
 		created_at,
-		updated_at
+		updated_at,
+		likes,
+		dislikes
 	FROM 
 		posts
 	WHERE 
@@ -153,6 +192,8 @@ func (r *postRepo) GetPost(post *pbp.GetRequest) (*pbp.Post, error) {
 		&respPost.OwnerId,
 		&respPost.CreatedAt,
 		&respPost.UpdatedAt,
+		&respPost.Likes,
+		&respPost.Dislikes,
 	); err != nil {
 		return nil, err
 	}
@@ -170,7 +211,9 @@ func (r *postRepo) GetAllPosts(post *pbp.GetAllPostsRequest) (*pbp.GetPostsByOwn
 		category,
 		owner_id,
 		created_at,
-		updated_at
+		updated_at,
+		likes,
+		dislikes
 	FROM
 		posts
 	WHERE
@@ -194,6 +237,8 @@ func (r *postRepo) GetAllPosts(post *pbp.GetAllPostsRequest) (*pbp.GetPostsByOwn
 			&post.OwnerId,
 			&post.CreatedAt,
 			&post.UpdatedAt,
+			&post.Likes,
+			&post.Dislikes,
 		); err != nil {
 			return nil, err
 		}
@@ -212,7 +257,9 @@ func (r *postRepo) GetPostsByOwnerId(req *pbp.GetPostsByOwnerIdRequest) (*pbp.Ge
 		category,
 		owner_id,
 		created_at,
-		updated_at
+		updated_at,
+		likes,
+		dislikes
 	FROM 
 		posts 
 	WHERE 
@@ -234,6 +281,8 @@ func (r *postRepo) GetPostsByOwnerId(req *pbp.GetPostsByOwnerIdRequest) (*pbp.Ge
 			&post.OwnerId,
 			&post.CreatedAt,
 			&post.UpdatedAt,
+			&post.Likes,
+			&post.Dislikes,
 		); err != nil {
 			return nil, err
 		}
@@ -242,3 +291,170 @@ func (r *postRepo) GetPostsByOwnerId(req *pbp.GetPostsByOwnerIdRequest) (*pbp.Ge
 
 	return &response, nil
 }
+
+// AddViews adds counted views to the posts in one statement, views maps
+// post ids to the number of new views
+func (r *postRepo) AddViews(views map[string]int64) error {
+	if len(views) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(views))
+	counts := make([]int64, 0, len(views))
+	for id, n := range views {
+		ids = append(ids, id)
+		counts = append(counts, n)
+	}
+
+	query := `
+	UPDATE
+		posts p
+	SET
+		views = p.views + v.n
+	FROM
+		unnest($1::UUID[], $2::BIGINT[]) AS v(id, n)
+	WHERE
+		p.id = v.id
+	`
+	_, err := r.db.Exec(query, pq.Array(ids), pq.Array(counts))
+	return err
+}
+
+// CategoryNeighbours returns the owners who posted in the categories ownerId
+// posts in, scored by the number of shared categories
+func (r *postRepo) CategoryNeighbours(ownerId string, limit int64) ([]*pbp.UserScore, error) {
+	query := `
+	SELECT
+		owner_id,
+		COUNT(DISTINCT category) AS shared
+	FROM
+		posts
+	WHERE
+		category IN (
+			SELECT category FROM posts WHERE owner_id = $1 AND deleted_at IS NULL
+		)
+	AND
+		owner_id <> $1
+	AND
+		deleted_at IS NULL
+	GROUP BY
+		owner_id
+	ORDER BY
+		shared DESC
+	LIMIT $2
+	`
+	rows, err := r.db.Query(query, ownerId, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var scores []*pbp.UserScore
+	for rows.Next() {
+		var score pbp.UserScore
+		if err := rows.Scan(&score.UserId, &score.Score); err != nil {
+			return nil, err
+		}
+		scores = append(scores, &score)
+	}
+	return scores, rows.Err()
+}
+
+// OwnerPostIds returns the ids of every post ownerId wrote, deleted or not
+func (r *postRepo) OwnerPostIds(ownerId string) ([]string, error) {
+	query := `
+	SELECT id FROM posts WHERE owner_id = $1
+	`
+	var ids []string
+	if err := r.db.Select(&ids, query, ownerId); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Purge removes every post ownerId wrote, deleted or not, with their
+// reactions, tags and timeline entries, and takes the reactions ownerId left
+// elsewhere off the counts
+func (r *postRepo) Purge(ownerId string) (int64, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+	WITH removed AS (
+		DELETE FROM
+			post_reactions
+		WHERE
+			user_id = $1
+		RETURNING
+			post_id,
+			reaction
+	)
+	UPDATE
+		posts p
+	SET
+		likes = p.likes - c.likes,
+		dislikes = p.dislikes - c.dislikes
+	FROM (
+		SELECT
+			post_id,
+			COUNT(*) FILTER (WHERE reaction = 'like') AS likes,
+			COUNT(*) FILTER (WHERE reaction = 'dislike') AS dislikes
+		FROM
+			removed
+		GROUP BY
+			post_id
+	) c
+	WHERE
+		p.id = c.post_id
+	`, ownerId)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(`
+	DELETE FROM
+		post_reactions
+	WHERE
+		post_id IN (SELECT id FROM posts WHERE owner_id = $1)
+	`, ownerId)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(`
+	DELETE FROM
+		post_tags
+	WHERE
+		post_id IN (SELECT id FROM posts WHERE owner_id = $1)
+	`, ownerId)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.Exec(`
+	DELETE FROM timelines WHERE user_id = $1 OR author_id = $1
+	`, ownerId)
+	if err != nil {
+		return 0, err
+	}
+	_, err = tx.Exec(`
+	DELETE FROM fanin_authors WHERE author_id = $1
+	`, ownerId)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := tx.Exec(`
+	DELETE FROM posts WHERE owner_id = $1
+	`, ownerId)
+	if err != nil {
+		return 0, err
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return deleted, tx.Commit()
+}
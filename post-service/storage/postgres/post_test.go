@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	pbp "post-service/protos/post-service"
+
+	"shared/fakedb"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// newFakeDB returns a sqlx.DB whose statements get results in order
+func newFakeDB(t *testing.T, results ...fakedb.Result) (*sqlx.DB, *fakedb.DB) {
+	db, fake := fakedb.Open(t, results...)
+	return sqlx.NewDb(db, "postgres"), fake
+}
+
+func TestCreateReturnsStoredPost(t *testing.T) {
+	db, fake := newFakeDB(t,
+		fakedb.Result{
+			Columns: []string{"id", "content", "title", "views", "category", "owner_id", "created_at", "updated_at", "likes", "dislikes"},
+			Rows: [][]driver.Value{
+				{"post-1", "hello #go", "Hello", int64(0), "tech", "user-1", "2024-05-01T10:00:00Z", "2024-05-01T10:00:00Z", int64(0), int64(0)},
+			},
+		},
+		fakedb.Result{}, fakedb.Result{}, fakedb.Result{},
+	)
+
+	req := &pbp.Post{Content: "hello #go", Title: "Hello", Category: "tech", OwnerId: "user-1", Likes: 7, Dislikes: 3}
+	post, err := NewPostRepo(db).Create(req, []string{"go"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if post == req {
+		t.Fatal("Create returned the request instead of the stored post")
+	}
+	if post.Id != "post-1" || post.OwnerId != "user-1" || post.CreatedAt == "" {
+		t.Fatalf("post = %v", post)
+	}
+	// the counts of a new post are what the database has, not what the
+	// client sent
+	if post.Likes != 0 || post.Dislikes != 0 {
+		t.Fatalf("likes %d, dislikes %d, want 0 and 0", post.Likes, post.Dislikes)
+	}
+	if req.Id != "" || req.Likes != 7 {
+		t.Fatalf("the request was changed: %v", req)
+	}
+	if !strings.Contains(fake.Queries[0], "likes,") || !strings.Contains(fake.Queries[0], "dislikes") {
+		t.Fatalf("likes and dislikes are not returned:\n%s", fake.Queries[0])
+	}
+	// the tags are filed under the id the database returned
+	if fake.Args[2][0] != "post-1" {
+		t.Fatalf("tags filed under %v", fake.Args[2][0])
+	}
+}
+
+func TestPostTimestampsMatchAcrossQueries(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 123456000, time.UTC)
+	row := []driver.Value{"post-1", "hello #go", "Hello", int64(0), "tech", "user-1", createdAt, createdAt, int64(0), int64(0)}
+	columns := []string{"id", "content", "title", "views", "category", "owner_id", "created_at", "updated_at", "likes", "dislikes"}
+
+	db, _ := newFakeDB(t,
+		fakedb.Result{Columns: columns, Rows: [][]driver.Value{row}},
+		fakedb.Result{Columns: columns, Rows: [][]driver.Value{row}},
+		fakedb.Result{Columns: columns, Rows: [][]driver.Value{row}},
+	)
+	post, err := NewPostRepo(db).GetPost(&pbp.GetRequest{PostId: "post-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tagged, err := NewTagRepo(db).PostsByTag("go", nil, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	feed, err := NewFeedRepo(db).Feed("user-2", []string{"user-1"}, nil, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if post.CreatedAt != "2024-05-01T10:00:00.123456Z" {
+		t.Fatalf("GetPost created_at = %q", post.CreatedAt)
+	}
+	for name, got := range map[string]*pbp.Post{"tag": tagged[0].Post, "feed": feed[0].Post} {
+		if got.CreatedAt != post.CreatedAt || got.UpdatedAt != post.UpdatedAt {
+			t.Errorf("%s posts carry %q and %q, GetPost %q and %q", name, got.CreatedAt, got.UpdatedAt, post.CreatedAt, post.UpdatedAt)
+		}
+	}
+	if !tagged[0].CreatedAt.Equal(createdAt) || !feed[0].CreatedAt.Equal(createdAt) {
+		t.Fatal("the time the listings are ordered by was lost")
+	}
+}
@@ -0,0 +1,203 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	pbp "post-service/protos/post-service"
+	"post-service/storage/repo"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type reactionRepo struct {
+	db *sqlx.DB
+}
+
+// NewReactionRepo ...
+func NewReactionRepo(db *sqlx.DB) *reactionRepo {
+	return &reactionRepo{db: db}
+}
+
+func (r *reactionRepo) React(postId, userId, reaction string) (*pbp.Reactions, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	previous, err := lockReaction(tx, postId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if previous != reaction {
+		_, err = tx.Exec(`
+		INSERT INTO post_reactions (
+			post_id,
+			user_id,
+			reaction
+		)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (post_id, user_id) DO UPDATE SET
+			reaction = EXCLUDED.reaction,
+			created_at = CURRENT_TIMESTAMP
+		`, postId, userId, reaction)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := countReaction(tx, postId, previous, reaction)
+	if err != nil {
+		return nil, err
+	}
+	res.Reaction = reaction
+	return res, tx.Commit()
+}
+
+func (r *reactionRepo) Unreact(postId, userId string) (*pbp.Reactions, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	previous, err := lockReaction(tx, postId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if previous != "" {
+		_, err = tx.Exec(`
+		DELETE FROM
+			post_reactions
+		WHERE
+			post_id = $1
+		AND
+			user_id = $2
+		`, postId, userId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := countReaction(tx, postId, previous, "")
+	if err != nil {
+		return nil, err
+	}
+	return res, tx.Commit()
+}
+
+func (r *reactionRepo) List(postId, reaction string, after *repo.Cursor, limit int64) ([]*repo.Reaction, error) {
+	args := []interface{}{postId, reaction, limit}
+	keyset := ""
+	if after != nil {
+		args = append(args, after.CreatedAt, after.Id)
+		keyset = "AND (r.created_at, r.user_id) < ($4, $5)"
+	}
+
+	query := `
+	SELECT
+		r.user_id,
+		r.reaction,
+		r.created_at
+	FROM
+		post_reactions r
+	JOIN
+		posts p ON p.id = r.post_id
+	WHERE
+		r.post_id = $1
+	AND
+		($2 = '' OR r.reaction = $2)
+	AND
+		p.deleted_at IS NULL
+	` + keyset + `
+	ORDER BY
+		r.created_at DESC,
+		r.user_id DESC
+	LIMIT $3
+	`
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reactions []*repo.Reaction
+	for rows.Next() {
+		var (
+			reaction  repo.Reaction
+			reactedAt time.Time
+		)
+		if err := rows.Scan(&reaction.UserId, &reaction.Reaction, &reactedAt); err != nil {
+			return nil, err
+		}
+		reaction.ReactedAt = reactedAt
+		reactions = append(reactions, &reaction)
+	}
+	return reactions, rows.Err()
+}
+
+// lockReaction locks the post, so changes of its counts are made one at a
+// time, and returns the reaction the user has on it or "" for none
+func lockReaction(tx *sql.Tx, postId, userId string) (string, error) {
+	var id string
+	err := tx.QueryRow(`
+	SELECT
+		id
+	FROM
+		posts
+	WHERE
+		id = $1
+	AND
+		deleted_at IS NULL
+	FOR UPDATE
+	`, postId).Scan(&id)
+	if err != nil {
+		return "", err
+	}
+
+	var reaction string
+	err = tx.QueryRow(`
+	SELECT
+		reaction
+	FROM
+		post_reactions
+	WHERE
+		post_id = $1
+	AND
+		user_id = $2
+	`, postId, userId).Scan(&reaction)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	return reaction, err
+}
+
+// countReaction moves one reaction of the post from previous to current in
+// its counts, either may be "" for no reaction
+func countReaction(tx *sql.Tx, postId, previous, current string) (*pbp.Reactions, error) {
+	delta := map[string]int64{}
+	delta[previous]--
+	delta[current]++
+
+	res := pbp.Reactions{PostId: postId}
+	err := tx.QueryRow(`
+	UPDATE
+		posts
+	SET
+		likes = likes + $2,
+		dislikes = dislikes + $3
+	WHERE
+		id = $1
+	RETURNING
+		likes,
+		dislikes
+	`, postId, delta[repo.ReactionLike], delta[repo.ReactionDislike]).Scan(&res.Likes, &res.Dislikes)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"testing"
+
+	pbp "post-service/protos/post-service"
+
+	"shared/fakedb"
+)
+
+func TestReactAndUnreact(t *testing.T) {
+	post := fakedb.Result{Columns: []string{"id"}, Rows: [][]driver.Value{{"post-1"}}}
+	none := fakedb.Result{Columns: []string{"reaction"}}
+	had := func(reaction string) fakedb.Result {
+		return fakedb.Result{Columns: []string{"reaction"}, Rows: [][]driver.Value{{reaction}}}
+	}
+	counts := fakedb.Result{Columns: []string{"likes", "dislikes"}, Rows: [][]driver.Value{{int64(4), int64(2)}}}
+
+	tests := []struct {
+		name     string
+		reaction string // "" unreacts
+		results  []fakedb.Result
+		// the statement changing post_reactions, "" when none should run
+		wantWrite string
+		// the likes and dislikes deltas of the counts update
+		wantDelta [2]int64
+		wantErr   error
+	}{
+		{name: "first like", reaction: "like", results: []fakedb.Result{post, none, {}, counts}, wantWrite: "INSERT INTO post_reactions", wantDelta: [2]int64{1, 0}},
+		{name: "like to dislike", reaction: "dislike", results: []fakedb.Result{post, had("like"), {}, counts}, wantWrite: "INSERT INTO post_reactions", wantDelta: [2]int64{-1, 1}},
+		{name: "same reaction again", reaction: "like", results: []fakedb.Result{post, had("like"), counts}, wantDelta: [2]int64{0, 0}},
+		{name: "unreact a dislike", results: []fakedb.Result{post, had("dislike"), {}, counts}, wantWrite: "DELETE FROM", wantDelta: [2]int64{0, -1}},
+		{name: "unreact without a reaction", results: []fakedb.Result{post, none, counts}, wantDelta: [2]int64{0, 0}},
+		{name: "react on a deleted post", reaction: "like", results: []fakedb.Result{{Columns: []string{"id"}}}, wantErr: sql.ErrNoRows},
+		{name: "unreact on a deleted post", results: []fakedb.Result{{Columns: []string{"id"}}}, wantErr: sql.ErrNoRows},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, fake := newFakeDB(t, tt.results...)
+			repo := NewReactionRepo(db)
+
+			var err error
+			if tt.reaction == "" {
+				_, err = repo.Unreact("post-1", "user-1")
+			} else {
+				var res *pbp.Reactions
+				res, err = repo.React("post-1", "user-1", tt.reaction)
+				if err == nil && res.Reaction != tt.reaction {
+					t.Fatalf("reaction = %q, want %q", res.Reaction, tt.reaction)
+				}
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if len(fake.Queries) != len(tt.results) {
+				t.Fatalf("ran %d statements, want %d:\n%s", len(fake.Queries), len(tt.results), strings.Join(fake.Queries, "\n"))
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			wrote := len(tt.results) == 4
+			if wrote != (tt.wantWrite != "") || (wrote && !strings.Contains(normalize(fake.Queries[2]), tt.wantWrite)) {
+				t.Fatalf("reactions changed by %q, want %q", fake.Queries[2], tt.wantWrite)
+			}
+			update := fake.Args[len(fake.Args)-1]
+			if update[1] != tt.wantDelta[0] || update[2] != tt.wantDelta[1] {
+				t.Fatalf("likes %+v and dislikes %+v, want %+v", update[1], update[2], tt.wantDelta)
+			}
+		})
+	}
+}
+
+// normalize folds the whitespace of a statement, so it can be matched
+// regardless of its layout
+func normalize(query string) string {
+	return strings.Join(strings.Fields(query), " ")
+}
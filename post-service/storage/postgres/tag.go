@@ -0,0 +1,199 @@
+package postgres
+
+import (
+	"database/sql"
+	"strings"
+	"time"
+
+	pbp "post-service/protos/post-service"
+	"post-service/storage/repo"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type tagRepo struct {
+	db *sqlx.DB
+}
+
+// NewTagRepo ...
+func NewTagRepo(db *sqlx.DB) *tagRepo {
+	return &tagRepo{db: db}
+}
+
+// setPostTags files the post under exactly tags, the tags it no longer has
+// are taken off
+func setPostTags(tx *sql.Tx, postId string, tags []string) error {
+	_, err := tx.Exec(`
+	INSERT INTO tags (name) SELECT unnest($1::VARCHAR[]) ON CONFLICT (name) DO NOTHING
+	`, pq.Array(tags))
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+	DELETE FROM
+		post_tags
+	WHERE
+		post_id = $1
+	AND
+		tag_id NOT IN (SELECT id FROM tags WHERE name = ANY($2::VARCHAR[]))
+	`, postId, pq.Array(tags))
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+	INSERT INTO post_tags (
+		post_id,
+		tag_id,
+		created_at
+	)
+	SELECT
+		p.id,
+		t.id,
+		p.created_at
+	FROM
+		posts p
+	JOIN
+		tags t ON t.name = ANY($2::VARCHAR[])
+	WHERE
+		p.id = $1
+	ON CONFLICT DO NOTHING
+	`, postId, pq.Array(tags))
+	return err
+}
+
+func (r *tagRepo) PostsByTag(tag string, after *repo.Cursor, limit int64) ([]*repo.FeedPost, error) {
+	args := []interface{}{tag, limit}
+	keyset := ""
+	if after != nil {
+		args = append(args, after.CreatedAt, after.Id)
+		keyset = "AND (pt.created_at, pt.post_id) < ($3, $4)"
+	}
+
+	query := `
+	SELECT
+		p.id,
+		p.content,
+		p.title,
+		p.views,
+		p.category,
+		p.owner_id,
+		p.created_at,
+		p.updated_at,
+		p.likes,
+		p.dislikes
+	FROM
+		post_tags pt
+	JOIN
+		tags t ON t.id = pt.tag_id
+	JOIN
+		posts p ON p.id = pt.post_id
+	WHERE
+		t.name = $1
+	AND
+		p.deleted_at IS NULL
+	` + keyset + `
+	ORDER BY
+		pt.created_at DESC,
+		pt.post_id DESC
+	LIMIT $2
+	`
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var posts []*repo.FeedPost
+	for rows.Next() {
+		var (
+			post      pbp.Post
+			createdAt time.Time
+		)
+		if err := rows.Scan(
+			&post.Id,
+			&post.Content,
+			&post.Title,
+			&post.Views,
+			&post.Category,
+			&post.OwnerId,
+			&createdAt,
+			&post.UpdatedAt,
+			&post.Likes,
+			&post.Dislikes,
+		); err != nil {
+			return nil, err
+		}
+		post.CreatedAt = timestampText(createdAt)
+		posts = append(posts, &repo.FeedPost{Post: &post, CreatedAt: createdAt})
+	}
+	return posts, rows.Err()
+}
+
+func (r *tagRepo) Search(prefix string, limit int64) ([]*pbp.Tag, error) {
+	// the prefix is matched literally, LIKE wildcards in it are escaped
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(prefix)
+	query := `
+	SELECT
+		t.name,
+		COUNT(*) AS posts
+	FROM
+		tags t
+	JOIN
+		post_tags pt ON pt.tag_id = t.id
+	JOIN
+		posts p ON p.id = pt.post_id
+	WHERE
+		t.name LIKE $1 || '%'
+	AND
+		p.deleted_at IS NULL
+	GROUP BY
+		t.name
+	ORDER BY
+		posts DESC,
+		t.name
+	LIMIT $2
+	`
+	rows, err := r.db.Query(query, escaped, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tags []*pbp.Tag
+	for rows.Next() {
+		var tag pbp.Tag
+		if err := rows.Scan(&tag.Name, &tag.Posts); err != nil {
+			return nil, err
+		}
+		tags = append(tags, &tag)
+	}
+	return tags, rows.Err()
+}
+
+func (r *tagRepo) Get(name string) (*pbp.Tag, error) {
+	query := `
+	SELECT
+		t.name,
+		COUNT(*) AS posts
+	FROM
+		tags t
+	JOIN
+		post_tags pt ON pt.tag_id = t.id
+	JOIN
+		posts p ON p.id = pt.post_id
+	WHERE
+		t.name = $1
+	AND
+		p.deleted_at IS NULL
+	GROUP BY
+		t.name
+	`
+	var tag pbp.Tag
+	if err := r.db.QueryRow(query, name).Scan(&tag.Name, &tag.Posts); err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"time"
+
+	pbp "post-service/protos/post-service"
+	"post-service/storage/repo"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type trendingRepo struct {
+	db *sqlx.DB
+}
+
+// NewTrendingRepo ...
+func NewTrendingRepo(db *sqlx.DB) *trendingRepo {
+	return &trendingRepo{db: db}
+}
+
+func (r *trendingRepo) Candidates(maxAge time.Duration, limit int64) ([]*repo.TrendingCandidate, error) {
+	query := `
+	SELECT
+		id,
+		views,
+		likes,
+		dislikes,
+		created_at
+	FROM
+		posts
+	WHERE
+		deleted_at IS NULL
+	AND
+		created_at > CURRENT_TIMESTAMP - $1 * INTERVAL '1 second'
+	ORDER BY
+		created_at DESC
+	LIMIT $2
+	`
+	rows, err := r.db.Query(query, int64(maxAge.Seconds()), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var candidates []*repo.TrendingCandidate
+	for rows.Next() {
+		var c repo.TrendingCandidate
+		if err := rows.Scan(&c.PostId, &c.Views, &c.Likes, &c.Dislikes, &c.CreatedAt); err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, &c)
+	}
+	return candidates, rows.Err()
+}
+
+// Replace rewrites the ranking in one transaction, so readers see either the
+// previous run or this one
+func (r *trendingRepo) Replace(scores []*repo.PostScore) error {
+	ids := make([]string, 0, len(scores))
+	values := make([]float64, 0, len(scores))
+	for _, s := range scores {
+		ids = append(ids, s.PostId)
+		values = append(values, s.Score)
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM post_rankings`); err != nil {
+		return err
+	}
+	_, err = tx.Exec(`
+	INSERT INTO post_rankings (
+		post_id,
+		category,
+		score,
+		created_at
+	)
+	SELECT
+		p.id,
+		p.category,
+		s.score,
+		p.created_at
+	FROM
+		unnest($1::UUID[], $2::DOUBLE PRECISION[]) AS s(id, score)
+	JOIN
+		posts p ON p.id = s.id
+	`, pq.Array(ids), pq.Array(values))
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *trendingRepo) Trending(category string, window time.Duration, limit, offset int64) ([]*pbp.TrendingPost, *time.Time, error) {
+	query := `
+	SELECT
+		p.id,
+		p.content,
+		p.title,
+		p.views,
+		p.category,
+		p.owner_id,
+		p.created_at,
+		p.updated_at,
+		p.likes,
+		p.dislikes,
+		r.score,
+		r.ranked_at
+	FROM
+		post_rankings r
+	JOIN
+		posts p ON p.id = r.post_id
+	WHERE
+		p.deleted_at IS NULL
+	AND
+		($1 = '' OR r.category = $1)
+	AND
+		($2 = 0 OR r.created_at > CURRENT_TIMESTAMP - $2 * INTERVAL '1 second')
+	ORDER BY
+		r.score DESC,
+		r.post_id
+	LIMIT $3
+	OFFSET $4
+	`
+	rows, err := r.db.Query(query, category, int64(window.Seconds()), limit, offset)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var (
+		posts    []*pbp.TrendingPost
+		rankedAt *time.Time
+	)
+	for rows.Next() {
+		var (
+			post     pbp.Post
+			score    float64
+			rankTime time.Time
+		)
+		if err := rows.Scan(
+			&post.Id,
+			&post.Content,
+			&post.Title,
+			&post.Views,
+			&post.Category,
+			&post.OwnerId,
+			&post.CreatedAt,
+			&post.UpdatedAt,
+			&post.Likes,
+			&post.Dislikes,
+			&score,
+			&rankTime,
+		); err != nil {
+			return nil, nil, err
+		}
+		if rankedAt == nil {
+			rankedAt = &rankTime
+		}
+		posts = append(posts, &pbp.TrendingPost{Post: &post, Score: score})
+	}
+	return posts, rankedAt, rows.Err()
+}
@@ -0,0 +1,27 @@
+package repo
+
+import (
+	"time"
+
+	pbp "post-service/protos/post-service"
+)
+
+// FeedPost is a post of a feed or a tag page and the time it is ordered by
+type FeedPost struct {
+	Post      *pbp.Post
+	CreatedAt time.Time
+}
+
+// FeedStorageI keeps the timelines the feeds are read from
+type FeedStorageI interface {
+	// FanOut copies the post into the timelines of the followers
+	FanOut(postId string, followerIds []string) error
+	// MarkFanIn records an author whose posts are no longer fanned out
+	MarkFanIn(authorId string) error
+	// Feed merges the timeline of the user with the posts of the followed
+	// fan-in authors, newest first
+	Feed(userId string, followeeIds []string, after *Cursor, limit int64) ([]*FeedPost, error)
+	// Rebuild refills the timeline of the user with the latest posts of the
+	// followed authors that are fanned out
+	Rebuild(userId string, followeeIds []string, size int64) (int64, error)
+}
@@ -6,10 +6,14 @@ import (
 
 // PostStorageI ...
 type PostStorageI interface {
-	Create(post *pbp.Post) (*pbp.Post, error)
-	Update(post *pbp.Post) (*pbp.Post, error)
+	Create(post *pbp.Post, tags []string) (*pbp.Post, error)
+	Update(post *pbp.Post, tags []string) (*pbp.Post, error)
 	Delete(req *pbp.GetRequest) (*pbp.CheckResponse, error)
 	GetPost(request *pbp.GetRequest) (*pbp.Post, error)
 	GetAllPosts(req *pbp.GetAllPostsRequest) (*pbp.GetPostsByOwnerIdResponse, error)
 	GetPostsByOwnerId(req *pbp.GetPostsByOwnerIdRequest) (*pbp.GetPostsByOwnerIdResponse, error)
+	AddViews(views map[string]int64) error
+	CategoryNeighbours(ownerId string, limit int64) ([]*pbp.UserScore, error)
+	OwnerPostIds(ownerId string) ([]string, error)
+	Purge(ownerId string) (int64, error)
 }
@@ -0,0 +1,38 @@
+package repo
+
+import (
+	"time"
+
+	pbp "post-service/protos/post-service"
+
+	"shared/paging"
+)
+
+// reactions a user can leave on a post
+const (
+	ReactionLike    = "like"
+	ReactionDislike = "dislike"
+)
+
+// Cursor is a keyset pagination position, lists are ordered by CreatedAt
+// and then Id, both descending
+type Cursor = paging.Cursor
+
+// Reaction is a user's reaction on a post and when it was left
+type Reaction struct {
+	UserId    string
+	Reaction  string
+	ReactedAt time.Time
+}
+
+// ReactionStorageI keeps the reactions and the likes and dislikes counts of
+// the posts in step. The methods return sql.ErrNoRows when the post does not
+// exist or is deleted.
+type ReactionStorageI interface {
+	// React sets the reaction of the user on the post, replacing the one
+	// left before
+	React(postId, userId, reaction string) (*pbp.Reactions, error)
+	Unreact(postId, userId string) (*pbp.Reactions, error)
+	// List returns the reactions of the post, reaction may be empty for both
+	List(postId, reaction string, after *Cursor, limit int64) ([]*Reaction, error)
+}
@@ -0,0 +1,15 @@
+package repo
+
+import (
+	pbp "post-service/protos/post-service"
+)
+
+// TagStorageI reads the hashtags, they are written with the posts by
+// PostStorageI. Only posts that are not deleted count.
+type TagStorageI interface {
+	PostsByTag(tag string, after *Cursor, limit int64) ([]*FeedPost, error)
+	// Search returns the used tags starting with prefix, most used first
+	Search(prefix string, limit int64) ([]*pbp.Tag, error)
+	// Get returns sql.ErrNoRows for a tag no post uses
+	Get(name string) (*pbp.Tag, error)
+}
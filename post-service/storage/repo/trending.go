@@ -0,0 +1,33 @@
+package repo
+
+import (
+	"time"
+
+	pbp "post-service/protos/post-service"
+)
+
+// TrendingCandidate is a recent post and the engagement it is ranked by
+type TrendingCandidate struct {
+	PostId    string
+	Views     int64
+	Likes     int64
+	Dislikes  int64
+	CreatedAt time.Time
+}
+
+// PostScore is the trending score of a post
+type PostScore struct {
+	PostId string
+	Score  float64
+}
+
+// TrendingStorageI keeps the ranking of the trending posts
+type TrendingStorageI interface {
+	// Candidates returns the newest posts written within maxAge, at most limit
+	Candidates(maxAge time.Duration, limit int64) ([]*TrendingCandidate, error)
+	// Replace swaps the whole ranking for scores
+	Replace(scores []*PostScore) error
+	// Trending returns the best scored posts, category and window are
+	// skipped when empty or 0. rankedAt is the time of the ranking run.
+	Trending(category string, window time.Duration, limit, offset int64) (posts []*pbp.TrendingPost, rankedAt *time.Time, err error)
+}
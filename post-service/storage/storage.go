@@ -9,17 +9,48 @@ import (
 
 type IStorage interface {
 	Post() repo.PostStorageI
+	Reaction() repo.ReactionStorageI
+	Feed() repo.FeedStorageI
+	Trending() repo.TrendingStorageI
+	Tag() repo.TagStorageI
 }
 
 type storagePg struct {
-	db       *sqlx.DB
-	postRepo repo.PostStorageI
+	db           *sqlx.DB
+	postRepo     repo.PostStorageI
+	reactionRepo repo.ReactionStorageI
+	feedRepo     repo.FeedStorageI
+	trendingRepo repo.TrendingStorageI
+	tagRepo      repo.TagStorageI
 }
 
 func (s storagePg) Post() repo.PostStorageI {
 	return s.postRepo
 }
 
+func (s storagePg) Reaction() repo.ReactionStorageI {
+	return s.reactionRepo
+}
+
+func (s storagePg) Feed() repo.FeedStorageI {
+	return s.feedRepo
+}
+
+func (s storagePg) Trending() repo.TrendingStorageI {
+	return s.trendingRepo
+}
+
+func (s storagePg) Tag() repo.TagStorageI {
+	return s.tagRepo
+}
+
 func NewStoragePg(db *sqlx.DB) *storagePg {
-	return &storagePg{db, postgres.NewPostRepo(db)}
+	return &storagePg{
+		db:           db,
+		postRepo:     postgres.NewPostRepo(db),
+		reactionRepo: postgres.NewReactionRepo(db),
+		feedRepo:     postgres.NewFeedRepo(db),
+		trendingRepo: postgres.NewTrendingRepo(db),
+		tagRepo:      postgres.NewTagRepo(db),
+	}
 }
@@ -0,0 +1,147 @@
+// Package auth carries the user a call is made for from the api-gateway to
+// the services, and between the services while serving it.
+//
+// The gateway verifies the JWT and sends the user as x-user-id and
+// x-user-role metadata. The services trust those values, so they must only
+// be reachable by the gateway and by each other: every call carries the
+// shared INTERNAL_SECRET and ServerInterceptor rejects calls without it.
+// Keep the service ports off public networks all the same.
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// metadata keys the api-gateway fills from the verified JWT
+const (
+	UserIdKey = "x-user-id"
+	RoleKey   = "x-user-role"
+	// SecretKey holds the secret shared by the gateway and the services
+	SecretKey = "x-internal-secret"
+)
+
+// RoleAdmin may change resources of any user
+const RoleAdmin = "admin"
+
+// RoleService marks calls a service makes on its own, from a background job
+// rather than for a user. The gateway never sends it.
+const RoleService = "service"
+
+type serviceKey struct{}
+
+// AsService returns a context whose calls are made by the service itself,
+// they carry RoleService instead of the user being served
+func AsService(ctx context.Context) context.Context {
+	return context.WithValue(ctx, serviceKey{}, true)
+}
+
+// Subject returns the id and role of the user the call is made for, both
+// are empty when the call carries no metadata
+func Subject(ctx context.Context) (userId, role string) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", ""
+	}
+	if v := md.Get(UserIdKey); len(v) > 0 {
+		userId = v[0]
+	}
+	if v := md.Get(RoleKey); len(v) > 0 {
+		role = v[0]
+	}
+	return userId, role
+}
+
+// CanModify fails with PermissionDenied unless the caller owns the resource
+// or is an admin
+func CanModify(ctx context.Context, ownerId string) error {
+	userId, role := Subject(ctx)
+	if role == RoleAdmin || (userId != "" && userId == ownerId) {
+		return nil
+	}
+	return status.Error(codes.PermissionDenied, "only the owner or an admin can change this resource")
+}
+
+// IsAdmin reports whether the call is made by an admin
+func IsAdmin(ctx context.Context) bool {
+	_, role := Subject(ctx)
+	return role == RoleAdmin
+}
+
+// RequireService fails with PermissionDenied unless the call is made by a
+// service on its own or by an admin
+func RequireService(ctx context.Context) error {
+	if _, role := Subject(ctx); role == RoleService || role == RoleAdmin {
+		return nil
+	}
+	return status.Error(codes.PermissionDenied, "only a service or an admin can do this")
+}
+
+// ServerInterceptor rejects calls that do not carry secret, it panics on an
+// empty secret so a service can not start unprotected
+func ServerInterceptor(secret string) grpc.UnaryServerInterceptor {
+	check := secretCheck(secret)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := check(ctx); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is ServerInterceptor for streaming calls
+func StreamServerInterceptor(secret string) grpc.StreamServerInterceptor {
+	check := secretCheck(secret)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := check(ss.Context()); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// ClientInterceptor adds secret to the calls made, and passes the user of the
+// call being served on to the services called while serving it
+func ClientInterceptor(secret string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoing(ctx, secret), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor is ClientInterceptor for streaming calls
+func StreamClientInterceptor(secret string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoing(ctx, secret), desc, cc, method, opts...)
+	}
+}
+
+func secretCheck(secret string) func(ctx context.Context) error {
+	if secret == "" {
+		panic("auth: empty internal secret")
+	}
+	return func(ctx context.Context) error {
+		md, _ := metadata.FromIncomingContext(ctx)
+		for _, v := range md.Get(SecretKey) {
+			if subtle.ConstantTimeCompare([]byte(v), []byte(secret)) == 1 {
+				return nil
+			}
+		}
+		return status.Error(codes.Unauthenticated, "calls must come through the api-gateway")
+	}
+}
+
+func outgoing(ctx context.Context, secret string) context.Context {
+	// the gateway sets the user itself, services forward the one they serve
+	// unless they call on their own
+	if ctx.Value(serviceKey{}) != nil {
+		ctx = metadata.AppendToOutgoingContext(ctx, RoleKey, RoleService)
+	} else if userId, role := Subject(ctx); userId != "" || role != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, UserIdKey, userId, RoleKey, role)
+	}
+	return metadata.AppendToOutgoingContext(ctx, SecretKey, secret)
+}
@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func incoming(kv ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(kv...))
+}
+
+func TestCanModify(t *testing.T) {
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		owner string
+		want  codes.Code
+	}{
+		{"owner", incoming(UserIdKey, "u1", RoleKey, "user"), "u1", codes.OK},
+		{"admin", incoming(UserIdKey, "u2", RoleKey, RoleAdmin), "u1", codes.OK},
+		{"other user", incoming(UserIdKey, "u2", RoleKey, "user"), "u1", codes.PermissionDenied},
+		{"no metadata", context.Background(), "u1", codes.PermissionDenied},
+		{"no user and no owner", incoming(RoleKey, "user"), "", codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(CanModify(tt.ctx, tt.owner)); got != tt.want {
+				t.Fatalf("CanModify = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServerInterceptor(t *testing.T) {
+	intercept := ServerInterceptor("s3cret")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want codes.Code
+	}{
+		{"secret", incoming(SecretKey, "s3cret"), codes.OK},
+		{"wrong secret", incoming(SecretKey, "guess"), codes.Unauthenticated},
+		{"user metadata only", incoming(UserIdKey, "u1", RoleKey, RoleAdmin), codes.Unauthenticated},
+		{"no metadata", context.Background(), codes.Unauthenticated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := intercept(tt.ctx, nil, &grpc.UnaryServerInfo{}, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("code = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServerInterceptorNeedsSecret(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("an empty secret was accepted")
+		}
+	}()
+	ServerInterceptor("")
+}
+
+func TestClientInterceptor(t *testing.T) {
+	var sent metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		sent, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+	intercept := ClientInterceptor("s3cret")
+
+	// a service serving a call forwards its user
+	ctx := incoming(UserIdKey, "u1", RoleKey, "user", SecretKey, "s3cret")
+	if err := intercept(ctx, "/m", nil, nil, nil, invoker); err != nil {
+		t.Fatal(err)
+	}
+	if got := sent.Get(UserIdKey); len(got) != 1 || got[0] != "u1" {
+		t.Fatalf("forwarded user = %v", got)
+	}
+	if got := sent.Get(SecretKey); len(got) != 1 || got[0] != "s3cret" {
+		t.Fatalf("secret = %v", got)
+	}
+
+	// the gateway sets the user itself, it must not be sent twice
+	ctx = metadata.AppendToOutgoingContext(context.Background(), UserIdKey, "u1", RoleKey, "user")
+	if err := intercept(ctx, "/m", nil, nil, nil, invoker); err != nil {
+		t.Fatal(err)
+	}
+	if got := sent.Get(UserIdKey); len(got) != 1 {
+		t.Fatalf("user ids sent = %v", got)
+	}
+}
+
+func TestClientInterceptorAsService(t *testing.T) {
+	var sent metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		sent, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	// a job started while serving a user calls as the service, not the user
+	ctx := AsService(incoming(UserIdKey, "u1", RoleKey, "user"))
+	if err := ClientInterceptor("s3cret")(ctx, "/m", nil, nil, nil, invoker); err != nil {
+		t.Fatal(err)
+	}
+	if got := sent.Get(RoleKey); len(got) != 1 || got[0] != RoleService {
+		t.Fatalf("role = %v, want %s", got, RoleService)
+	}
+	if got := sent.Get(UserIdKey); len(got) != 0 {
+		t.Fatalf("user ids sent = %v", got)
+	}
+}
+
+func TestRequireService(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want codes.Code
+	}{
+		{"service", incoming(RoleKey, RoleService), codes.OK},
+		{"admin", incoming(UserIdKey, "u1", RoleKey, RoleAdmin), codes.OK},
+		{"user", incoming(UserIdKey, "u1", RoleKey, "user"), codes.PermissionDenied},
+		{"no metadata", context.Background(), codes.PermissionDenied},
+		{"outgoing only", AsService(context.Background()), codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(RequireService(tt.ctx)); got != tt.want {
+				t.Fatalf("RequireService = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
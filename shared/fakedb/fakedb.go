@@ -0,0 +1,143 @@
+// Package fakedb is a database/sql driver for tests. It answers the
+// statements of a test with scripted results in order and records what was
+// run, so storage code can be tested without Postgres.
+package fakedb
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+)
+
+// Result is what the driver answers to one statement. Exec reports as many
+// affected rows as Rows holds.
+type Result struct {
+	Columns []string
+	Rows    [][]driver.Value
+	Err     error
+}
+
+// DB holds the results not handed out yet and the statements run so far
+type DB struct {
+	mu      sync.Mutex
+	results []Result
+	Queries []string
+	Args    [][]driver.Value
+}
+
+var (
+	dbsMu sync.Mutex
+	dbs   = map[string]*DB{}
+)
+
+func init() {
+	sql.Register("fakedb", fakeDriver{})
+}
+
+// Open returns a database whose statements get results in order, it is
+// closed when the test ends
+func Open(t testing.TB, results ...Result) (*sql.DB, *DB) {
+	t.Helper()
+	f := &DB{results: results}
+	dbsMu.Lock()
+	dbs[t.Name()] = f
+	dbsMu.Unlock()
+
+	db, err := sql.Open("fakedb", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		db.Close()
+		dbsMu.Lock()
+		delete(dbs, t.Name())
+		dbsMu.Unlock()
+	})
+	return db, f
+}
+
+func (f *DB) next(query string, args []driver.Value) (Result, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.Queries = append(f.Queries, query)
+	f.Args = append(f.Args, args)
+	if len(f.results) == 0 {
+		return Result{}, fmt.Errorf("fakedb: unexpected statement %q", query)
+	}
+	res := f.results[0]
+	f.results = f.results[1:]
+	return res, res.Err
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	dbsMu.Lock()
+	defer dbsMu.Unlock()
+	f, ok := dbs[name]
+	if !ok {
+		return nil, fmt.Errorf("fakedb: no database %q", name)
+	}
+	return &fakeConn{db: f}, nil
+}
+
+type fakeConn struct {
+	db *DB
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{db: c.db, query: query}, nil
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) { return fakeTx{}, nil }
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+type fakeStmt struct {
+	db    *DB
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	res, err := s.db.next(s.query, args)
+	if err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(len(res.Rows)), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	res, err := s.db.next(s.query, args)
+	if err != nil {
+		return nil, err
+	}
+	return &fakeRows{columns: res.Columns, rows: res.Rows}, nil
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
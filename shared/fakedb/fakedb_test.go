@@ -0,0 +1,54 @@
+package fakedb
+
+import (
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestScriptedResults(t *testing.T) {
+	failed := errors.New("constraint")
+	db, fake := Open(t,
+		Result{Columns: []string{"id"}, Rows: [][]driver.Value{{"a"}, {"b"}}},
+		Result{Rows: make([][]driver.Value, 3)},
+		Result{Err: failed},
+	)
+
+	rows, err := db.Query("SELECT id FROM t WHERE x = $1", "x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if !reflect.DeepEqual(ids, []string{"a", "b"}) {
+		t.Fatalf("ids = %v", ids)
+	}
+
+	res, err := db.Exec("UPDATE t SET x = $1", int64(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := res.RowsAffected(); n != 3 {
+		t.Fatalf("affected %d, want 3", n)
+	}
+
+	if _, err := db.Exec("DELETE FROM t"); !errors.Is(err, failed) {
+		t.Fatalf("err = %v, want %v", err, failed)
+	}
+	if _, err := db.Exec("DELETE FROM t"); err == nil {
+		t.Fatal("a statement past the script succeeded")
+	}
+
+	wantArgs := [][]driver.Value{{"x"}, {int64(1)}, {}, {}}
+	if len(fake.Queries) != 4 || !reflect.DeepEqual(fake.Args, wantArgs) {
+		t.Fatalf("recorded %q with %v", fake.Queries, fake.Args)
+	}
+}
@@ -0,0 +1,63 @@
+// Package paging holds what the services share to page through lists: the
+// page size clamp and the opaque cursor of keyset paging.
+package paging
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultSize = 20
+	MaxSize     = 100
+)
+
+// Size clamps the limit asked for by the client
+func Size(limit int64) int64 {
+	if limit <= 0 {
+		return DefaultSize
+	}
+	if limit > MaxSize {
+		return MaxSize
+	}
+	return limit
+}
+
+// Cursor is a keyset position, the time and id of the last item of a page
+type Cursor struct {
+	CreatedAt time.Time
+	Id        string
+}
+
+// EncodeCursor makes an opaque page token out of a keyset position
+func EncodeCursor(c Cursor) string {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.Id
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor returns nil for an empty token, that is the first page. A
+// token it did not make fails with InvalidArgument.
+func DecodeCursor(token string) (*Cursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	invalid := status.Error(codes.InvalidArgument, "invalid cursor")
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, invalid
+	}
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, invalid
+	}
+	return &Cursor{CreatedAt: t, Id: id}, nil
+}
@@ -0,0 +1,64 @@
+package paging
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	tashkent := time.FixedZone("UZT", 5*60*60)
+	tests := []Cursor{
+		{CreatedAt: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), Id: "id-1"},
+		{CreatedAt: time.Date(2024, 5, 1, 10, 0, 0, 123456789, time.UTC), Id: "id-2"},
+		{CreatedAt: time.Date(2024, 5, 1, 15, 0, 0, 1000, tashkent), Id: "a|b"},
+	}
+	for _, c := range tests {
+		token := EncodeCursor(c)
+		got, err := DecodeCursor(token)
+		if err != nil {
+			t.Fatalf("DecodeCursor(EncodeCursor(%v)): %v", c, err)
+		}
+		if !got.CreatedAt.Equal(c.CreatedAt) || got.Id != c.Id {
+			t.Errorf("round trip of %v = %v", c, got)
+		}
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	if c, err := DecodeCursor(""); c != nil || err != nil {
+		t.Errorf(`DecodeCursor("") = %v, %v, want the first page`, c, err)
+	}
+
+	encode := func(raw string) string { return base64.RawURLEncoding.EncodeToString([]byte(raw)) }
+	for _, token := range []string{
+		"not base64!",
+		encode("2024-05-01T10:00:00Z"),
+		encode("yesterday|id-1"),
+		base64.URLEncoding.EncodeToString([]byte("2024-05-01T10:00:00Z|id-10")),
+	} {
+		if _, err := DecodeCursor(token); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("DecodeCursor(%q) error = %v, want InvalidArgument", token, err)
+		}
+	}
+}
+
+func TestSize(t *testing.T) {
+	tests := []struct {
+		limit, want int64
+	}{
+		{-1, DefaultSize},
+		{0, DefaultSize},
+		{1, 1},
+		{MaxSize, MaxSize},
+		{MaxSize + 1, MaxSize},
+	}
+	for _, tt := range tests {
+		if got := Size(tt.limit); got != tt.want {
+			t.Errorf("Size(%d) = %d, want %d", tt.limit, got, tt.want)
+		}
+	}
+}
@@ -1,17 +1,21 @@
 package main
 
 import (
+	"context"
 	"net"
 
 	"user-service/config"
 	"user-service/pkg/db"
 	"user-service/pkg/logger"
+	"user-service/pkg/mailer"
 	pbu "user-service/protos/user-service"
 
 	// "user-service/storage/postgres"
 	service "user-service/service"
 	grpcclient "user-service/service/grpc_client"
 
+	"shared/auth"
+
 	"google.golang.org/grpc"
 )
 
@@ -36,15 +40,27 @@ func main() {
 	if err != nil {
 		log.Fatal("sqlx connection to postgres error", logger.Error(err))
 	}
-	userService := service.NewUserService(connDB, log, grpcClient)
+	mail, err := mailer.New(cfg, log)
+	if err != nil {
+		log.Fatal("mailer error", logger.Error(err))
+	}
+	userService := service.NewUserService(connDB, log, grpcClient, cfg, mail)
 	// postgres
 
+	go userService.PurgeDeletedUsers(context.Background())
+
 	lis, err := net.Listen("tcp", cfg.RPCPort)
 	if err != nil {
 		log.Fatal("Error while listening: %v", logger.Error(err))
 	}
 
-	s := grpc.NewServer()
+	if cfg.InternalSecret == "" {
+		log.Fatal("INTERNAL_SECRET is not set, the service trusts the user metadata of its callers")
+	}
+	s := grpc.NewServer(
+		grpc.UnaryInterceptor(auth.ServerInterceptor(cfg.InternalSecret)),
+		grpc.StreamInterceptor(auth.StreamServerInterceptor(cfg.InternalSecret)),
+	)
 	pbu.RegisterUserServiceServer(s, userService)
 	log.Info("main: server running",
 		logger.String("port", cfg.RPCPort))
@@ -16,6 +16,43 @@ type Config struct {
 	PostgresPassword string
 	LogLevel         string
 	RPCPort          string
+	// shared with the api-gateway and the other services, calls without it
+	// are rejected
+	InternalSecret string
+	// registration, password reset and email change code lifetimes in minutes
+	RegistrationCodeTTL  int
+	PasswordResetCodeTTL int
+	EmailChangeCodeTTL   int
+	// seconds before another password reset code can be requested
+	PasswordResetResendInterval int
+	// two-factor authentication, challenge lifetime in minutes
+	TotpIssuer      string
+	MfaChallengeTTL int
+	// login throttling: failures before delays start, failures before the
+	// lockout, lockout length in minutes and the longest delay in seconds
+	LoginFreeAttempts     int
+	LoginMaxFailures      int
+	LoginMaxFailuresPerIP int
+	LoginLockout          int
+	LoginMaxDelay         int
+	// mailer: smtp, file or log
+	MailerBackend string
+	MailFrom      string
+	MailFilePath  string
+	SMTPHost      string
+	SMTPPort      int
+	SMTPUser      string
+	SMTPPassword  string
+	// deleted accounts can be restored for AccountRestoreDays, the purge
+	// job removes the expired ones every AccountPurgeInterval minutes
+	AccountRestoreDays   int
+	AccountPurgeInterval int
+	// people you may know, cached per user for SuggestionCacheTTL minutes
+	SuggestionCacheTTL int
+	// argon2id password hashing, memory in KiB
+	Argon2Memory      int
+	Argon2Iterations  int
+	Argon2Parallelism int
 	// connect fields
 	PostServiceHost    string
 	PostServicePort    int
@@ -37,14 +74,50 @@ func Load() Config {
 
 	// connect to post-service
 	c.PostServiceHost = cast.ToString(getOrReturnDefault("POST_SERVICE_HOST", "localhost"))
-	c.PostServicePort = cast.ToInt(getOrReturnDefault("POST_SERVICE_HOST", "2222"))
+	c.PostServicePort = cast.ToInt(getOrReturnDefault("POST_SERVICE_PORT", "2222"))
 
 	// connect to comment-service
 	c.CommentServiceHost = cast.ToString(getOrReturnDefault("COMMENT_SERVICE_HOST", "localhost"))
 	c.CommentServicePort = cast.ToInt(getOrReturnDefault("COMMENT_SERVICE_PORT", "3333"))
 
+	c.RegistrationCodeTTL = cast.ToInt(getOrReturnDefault("REGISTRATION_CODE_TTL", 10))
+	c.PasswordResetCodeTTL = cast.ToInt(getOrReturnDefault("PASSWORD_RESET_CODE_TTL", 15))
+	c.EmailChangeCodeTTL = cast.ToInt(getOrReturnDefault("EMAIL_CHANGE_CODE_TTL", 30))
+	c.PasswordResetResendInterval = cast.ToInt(getOrReturnDefault("PASSWORD_RESET_RESEND_INTERVAL", 60))
+
+	c.TotpIssuer = cast.ToString(getOrReturnDefault("TOTP_ISSUER", "social-network"))
+	c.MfaChallengeTTL = cast.ToInt(getOrReturnDefault("MFA_CHALLENGE_TTL", 5))
+
+	// login throttling
+	c.LoginFreeAttempts = cast.ToInt(getOrReturnDefault("LOGIN_FREE_ATTEMPTS", 3))
+	c.LoginMaxFailures = cast.ToInt(getOrReturnDefault("LOGIN_MAX_FAILURES", 10))
+	c.LoginMaxFailuresPerIP = cast.ToInt(getOrReturnDefault("LOGIN_MAX_FAILURES_PER_IP", 100))
+	c.LoginLockout = cast.ToInt(getOrReturnDefault("LOGIN_LOCKOUT", 15))
+	c.LoginMaxDelay = cast.ToInt(getOrReturnDefault("LOGIN_MAX_DELAY", 60))
+
+	// mailer
+	c.MailerBackend = cast.ToString(getOrReturnDefault("MAILER_BACKEND", "log"))
+	c.MailFrom = cast.ToString(getOrReturnDefault("MAIL_FROM", "no-reply@social-network.local"))
+	c.MailFilePath = cast.ToString(getOrReturnDefault("MAIL_FILE_PATH", "mail.log"))
+	c.SMTPHost = cast.ToString(getOrReturnDefault("SMTP_HOST", "localhost"))
+	c.SMTPPort = cast.ToInt(getOrReturnDefault("SMTP_PORT", 1025))
+	c.SMTPUser = cast.ToString(getOrReturnDefault("SMTP_USER", ""))
+	c.SMTPPassword = cast.ToString(getOrReturnDefault("SMTP_PASSWORD", ""))
+
+	// password hashing
+	c.Argon2Memory = cast.ToInt(getOrReturnDefault("ARGON2_MEMORY", 64*1024))
+	c.Argon2Iterations = cast.ToInt(getOrReturnDefault("ARGON2_ITERATIONS", 3))
+	c.Argon2Parallelism = cast.ToInt(getOrReturnDefault("ARGON2_PARALLELISM", 2))
+
+	// account deletion
+	c.AccountRestoreDays = cast.ToInt(getOrReturnDefault("ACCOUNT_RESTORE_DAYS", 30))
+	c.AccountPurgeInterval = cast.ToInt(getOrReturnDefault("ACCOUNT_PURGE_INTERVAL", 60))
+
+	c.SuggestionCacheTTL = cast.ToInt(getOrReturnDefault("SUGGESTION_CACHE_TTL", 30))
+
 	c.LogLevel = cast.ToString(getOrReturnDefault("LOG_LEVEL", "debug"))
 	c.RPCPort = cast.ToString(getOrReturnDefault("RPC_PORT", ":1111"))
+	c.InternalSecret = cast.ToString(getOrReturnDefault("INTERNAL_SECRET", ""))
 
 	return c
 }
@@ -8,6 +8,8 @@ require (
 	github.com/lib/pq v1.10.9
 	github.com/spf13/cast v1.6.0
 	go.uber.org/zap v1.27.0
+	golang.org/x/crypto v0.23.0
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240318140521-94a12d6c2237
 	google.golang.org/grpc v1.64.0
 	google.golang.org/protobuf v1.34.1
 )
@@ -15,7 +17,10 @@ require (
 require (
 	go.uber.org/multierr v1.10.0 // indirect
 	golang.org/x/net v0.22.0 // indirect
-	golang.org/x/sys v0.18.0 // indirect
-	golang.org/x/text v0.14.0 // indirect
-	google.golang.org/genproto/googleapis/rpc v0.0.0-20240318140521-94a12d6c2237 // indirect
+	golang.org/x/sys v0.20.0 // indirect
+	golang.org/x/text v0.15.0 // indirect
 )
+
+require shared v0.0.0-00010101000000-000000000000
+
+replace shared => ../shared
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS user_registrations;
\ No newline at end of file
@@ -0,0 +1,11 @@
+CREATE TABLE user_registrations (
+    email VARCHAR(255) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    last_name VARCHAR(100),
+    username VARCHAR(100) NOT NULL,
+    password TEXT NOT NULL,
+    code VARCHAR(16) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
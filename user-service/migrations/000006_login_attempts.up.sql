@@ -0,0 +1,7 @@
+CREATE TABLE login_attempts (
+    -- "user:<id>" or "ip:<address>"
+    key VARCHAR(128) PRIMARY KEY,
+    failures INT NOT NULL DEFAULT 0,
+    last_failure_at TIMESTAMPTZ NOT NULL,
+    locked_until TIMESTAMPTZ NULL
+);
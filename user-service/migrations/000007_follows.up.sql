@@ -0,0 +1,11 @@
+CREATE TABLE follows (
+    follower_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    followee_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (follower_id, followee_id),
+    CHECK (follower_id <> followee_id)
+);
+
+-- keyset pagination of both lists, newest first
+CREATE INDEX follows_followee_idx ON follows (followee_id, created_at DESC, follower_id DESC);
+CREATE INDEX follows_follower_idx ON follows (follower_id, created_at DESC, followee_id DESC);
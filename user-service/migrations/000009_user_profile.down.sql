@@ -0,0 +1,13 @@
+ALTER TABLE user_registrations
+    DROP COLUMN IF EXISTS birth_date,
+    DROP COLUMN IF EXISTS biography,
+    DROP COLUMN IF EXISTS gender,
+    DROP COLUMN IF EXISTS profile_picture,
+    DROP COLUMN IF EXISTS phone_number;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS birth_date,
+    DROP COLUMN IF EXISTS biography,
+    DROP COLUMN IF EXISTS gender,
+    DROP COLUMN IF EXISTS profile_picture,
+    DROP COLUMN IF EXISTS phone_number;
\ No newline at end of file
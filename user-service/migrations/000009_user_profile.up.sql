@@ -0,0 +1,14 @@
+ALTER TABLE users
+    ADD COLUMN birth_date DATE,
+    ADD COLUMN biography TEXT NOT NULL DEFAULT '',
+    ADD COLUMN gender VARCHAR(16) NOT NULL DEFAULT '',
+    ADD COLUMN profile_picture TEXT NOT NULL DEFAULT '',
+    -- E.164, normalized by the service
+    ADD COLUMN phone_number VARCHAR(16) NOT NULL DEFAULT '';
+
+ALTER TABLE user_registrations
+    ADD COLUMN birth_date DATE,
+    ADD COLUMN biography TEXT NOT NULL DEFAULT '',
+    ADD COLUMN gender VARCHAR(16) NOT NULL DEFAULT '',
+    ADD COLUMN profile_picture TEXT NOT NULL DEFAULT '',
+    ADD COLUMN phone_number VARCHAR(16) NOT NULL DEFAULT '';
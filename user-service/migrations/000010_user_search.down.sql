@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS users_search_tsv_idx;
+DROP INDEX IF EXISTS users_last_name_trgm_idx;
+DROP INDEX IF EXISTS users_name_trgm_idx;
+DROP INDEX IF EXISTS users_username_trgm_idx;
\ No newline at end of file
@@ -0,0 +1,12 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- similarity and ILIKE prefix matching
+CREATE INDEX users_username_trgm_idx ON users USING GIN (username gin_trgm_ops) WHERE deleted_at IS NULL;
+CREATE INDEX users_name_trgm_idx ON users USING GIN (name gin_trgm_ops) WHERE deleted_at IS NULL;
+CREATE INDEX users_last_name_trgm_idx ON users USING GIN (last_name gin_trgm_ops) WHERE deleted_at IS NULL;
+
+-- full-text matching of whole words, the expression must stay in sync with
+-- searchVector in storage/postgres/user.go
+CREATE INDEX users_search_tsv_idx ON users USING GIN (
+    to_tsvector('simple', username || ' ' || name || ' ' || COALESCE(last_name, ''))
+) WHERE deleted_at IS NULL;
@@ -0,0 +1,7 @@
+-- a deleted account can be restored by logging in until purge_after, then
+-- it is removed for good along with its posts and comments
+ALTER TABLE users ADD COLUMN purge_after TIMESTAMP NULL;
+
+UPDATE users SET purge_after = deleted_at + INTERVAL '30 days' WHERE deleted_at IS NOT NULL;
+
+CREATE INDEX users_purge_after_idx ON users (purge_after) WHERE deleted_at IS NOT NULL;
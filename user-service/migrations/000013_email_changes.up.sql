@@ -0,0 +1,13 @@
+-- a pending email change, confirmed by codes sent to the old and the new
+-- address, only the code hashes are kept
+CREATE TABLE email_changes (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    new_email VARCHAR(255) NOT NULL,
+    old_code_hash VARCHAR(64) NOT NULL,
+    new_code_hash VARCHAR(64) NOT NULL,
+    old_confirmed BOOLEAN NOT NULL DEFAULT FALSE,
+    new_confirmed BOOLEAN NOT NULL DEFAULT FALSE,
+    attempts INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,7 @@
+-- the codes can not be recovered from their hashes, pending registrations
+-- have to register again
+DELETE FROM user_registrations;
+
+ALTER TABLE user_registrations ALTER COLUMN code_hash TYPE VARCHAR(16);
+
+ALTER TABLE user_registrations RENAME COLUMN code_hash TO code;
\ No newline at end of file
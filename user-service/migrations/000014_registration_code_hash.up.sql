@@ -0,0 +1,7 @@
+-- pending registrations keep the sha256 of their code, the plaintext codes
+-- of the registrations already pending are hashed in place
+ALTER TABLE user_registrations RENAME COLUMN code TO code_hash;
+
+ALTER TABLE user_registrations ALTER COLUMN code_hash TYPE VARCHAR(64);
+
+UPDATE user_registrations SET code_hash = encode(sha256(code_hash::BYTEA), 'hex');
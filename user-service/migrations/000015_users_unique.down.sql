@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS users_username_key;
+
+DROP INDEX IF EXISTS users_email_key;
\ No newline at end of file
@@ -0,0 +1,6 @@
+-- checkAvailable alone races, two signups can both pass it before either
+-- inserts. Deleted users keep their email and username until they are
+-- purged, so the indexes cover them too.
+CREATE UNIQUE INDEX users_email_key ON users (email);
+
+CREATE UNIQUE INDEX users_username_key ON users (username);
@@ -0,0 +1 @@
+ALTER TABLE login_challenges DROP COLUMN IF EXISTS restore;
\ No newline at end of file
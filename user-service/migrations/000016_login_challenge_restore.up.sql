@@ -0,0 +1,3 @@
+-- a login that brings a deleted account back only restores it once the
+-- second step of two-factor authentication is passed
+ALTER TABLE login_challenges ADD COLUMN restore BOOLEAN NOT NULL DEFAULT FALSE;
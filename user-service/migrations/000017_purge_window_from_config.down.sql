@@ -0,0 +1,2 @@
+UPDATE users SET purge_after = deleted_at + INTERVAL '30 days'
+WHERE deleted_at IS NOT NULL AND purge_after IS NULL;
@@ -0,0 +1,5 @@
+-- 000012 gave the accounts deleted before it a fixed 30 day restore window.
+-- Clear the purge time it set, the service schedules these accounts again
+-- from ACCOUNT_RESTORE_DAYS when it starts.
+UPDATE users SET purge_after = NULL
+WHERE deleted_at IS NOT NULL AND purge_after = deleted_at + INTERVAL '30 days';
@@ -0,0 +1,40 @@
+package etc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+)
+
+// Table for code generator
+var table = [...]byte{'1', '2', '3', '4', '5', '6', '7', '8', '9', '0'}
+
+// GenerateCode creates n-digit random code from a cryptographically secure source
+func GenerateCode(max int) (string, error) {
+	b := make([]byte, max)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(table))))
+		if err != nil {
+			return "", err
+		}
+		b[i] = table[n.Int64()]
+	}
+	return string(b), nil
+}
+
+// HashCode returns the hex sha256 of a one-time code, codes are stored hashed
+func HashCode(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// GenerateToken returns size random bytes encoded for use in URLs
+func GenerateToken(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
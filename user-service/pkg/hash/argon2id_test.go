@@ -0,0 +1,97 @@
+package hash
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var testParams = Params{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+func TestArgon2idRoundTrip(t *testing.T) {
+	h := NewArgon2id(testParams)
+
+	hashed, err := h.Hash("secret-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "$argon2id$v=19$m=64,t=1,p=1$"; !strings.HasPrefix(hashed, want) {
+		t.Errorf("Hash = %q, want prefix %q", hashed, want)
+	}
+	if again, _ := h.Hash("secret-password"); again == hashed {
+		t.Error("two hashes of the same password are equal, salt is not random")
+	}
+
+	ok, rehash, err := h.Verify("secret-password", hashed)
+	if err != nil || !ok || rehash {
+		t.Errorf("Verify(right password) = %v, %v, %v, want true, false, nil", ok, rehash, err)
+	}
+	ok, rehash, err = h.Verify("wrong-password", hashed)
+	if err != nil || ok || rehash {
+		t.Errorf("Verify(wrong password) = %v, %v, %v, want false, false, nil", ok, rehash, err)
+	}
+}
+
+func TestVerifyRehash(t *testing.T) {
+	old, err := NewArgon2id(testParams).Hash("secret-password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bcrypted, err := bcrypt.GenerateFromPassword([]byte("secret-password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changed := func(f func(p *Params)) Params {
+		p := testParams
+		f(&p)
+		return p
+	}
+	tests := []struct {
+		name       string
+		params     Params
+		hashed     string
+		password   string
+		wantOk     bool
+		wantRehash bool
+	}{
+		{"same params", testParams, old, "secret-password", true, false},
+		{"more memory", changed(func(p *Params) { p.Memory = 128 }), old, "secret-password", true, true},
+		{"more iterations", changed(func(p *Params) { p.Iterations = 2 }), old, "secret-password", true, true},
+		{"more parallelism", changed(func(p *Params) { p.Parallelism = 2 }), old, "secret-password", true, true},
+		{"longer salt", changed(func(p *Params) { p.SaltLength = 32 }), old, "secret-password", true, true},
+		{"longer key", changed(func(p *Params) { p.KeyLength = 64 }), old, "secret-password", true, true},
+		{"changed params wrong password", changed(func(p *Params) { p.Memory = 128 }), old, "wrong-password", false, false},
+		{"bcrypt", testParams, string(bcrypted), "secret-password", true, true},
+		{"bcrypt wrong password", testParams, string(bcrypted), "wrong-password", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, rehash, err := NewArgon2id(tt.params).Verify(tt.password, tt.hashed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != tt.wantOk || rehash != tt.wantRehash {
+				t.Errorf("Verify = %v, %v, want %v, %v", ok, rehash, tt.wantOk, tt.wantRehash)
+			}
+		})
+	}
+}
+
+func TestVerifyInvalidHash(t *testing.T) {
+	h := NewArgon2id(testParams)
+	for _, hashed := range []string{
+		"",
+		"plain-text",
+		"$argon2i$v=19$m=64,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=16$m=64,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=x,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=64,t=1,p=1$not base64!$a2V5",
+		"$argon2id$v=19$m=64,t=1,p=1$c2FsdA",
+	} {
+		if _, _, err := h.Verify("secret-password", hashed); err != ErrInvalidHash {
+			t.Errorf("Verify(%q) error = %v, want ErrInvalidHash", hashed, err)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package hash
+
+import "strings"
+
+// PasswordHasher hashes passwords for storage and checks them on login
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	// Verify reports whether password matches the stored hash and whether
+	// the hash should be replaced because it uses outdated parameters
+	Verify(password, hashed string) (ok bool, rehash bool, err error)
+}
+
+// isBcrypt reports whether the hash was made by bcrypt, all passwords were
+// stored that way before argon2id
+func isBcrypt(hashed string) bool {
+	return strings.HasPrefix(hashed, "$2a$") ||
+		strings.HasPrefix(hashed, "$2b$") ||
+		strings.HasPrefix(hashed, "$2y$")
+}
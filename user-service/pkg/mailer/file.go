@@ -0,0 +1,54 @@
+package mailer
+
+import (
+	"os"
+	"sync"
+
+	"user-service/pkg/logger"
+)
+
+// fileMailer appends every message to a file, handy for local testing
+type fileMailer struct {
+	mu   sync.Mutex
+	path string
+	from string
+}
+
+// NewFile ...
+func NewFile(path, from string) Mailer {
+	return &fileMailer{path: path, from: from}
+}
+
+func (m *fileMailer) Send(to, subject, body string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := os.OpenFile(m.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(message(m.from, to, subject, body), "\r\n"...)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// logMailer writes messages to the service log instead of sending them
+type logMailer struct {
+	log logger.Logger
+}
+
+// NewLog ...
+func NewLog(log logger.Logger) Mailer {
+	return &logMailer{log: log}
+}
+
+func (m *logMailer) Send(to, subject, body string) error {
+	m.log.Info("mail",
+		logger.String("to", to),
+		logger.String("subject", subject),
+		logger.String("body", body))
+	return nil
+}
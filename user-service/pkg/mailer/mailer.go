@@ -0,0 +1,27 @@
+package mailer
+
+import (
+	"fmt"
+
+	"user-service/config"
+	"user-service/pkg/logger"
+)
+
+// Mailer sends plain text emails
+type Mailer interface {
+	Send(to, subject, body string) error
+}
+
+// New picks the mailer configured by MAILER_BACKEND: smtp, file or log
+func New(cfg config.Config, log logger.Logger) (Mailer, error) {
+	switch cfg.MailerBackend {
+	case "smtp":
+		return NewSMTP(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUser, cfg.SMTPPassword, cfg.MailFrom), nil
+	case "file":
+		return NewFile(cfg.MailFilePath, cfg.MailFrom), nil
+	case "log", "":
+		return NewLog(log), nil
+	default:
+		return nil, fmt.Errorf("unknown mailer backend %q", cfg.MailerBackend)
+	}
+}
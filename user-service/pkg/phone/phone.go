@@ -0,0 +1,42 @@
+package phone
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrInvalid is returned for numbers that can not be turned into E.164
+var ErrInvalid = errors.New("phone number must be in international format, e.g. +998901234567")
+
+// Normalize returns the number in E.164 form, "+" followed by up to 15
+// digits. Spaces, dots, dashes and parentheses are dropped and a leading
+// international "00" prefix is accepted in place of "+". Numbers without a
+// country code are rejected since there is no region to assume.
+func Normalize(raw string) (string, error) {
+	s := strings.TrimSpace(raw)
+	switch {
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	case strings.HasPrefix(s, "00"):
+		s = s[2:]
+	default:
+		return "", ErrInvalid
+	}
+
+	digits := make([]byte, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c >= '0' && c <= '9':
+			digits = append(digits, c)
+		case c == ' ' || c == '-' || c == '.' || c == '(' || c == ')':
+		default:
+			return "", ErrInvalid
+		}
+	}
+	// country codes never start with 0, and the shortest numbers in use
+	// have 8 digits with the country code
+	if len(digits) < 8 || len(digits) > 15 || digits[0] == '0' {
+		return "", ErrInvalid
+	}
+	return "+" + string(digits), nil
+}
@@ -0,0 +1,47 @@
+package phone
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want string
+	}{
+		{"+998901234567", "+998901234567"},
+		{"  +998 90 123-45-67 ", "+998901234567"},
+		{"+998 (90) 123.45.67", "+998901234567"},
+		{"00998901234567", "+998901234567"},
+		{"+1 (202) 555-0143", "+12025550143"},
+		{"+49301234", "+49301234"},
+		{"+123456789012345", "+123456789012345"},
+	}
+	for _, tt := range tests {
+		got, err := Normalize(tt.raw)
+		if err != nil || got != tt.want {
+			t.Errorf("Normalize(%q) = %q, %v, want %q", tt.raw, got, err, tt.want)
+		}
+	}
+}
+
+func TestNormalizeInvalid(t *testing.T) {
+	for _, raw := range []string{
+		"",
+		"+",
+		// no country code
+		"901234567",
+		"0901234567",
+		// country codes never start with 0
+		"+0998901234567",
+		"000998901234567",
+		// too short and too long
+		"+493012",
+		"+1234567890123456",
+		"+998 90 123 45 6x",
+		"+998/90/1234567",
+		"++998901234567",
+	} {
+		if got, err := Normalize(raw); err != ErrInvalid {
+			t.Errorf("Normalize(%q) = %q, %v, want ErrInvalid", raw, got, err)
+		}
+	}
+}
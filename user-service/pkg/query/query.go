@@ -0,0 +1,105 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrUnknownField is returned when client input names a field that is not
+// in the whitelist of the builder
+var ErrUnknownField = errors.New("unknown field")
+
+// Builder assembles the WHERE and ORDER BY clauses of a query from client
+// input. Client supplied field names are only ever looked up in the
+// whitelist given to New, values always travel as numbered arguments.
+type Builder struct {
+	columns map[string]string
+	conds   []string
+	order   []string
+	args    []interface{}
+}
+
+// New returns a builder accepting the fields in columns, which maps the
+// names clients use to SQL columns
+func New(columns map[string]string) *Builder {
+	return &Builder{columns: columns}
+}
+
+// Column resolves a client field name through the whitelist
+func (b *Builder) Column(field string) (string, error) {
+	column, ok := b.columns[field]
+	if !ok {
+		return "", fmt.Errorf("%w %q", ErrUnknownField, field)
+	}
+	return column, nil
+}
+
+// Arg adds an argument and returns its placeholder
+func (b *Builder) Arg(value interface{}) string {
+	b.args = append(b.args, value)
+	return "$" + strconv.Itoa(len(b.args))
+}
+
+// Where adds a condition written by the caller, every "?" in cond is bound
+// to the next value of args. cond must never contain client input.
+func (b *Builder) Where(cond string, args ...interface{}) *Builder {
+	var sb strings.Builder
+	for _, part := range strings.SplitAfter(cond, "?") {
+		if strings.HasSuffix(part, "?") && len(args) > 0 {
+			sb.WriteString(strings.TrimSuffix(part, "?"))
+			sb.WriteString(b.Arg(args[0]))
+			args = args[1:]
+			continue
+		}
+		sb.WriteString(part)
+	}
+	b.conds = append(b.conds, sb.String())
+	return b
+}
+
+// Eq adds field = value for a whitelisted field
+func (b *Builder) Eq(field string, value interface{}) error {
+	column, err := b.Column(field)
+	if err != nil {
+		return err
+	}
+	b.Where(column+" = ?", value)
+	return nil
+}
+
+// OrderBy adds a sort on a whitelisted field, a leading "-" sorts descending
+func (b *Builder) OrderBy(field string) error {
+	dir := "ASC"
+	if strings.HasPrefix(field, "-") {
+		field, dir = field[1:], "DESC"
+	}
+	column, err := b.Column(field)
+	if err != nil {
+		return err
+	}
+	b.order = append(b.order, column+" "+dir)
+	return nil
+}
+
+// WhereClause returns "WHERE ..." or an empty string without conditions
+func (b *Builder) WhereClause() string {
+	if len(b.conds) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(b.conds, " AND ")
+}
+
+// OrderClause returns "ORDER BY ..." or an empty string without sorts
+func (b *Builder) OrderClause() string {
+	if len(b.order) == 0 {
+		return ""
+	}
+	return "ORDER BY " + strings.Join(b.order, ", ")
+}
+
+// Args returns the arguments in placeholder order
+func (b *Builder) Args() []interface{} {
+	return b.args
+}
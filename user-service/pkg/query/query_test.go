@@ -0,0 +1,92 @@
+package query
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testColumns = map[string]string{
+	"name":       "name",
+	"first_name": "name",
+	"created_at": "created_at",
+}
+
+func TestUnknownFieldsAreRejected(t *testing.T) {
+	names := []string{
+		"",
+		"password",
+		"NAME",
+		"name; DROP TABLE users",
+		"name--",
+		"(SELECT 1)",
+		"created_at DESC, password",
+		"--name",
+	}
+	for _, name := range names {
+		q := New(testColumns)
+		if err := q.Eq(name, "x"); !errors.Is(err, ErrUnknownField) {
+			t.Errorf("Eq(%q) error = %v, want ErrUnknownField", name, err)
+		}
+		if err := q.OrderBy(name); !errors.Is(err, ErrUnknownField) {
+			t.Errorf("OrderBy(%q) error = %v, want ErrUnknownField", name, err)
+		}
+		if q.WhereClause() != "" || q.OrderClause() != "" || len(q.Args()) != 0 {
+			t.Errorf("rejected %q still changed the query: %q %q %v", name, q.WhereClause(), q.OrderClause(), q.Args())
+		}
+	}
+}
+
+func TestFieldsResolveThroughWhitelist(t *testing.T) {
+	q := New(testColumns)
+	if err := q.Eq("first_name", "Ann"); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.OrderBy("-created_at"); err != nil {
+		t.Fatal(err)
+	}
+	if err := q.OrderBy("name"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := q.WhereClause(), "WHERE name = $1"; got != want {
+		t.Errorf("WhereClause() = %q, want %q", got, want)
+	}
+	if got, want := q.OrderClause(), "ORDER BY created_at DESC, name ASC"; got != want {
+		t.Errorf("OrderClause() = %q, want %q", got, want)
+	}
+}
+
+func TestValuesAreAlwaysPlaceholders(t *testing.T) {
+	hostile := "x' OR '1'='1"
+	q := New(testColumns)
+	q.Where("deleted_at IS NULL")
+	if err := q.Eq("name", hostile); err != nil {
+		t.Fatal(err)
+	}
+	q.Where("created_at >= ? AND created_at < ?", "2024-01-01", "2025-01-01")
+	limit := q.Arg(int64(10))
+
+	where := q.WhereClause()
+	want := "WHERE deleted_at IS NULL AND name = $1 AND created_at >= $2 AND created_at < $3"
+	if where != want {
+		t.Fatalf("WhereClause() = %q, want %q", where, want)
+	}
+	if strings.Contains(where, hostile) || strings.Contains(where, "2024") {
+		t.Fatalf("a value ended up in the SQL: %q", where)
+	}
+	if limit != "$4" {
+		t.Fatalf("Arg() = %q, want $4", limit)
+	}
+	wantArgs := []interface{}{hostile, "2024-01-01", "2025-01-01", int64(10)}
+	if !reflect.DeepEqual(q.Args(), wantArgs) {
+		t.Fatalf("Args() = %v, want %v", q.Args(), wantArgs)
+	}
+}
+
+func TestEmptyBuilder(t *testing.T) {
+	q := New(testColumns)
+	if q.WhereClause() != "" || q.OrderClause() != "" || len(q.Args()) != 0 {
+		t.Fatalf("empty builder produced %q %q %v", q.WhereClause(), q.OrderClause(), q.Args())
+	}
+}
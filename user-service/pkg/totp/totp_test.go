@@ -0,0 +1,143 @@
+package totp
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// the SHA1 secret of RFC 6238 appendix B, "12345678901234567890" in base32
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+// RFC 6238 appendix B, SHA1, the last 6 of the 8 digits given there
+var rfcVectors = []struct {
+	unix int64
+	code string
+}{
+	{59, "287082"},
+	{1111111109, "081804"},
+	{1111111111, "050471"},
+	{1234567890, "005924"},
+	{2000000000, "279037"},
+	{20000000000, "353130"},
+}
+
+func TestGenerateRFC6238(t *testing.T) {
+	key, err := encoding.DecodeString(rfcSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range rfcVectors {
+		if got := generate(key, v.unix/period); got != v.code {
+			t.Errorf("generate at %d = %s, want %s", v.unix, got, v.code)
+		}
+	}
+}
+
+func TestValidateRFC6238(t *testing.T) {
+	for _, v := range rfcVectors {
+		step, ok := Validate(rfcSecret, v.code, time.Unix(v.unix, 0))
+		if !ok {
+			t.Errorf("Validate(%s) at %d failed", v.code, v.unix)
+			continue
+		}
+		if step != v.unix/period {
+			t.Errorf("step = %d, want %d", step, v.unix/period)
+		}
+	}
+}
+
+func TestValidateSkew(t *testing.T) {
+	// "050471" belongs to step 37037037, the seconds 1111111110 to 1111111139
+	const code = "050471"
+	const step = 1111111110 / period
+	start := int64(step * period)
+
+	tests := []struct {
+		name string
+		unix int64
+		ok   bool
+	}{
+		{"first second of the step", start, true},
+		{"last second of the step", start + period - 1, true},
+		{"one step late", start + period, true},
+		{"last second one step late", start + 2*period - 1, true},
+		{"two steps late", start + 2*period, false},
+		{"one step early", start - period, true},
+		{"just over one step early", start - period - 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Validate(rfcSecret, code, time.Unix(tt.unix, 0))
+			if ok != tt.ok {
+				t.Fatalf("ok = %v, want %v", ok, tt.ok)
+			}
+			// the step returned is the code's own, whatever the clock says
+			if ok && got != step {
+				t.Fatalf("step = %d, want %d", got, step)
+			}
+		})
+	}
+}
+
+func TestValidateRejects(t *testing.T) {
+	at := time.Unix(59, 0)
+	tests := []struct {
+		name, secret, code string
+	}{
+		{"wrong code", rfcSecret, "287083"},
+		{"8 digits", rfcSecret, "94287082"},
+		{"5 digits", rfcSecret, "87082"},
+		{"empty", rfcSecret, ""},
+		{"invalid secret", "not base32!", "287082"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := Validate(tt.secret, tt.code, at); ok {
+				t.Fatal("accepted")
+			}
+		})
+	}
+}
+
+func TestValidateLowercaseSecret(t *testing.T) {
+	if _, ok := Validate(strings.ToLower(rfcSecret), "287082", time.Unix(59, 0)); !ok {
+		t.Fatal("a lowercase secret was not accepted")
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	a, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := GenerateSecret()
+	key, err := encoding.DecodeString(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(key) != 20 {
+		t.Fatalf("secret of %d bytes, want 20", len(key))
+	}
+	if a == b {
+		t.Fatal("two secrets are the same")
+	}
+}
+
+func TestURI(t *testing.T) {
+	u, err := url.Parse(URI(rfcSecret, "social network", "ann@example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" {
+		t.Fatalf("uri = %s", u)
+	}
+	if u.Path != "/social network:ann@example.com" {
+		t.Fatalf("label = %q", u.Path)
+	}
+	q := u.Query()
+	if q.Get("secret") != rfcSecret || q.Get("digits") != "6" || q.Get("period") != "30" {
+		t.Fatalf("query = %v", q)
+	}
+}
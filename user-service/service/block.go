@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+
+	pbu "user-service/protos/user-service"
+
+	"shared/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Block also removes the follows in both directions, a blocked user can not
+// follow the blocker again until unblocked
+func (s *UserService) Block(ctx context.Context, req *pbu.RelationReq) (*pbu.Status, error) {
+	if err := s.checkRelation(ctx, req); err != nil {
+		return nil, err
+	}
+
+	created, err := s.storage.Block().Block(req.UserId, req.TargetId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	s.suggestions.forget(req.UserId)
+	s.suggestions.forget(req.TargetId)
+	if !created {
+		return &pbu.Status{Message: "already blocked"}, nil
+	}
+	s.rebuildFeeds(req.UserId, req.TargetId)
+	return &pbu.Status{Message: "blocked"}, nil
+}
+
+func (s *UserService) Unblock(ctx context.Context, req *pbu.RelationReq) (*pbu.Status, error) {
+	if err := auth.CanModify(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	removed, err := s.storage.Block().Unblock(req.UserId, req.TargetId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if !removed {
+		return nil, status.Error(codes.NotFound, "not blocked")
+	}
+	return &pbu.Status{Message: "unblocked"}, nil
+}
+
+func (s *UserService) Mute(ctx context.Context, req *pbu.RelationReq) (*pbu.Status, error) {
+	if err := s.checkRelation(ctx, req); err != nil {
+		return nil, err
+	}
+
+	created, err := s.storage.Block().Mute(req.UserId, req.TargetId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	s.suggestions.forget(req.UserId)
+	if !created {
+		return &pbu.Status{Message: "already muted"}, nil
+	}
+	return &pbu.Status{Message: "muted"}, nil
+}
+
+func (s *UserService) Unmute(ctx context.Context, req *pbu.RelationReq) (*pbu.Status, error) {
+	if err := auth.CanModify(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	removed, err := s.storage.Block().Unmute(req.UserId, req.TargetId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if !removed {
+		return nil, status.Error(codes.NotFound, "not muted")
+	}
+	return &pbu.Status{Message: "unmuted"}, nil
+}
+
+// IsBlocked is asked by the other services, e.g. before a comment is written
+// under a post of user_id
+func (s *UserService) IsBlocked(ctx context.Context, req *pbu.RelationReq) (*pbu.IsBlockedRes, error) {
+	blocked, err := s.storage.Block().IsBlocked(req.UserId, req.TargetId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return &pbu.IsBlockedRes{Blocked: blocked}, nil
+}
+
+// GetHiddenUsers is asked by the other services to filter lists for a viewer,
+// only the viewer's own call or an admin's may ask
+func (s *UserService) GetHiddenUsers(ctx context.Context, req *pbu.HiddenUsersReq) (*pbu.HiddenUsersRes, error) {
+	res := &pbu.HiddenUsersRes{Blocked: []string{}, Muted: []string{}}
+	if req.ViewerId == "" {
+		return res, nil
+	}
+	if err := auth.CanModify(ctx, req.ViewerId); err != nil {
+		return nil, err
+	}
+
+	blocked, err := s.storage.Block().Blocked(req.ViewerId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	muted, err := s.storage.Block().Muted(req.ViewerId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	res.Blocked = append(res.Blocked, blocked...)
+	res.Muted = append(res.Muted, muted...)
+	return res, nil
+}
+
+func (s *UserService) checkRelation(ctx context.Context, req *pbu.RelationReq) error {
+	if err := auth.CanModify(ctx, req.UserId); err != nil {
+		return err
+	}
+	if req.UserId == req.TargetId {
+		return status.Error(codes.InvalidArgument, "users can not block or mute themselves")
+	}
+	return s.checkUserExists(req.TargetId)
+}
+
+// checkNotBlocked fails when either user blocked the other
+func (s *UserService) checkNotBlocked(userId, otherId string) error {
+	for _, pair := range [][2]string{{userId, otherId}, {otherId, userId}} {
+		blocked, err := s.storage.Block().IsBlocked(pair[0], pair[1])
+		if err != nil {
+			s.logger.Error(err.Error())
+			return err
+		}
+		if blocked {
+			return status.Error(codes.PermissionDenied, "user is blocked")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"user-service/config"
+	pbu "user-service/protos/user-service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetHiddenUsers(t *testing.T) {
+	blocks := &fakeBlockRepo{
+		blocked: map[[2]string]bool{{"ann", "bob"}: true, {"cid", "ann"}: true},
+		muted:   map[[2]string]bool{{"ann", "dan"}: true},
+	}
+	s := newTestService(&fakeStorage{block: blocks}, &fakeMailer{}, config.Config{})
+
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		viewer      string
+		wantBlocked []string
+		wantMuted   []string
+		want        codes.Code
+	}{
+		{"own", asUser("ann"), "ann", []string{"bob", "cid"}, []string{"dan"}, codes.OK},
+		{"admin", asAdmin(), "ann", []string{"bob", "cid"}, []string{"dan"}, codes.OK},
+		{"no viewer", context.Background(), "", []string{}, []string{}, codes.OK},
+		{"another viewer", asUser("bob"), "ann", nil, nil, codes.PermissionDenied},
+		{"no caller", context.Background(), "ann", nil, nil, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := s.GetHiddenUsers(tt.ctx, &pbu.HiddenUsersReq{ViewerId: tt.viewer})
+			if status.Code(err) != tt.want {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			if err != nil {
+				return
+			}
+			sort.Strings(res.Blocked)
+			if !reflect.DeepEqual(res.Blocked, tt.wantBlocked) || !reflect.DeepEqual(res.Muted, tt.wantMuted) {
+				t.Fatalf("blocked %v and muted %v, want %v and %v", res.Blocked, res.Muted, tt.wantBlocked, tt.wantMuted)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"user-service/pkg/etc"
+	pbu "user-service/protos/user-service"
+	"user-service/storage/repo"
+
+	"shared/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RequestEmailChange sends a code to the current and to the new address, the
+// email is swapped once both codes are confirmed. A new request replaces the
+// pending one.
+func (s *UserService) RequestEmailChange(ctx context.Context, req *pbu.EmailChangeReq) (*pbu.Status, error) {
+	if err := auth.CanModify(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	newEmail := strings.TrimSpace(req.NewEmail)
+	if addr, err := mail.ParseAddress(newEmail); err != nil || addr.Address != newEmail {
+		return nil, status.Error(codes.InvalidArgument, "new_email is not a valid email address")
+	}
+
+	user, err := s.storage.User().Get(&pbu.GetUserReq{Field: "id", Value: req.UserId})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if strings.EqualFold(newEmail, user.Email) {
+		return nil, status.Error(codes.InvalidArgument, "new_email is the current email")
+	}
+	if err := s.checkAvailable(newEmail, ""); err != nil {
+		return nil, err
+	}
+
+	oldCode, err := etc.GenerateCode(6)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, "failed to generate code")
+	}
+	newCode, err := etc.GenerateCode(6)
+	// the codes tell the addresses apart, so they must differ
+	for err == nil && newCode == oldCode {
+		newCode, err = etc.GenerateCode(6)
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, "failed to generate code")
+	}
+
+	err = s.storage.EmailChange().Save(&repo.EmailChange{
+		UserId:      user.Id,
+		NewEmail:    newEmail,
+		OldCodeHash: etc.HashCode(oldCode),
+		NewCodeHash: etc.HashCode(newCode),
+		ExpiresAt:   time.Now().Add(time.Minute * time.Duration(s.cfg.EmailChangeCodeTTL)),
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	oldBody := fmt.Sprintf("Someone asked to change the email of your account to %s.\n"+
+		"If it was you, confirm with the code %s. It expires in %d minutes.\n"+
+		"If it was not, change your password, the email stays as it is.", newEmail, oldCode, s.cfg.EmailChangeCodeTTL)
+	if err := s.mailer.Send(user.Email, "Confirm your email change", oldBody); err != nil {
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Unavailable, "failed to send confirmation code")
+	}
+	newBody := fmt.Sprintf("Your code to confirm this address for your account is %s. It expires in %d minutes.\n"+
+		"If you did not ask for it, ignore this email.", newCode, s.cfg.EmailChangeCodeTTL)
+	if err := s.mailer.Send(newEmail, "Confirm your new email", newBody); err != nil {
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Unavailable, "failed to send confirmation code")
+	}
+
+	return &pbu.Status{Message: "confirmation codes have been sent to the current and the new email"}, nil
+}
+
+// ConfirmEmailChange confirms one of the two addresses. When both are
+// confirmed the email is swapped and every session of the user is ended.
+func (s *UserService) ConfirmEmailChange(ctx context.Context, req *pbu.ConfirmEmailChangeReq) (*pbu.EmailChangeStatus, error) {
+	if err := auth.CanModify(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	invalid := status.Error(codes.InvalidArgument, "invalid or expired confirmation code")
+
+	change, err := s.storage.EmailChange().Get(req.UserId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "no pending email change")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if change.Attempts >= maxCodeAttempts || time.Now().After(change.ExpiresAt) {
+		return nil, invalid
+	}
+
+	change, err = s.storage.EmailChange().Confirm(req.UserId, etc.HashCode(strings.TrimSpace(req.Code)))
+	if errors.Is(err, sql.ErrNoRows) {
+		if err := s.storage.EmailChange().IncrAttempts(req.UserId); err != nil {
+			s.logger.Error(err.Error())
+		}
+		return nil, invalid
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	res := &pbu.EmailChangeStatus{
+		OldConfirmed: change.OldConfirmed,
+		NewConfirmed: change.NewConfirmed,
+	}
+	if !change.OldConfirmed || !change.NewConfirmed {
+		return res, nil
+	}
+
+	// only one of concurrent confirmations gets the change back
+	change, err = s.storage.EmailChange().Complete(req.UserId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "no pending email change")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	// the address may have been taken while the codes were out
+	if err := s.checkAvailable(change.NewEmail, ""); err != nil {
+		return nil, err
+	}
+
+	old, err := s.storage.User().Get(&pbu.GetUserReq{Field: "id", Value: req.UserId})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	user, err := s.storage.User().UpdateEmail(req.UserId, change.NewEmail)
+	if errors.Is(err, repo.ErrTaken) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	// sessions opened under the old email must not outlive it
+	if err := s.storage.RefreshToken().RevokeUser(user.Id); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	body := fmt.Sprintf("The email of your account has been changed to %s and every session has been signed out.", change.NewEmail)
+	if err := s.mailer.Send(old.Email, "Your email has been changed", body); err != nil {
+		// the change is done, a lost notice should not fail it
+		s.logger.Error(err.Error())
+	}
+
+	user.Password = ""
+	res.Changed = true
+	res.User = user
+	return res, nil
+}
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"user-service/config"
+	pbu "user-service/protos/user-service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type emailChangeFixture struct {
+	s       *UserService
+	users   *fakeUserRepo
+	changes *fakeEmailChangeRepo
+	tokens  *fakeRefreshTokenRepo
+	mail    *fakeMailer
+}
+
+// newEmailChangeFixture is ann@example.com, and bob@example.com who is in
+// the way of some changes
+func newEmailChangeFixture() *emailChangeFixture {
+	f := &emailChangeFixture{
+		users:   newFakeUserRepo(),
+		changes: newFakeEmailChangeRepo(),
+		tokens:  &fakeRefreshTokenRepo{},
+		mail:    &fakeMailer{},
+	}
+	f.users.users["ann"] = &pbu.User{Id: "ann", Email: "ann@example.com", UserName: "ann"}
+	f.users.users["bob"] = &pbu.User{Id: "bob", Email: "bob@example.com", UserName: "bob"}
+	f.s = newTestService(&fakeStorage{
+		user:         f.users,
+		emailChange:  f.changes,
+		refreshToken: f.tokens,
+	}, f.mail, config.Config{EmailChangeCodeTTL: 15})
+	return f
+}
+
+// request asks to move ann to newEmail and returns the codes mailed to the
+// old and the new address
+func (f *emailChangeFixture) request(t *testing.T, newEmail string) (oldCode, newCode string) {
+	t.Helper()
+	if _, err := f.s.RequestEmailChange(asUser("ann"), &pbu.EmailChangeReq{UserId: "ann", NewEmail: newEmail}); err != nil {
+		t.Fatalf("RequestEmailChange: %v", err)
+	}
+	sent := f.mail.sent[len(f.mail.sent)-2:]
+	if sent[0].to != "ann@example.com" || sent[1].to != newEmail {
+		t.Fatalf("codes mailed to %s and %s", sent[0].to, sent[1].to)
+	}
+	oldCode, newCode = mailedCode.FindString(sent[0].body), mailedCode.FindString(sent[1].body)
+	if oldCode == "" || newCode == "" || oldCode == newCode {
+		t.Fatalf("codes %q and %q", oldCode, newCode)
+	}
+	return oldCode, newCode
+}
+
+func (f *emailChangeFixture) confirm(code string) (*pbu.EmailChangeStatus, error) {
+	return f.s.ConfirmEmailChange(asUser("ann"), &pbu.ConfirmEmailChangeReq{UserId: "ann", Code: code})
+}
+
+func TestEmailChange(t *testing.T) {
+	f := newEmailChangeFixture()
+	oldCode, newCode := f.request(t, "ann@new.example.com")
+
+	// either address may confirm first
+	res, err := f.confirm(" " + newCode + " ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Changed || res.OldConfirmed || !res.NewConfirmed {
+		t.Fatalf("after the new code: %+v", res)
+	}
+	if f.users.users["ann"].Email != "ann@example.com" {
+		t.Fatal("email changed with one confirmation")
+	}
+
+	res, err = f.confirm(oldCode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Changed || !res.OldConfirmed || !res.NewConfirmed || res.User.Email != "ann@new.example.com" || res.User.Password != "" {
+		t.Fatalf("after both codes: %+v", res)
+	}
+	if f.users.users["ann"].Email != "ann@new.example.com" {
+		t.Error("email not changed")
+	}
+	if !reflect.DeepEqual(f.tokens.revoked, []string{"ann"}) {
+		t.Errorf("revoked sessions of %v, want ann", f.tokens.revoked)
+	}
+	if notice := f.mail.sent[len(f.mail.sent)-1]; notice.to != "ann@example.com" || notice.subject != "Your email has been changed" {
+		t.Errorf("last mail %+v, want the notice to the old address", notice)
+	}
+
+	if _, err := f.confirm(oldCode); status.Code(err) != codes.NotFound {
+		t.Errorf("confirming a finished change = %v, want NotFound", err)
+	}
+}
+
+func TestRequestEmailChangeInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		newEmail string
+		want     codes.Code
+	}{
+		{"another user", asUser("bob"), "ann@new.example.com", codes.PermissionDenied},
+		{"not an address", asUser("ann"), "ann", codes.InvalidArgument},
+		{"display name", asUser("ann"), "Ann <ann@new.example.com>", codes.InvalidArgument},
+		{"current email", asUser("ann"), "ANN@example.com", codes.InvalidArgument},
+		{"taken", asUser("ann"), "bob@example.com", codes.AlreadyExists},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newEmailChangeFixture()
+			_, err := f.s.RequestEmailChange(tt.ctx, &pbu.EmailChangeReq{UserId: "ann", NewEmail: tt.newEmail})
+			if status.Code(err) != tt.want {
+				t.Fatalf("error = %v, want %v", err, tt.want)
+			}
+			if len(f.mail.sent) != 0 || len(f.changes.changes) != 0 {
+				t.Errorf("change saved or mailed: %v, %v", f.changes.changes, f.mail.sent)
+			}
+		})
+	}
+}
+
+func TestConfirmEmailChangeInvalid(t *testing.T) {
+	t.Run("no change", func(t *testing.T) {
+		f := newEmailChangeFixture()
+		if _, err := f.confirm("123456"); status.Code(err) != codes.NotFound {
+			t.Errorf("error = %v, want NotFound", err)
+		}
+	})
+
+	t.Run("too many wrong codes", func(t *testing.T) {
+		f := newEmailChangeFixture()
+		oldCode, _ := f.request(t, "ann@new.example.com")
+		for i := 0; i < maxCodeAttempts; i++ {
+			if _, err := f.confirm("000000x"); status.Code(err) != codes.InvalidArgument {
+				t.Fatalf("wrong code %d = %v, want InvalidArgument", i+1, err)
+			}
+		}
+		if _, err := f.confirm(oldCode); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("right code after too many attempts = %v, want InvalidArgument", err)
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		f := newEmailChangeFixture()
+		oldCode, _ := f.request(t, "ann@new.example.com")
+		f.changes.changes["ann"].ExpiresAt = time.Now().Add(-time.Second)
+		if _, err := f.confirm(oldCode); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("expired code = %v, want InvalidArgument", err)
+		}
+	})
+
+	t.Run("replaced by a new request", func(t *testing.T) {
+		f := newEmailChangeFixture()
+		firstOld, _ := f.request(t, "ann@new.example.com")
+		secondOld, _ := f.request(t, "ann@other.example.com")
+		if firstOld == secondOld {
+			t.Skip("the same code was drawn twice")
+		}
+		if _, err := f.confirm(firstOld); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("code of the replaced request = %v, want InvalidArgument", err)
+		}
+	})
+
+	t.Run("address taken meanwhile", func(t *testing.T) {
+		f := newEmailChangeFixture()
+		oldCode, newCode := f.request(t, "ann@new.example.com")
+		f.users.users["cid"] = &pbu.User{Id: "cid", Email: "ann@new.example.com", UserName: "cid"}
+		if _, err := f.confirm(oldCode); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.confirm(newCode); status.Code(err) != codes.AlreadyExists {
+			t.Errorf("error = %v, want AlreadyExists", err)
+		}
+		if f.users.users["ann"].Email != "ann@example.com" || len(f.tokens.revoked) != 0 {
+			t.Error("email changed to a taken address")
+		}
+	})
+}
@@ -0,0 +1,257 @@
+package service
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	pbc "user-service/protos/comment-service"
+	pbp "user-service/protos/post-service"
+	pbu "user-service/protos/user-service"
+	"user-service/storage/repo"
+
+	"shared/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// exportPageSize is how many follows are read at a time while exporting
+	exportPageSize = 500
+	// exportChunkSize bounds the content of one ExportChunk, well below the
+	// message size limit of gRPC
+	exportChunkSize = 1 << 20
+)
+
+// exportJSON is how the protobuf messages of an export are written
+var exportJSON = protojson.MarshalOptions{Multiline: true, EmitUnpopulated: true}
+
+// exportedRelation is a follower, followee or follow request in an export
+type exportedRelation struct {
+	Id       string    `json:"id"`
+	UserName string    `json:"user_name"`
+	Since    time.Time `json:"since"`
+}
+
+// ExportUserData streams everything kept about the user, their posts and
+// comments included, as one JSON document per kind of data
+func (s *UserService) ExportUserData(req *pbu.ExportUserDataReq, stream pbu.UserService_ExportUserDataServer) error {
+	ctx := stream.Context()
+	if err := auth.CanModify(ctx, req.UserId); err != nil {
+		return err
+	}
+
+	user, err := s.storage.User().Get(&pbu.GetUserReq{Field: "id", Value: req.UserId})
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+	user.Password = ""
+
+	w := &chunkWriter{send: stream.Send}
+	addJSON := func(name string, v interface{}) error {
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		return w.file(name, data)
+	}
+
+	profile, err := exportJSON.Marshal(user)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+	if err := w.file("profile.json", profile); err != nil {
+		return err
+	}
+
+	relations := []struct {
+		name string
+		list func(string, *repo.Cursor, int64) ([]*repo.FollowEdge, error)
+	}{
+		{"followers.json", s.storage.Follow().ListFollowers},
+		{"following.json", s.storage.Follow().ListFollowing},
+		{"follow_requests.json", s.storage.Follow().ListRequests},
+	}
+	for _, r := range relations {
+		edges, err := exportRelations(req.UserId, r.list)
+		if err != nil {
+			s.logger.Error(err.Error())
+			return err
+		}
+		if err := addJSON(r.name, edges); err != nil {
+			s.logger.Error(err.Error())
+			return err
+		}
+	}
+
+	blocked, err := s.storage.Block().Blocking(req.UserId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+	muted, err := s.storage.Block().Muted(req.UserId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+	if err := addJSON("blocked.json", nonNil(blocked)); err != nil {
+		return err
+	}
+	if err := addJSON("muted.json", nonNil(muted)); err != nil {
+		return err
+	}
+
+	// an export without the posts or comments is not complete, so the
+	// errors of the other services fail it as a whole
+	posts, err := s.client.PostService().ExportPosts(ctx, &pbp.GetPostsByOwnerIdRequest{OwnerId: req.UserId})
+	if err == nil {
+		err = w.array("posts.json", func() (proto.Message, error) { return posts.Recv() })
+	}
+	if err != nil {
+		s.logger.Error("failed to export posts: " + err.Error())
+		return err
+	}
+	comments, err := s.client.CommentService().ExportComments(ctx, &pbc.IdRequst{Id: req.UserId})
+	if err == nil {
+		err = w.array("comments.json", func() (proto.Message, error) { return comments.Recv() })
+	}
+	if err != nil {
+		s.logger.Error("failed to export comments: " + err.Error())
+		return err
+	}
+
+	return w.flush()
+}
+
+// chunkWriter cuts the documents of an export into chunks of at most
+// exportChunkSize bytes
+type chunkWriter struct {
+	send func(*pbu.ExportChunk) error
+	name string
+	buf  []byte
+}
+
+// start ends the current document and begins the one called name
+func (w *chunkWriter) start(name string) error {
+	if err := w.flush(); err != nil {
+		return err
+	}
+	w.name = name
+	return nil
+}
+
+// Write adds to the current document, sending every chunk that fills up
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := exportChunkSize - len(w.buf)
+		if n > len(p) {
+			n = len(p)
+		}
+		w.buf = append(w.buf, p[:n]...)
+		p = p[n:]
+		written += n
+		if len(w.buf) == exportChunkSize {
+			if err := w.flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+	return written, nil
+}
+
+// flush sends what is left of the current document
+func (w *chunkWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	chunk := &pbu.ExportChunk{Name: w.name, Content: w.buf}
+	w.buf = nil
+	return w.send(chunk)
+}
+
+// file writes a whole document
+func (w *chunkWriter) file(name string, content []byte) error {
+	if err := w.start(name); err != nil {
+		return err
+	}
+	_, err := w.Write(content)
+	return err
+}
+
+// array writes a document holding a JSON array of the messages next returns
+// until io.EOF, without keeping more than a chunk of them in memory
+func (w *chunkWriter) array(name string, next func() (proto.Message, error)) error {
+	if err := w.start(name); err != nil {
+		return err
+	}
+	sep := "[\n"
+	for {
+		m, err := next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		data, err := exportJSON.Marshal(m)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, sep); err != nil {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+		sep = ",\n"
+	}
+	if sep == "[\n" {
+		_, err := io.WriteString(w, "[]\n")
+		return err
+	}
+	_, err := io.WriteString(w, "\n]\n")
+	return err
+}
+
+// exportRelations reads a whole follow list page by page
+func exportRelations(userId string, list func(string, *repo.Cursor, int64) ([]*repo.FollowEdge, error)) ([]exportedRelation, error) {
+	relations := []exportedRelation{}
+	var after *repo.Cursor
+	for {
+		edges, err := list(userId, after, exportPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, edge := range edges {
+			relations = append(relations, exportedRelation{
+				Id:       edge.User.Id,
+				UserName: edge.User.UserName,
+				Since:    edge.FollowedAt,
+			})
+		}
+		if len(edges) < exportPageSize {
+			return relations, nil
+		}
+		last := edges[len(edges)-1]
+		after = &repo.Cursor{CreatedAt: last.FollowedAt, Id: last.User.Id}
+	}
+}
+
+// nonNil makes empty lists come out as [] rather than null
+func nonNil(ids []string) []string {
+	if ids == nil {
+		return []string{}
+	}
+	return ids
+}
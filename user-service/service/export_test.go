@@ -0,0 +1,116 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+
+	pbp "user-service/protos/post-service"
+	pbu "user-service/protos/user-service"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// collectChunks returns a chunkWriter and the chunks it sent
+func collectChunks() (*chunkWriter, *[]*pbu.ExportChunk) {
+	var chunks []*pbu.ExportChunk
+	w := &chunkWriter{send: func(c *pbu.ExportChunk) error {
+		chunks = append(chunks, c)
+		return nil
+	}}
+	return w, &chunks
+}
+
+func TestChunkWriterSplitsDocuments(t *testing.T) {
+	w, chunks := collectChunks()
+	big := bytes.Repeat([]byte("x"), 2*exportChunkSize+10)
+	if err := w.file("big.json", big); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.file("small.json", []byte("{}")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	docs := map[string][]byte{}
+	for _, c := range *chunks {
+		if len(c.Content) == 0 || len(c.Content) > exportChunkSize {
+			t.Fatalf("chunk of %s holds %d bytes", c.Name, len(c.Content))
+		}
+		if len(names) == 0 || names[len(names)-1] != c.Name {
+			names = append(names, c.Name)
+		}
+		docs[c.Name] = append(docs[c.Name], c.Content...)
+	}
+	if len(*chunks) != 4 {
+		t.Errorf("sent %d chunks, want 4", len(*chunks))
+	}
+	if len(names) != 2 || names[0] != "big.json" || names[1] != "small.json" {
+		t.Fatalf("documents = %v", names)
+	}
+	if !bytes.Equal(docs["big.json"], big) || string(docs["small.json"]) != "{}" {
+		t.Fatal("documents do not add up to what was written")
+	}
+}
+
+func TestChunkWriterArray(t *testing.T) {
+	posts := []*pbp.Post{{Id: "post-1", Title: "one"}, {Id: "post-2", Title: "two"}}
+	next := func(posts []*pbp.Post) func() (proto.Message, error) {
+		return func() (proto.Message, error) {
+			if len(posts) == 0 {
+				return nil, io.EOF
+			}
+			p := posts[0]
+			posts = posts[1:]
+			return p, nil
+		}
+	}
+
+	for _, tt := range []struct {
+		name  string
+		posts []*pbp.Post
+	}{{"empty", nil}, {"two posts", posts}} {
+		t.Run(tt.name, func(t *testing.T) {
+			w, chunks := collectChunks()
+			if err := w.array("posts.json", next(tt.posts)); err != nil {
+				t.Fatal(err)
+			}
+			if err := w.flush(); err != nil {
+				t.Fatal(err)
+			}
+			var doc []byte
+			for _, c := range *chunks {
+				doc = append(doc, c.Content...)
+			}
+			var got []struct {
+				Id    string `json:"id"`
+				Title string `json:"title"`
+			}
+			if err := json.Unmarshal(doc, &got); err != nil {
+				t.Fatalf("%s is not a JSON array: %v", doc, err)
+			}
+			if got == nil || len(got) != len(tt.posts) {
+				t.Fatalf("got %s", doc)
+			}
+			for i, p := range tt.posts {
+				if got[i].Id != p.Id || got[i].Title != p.Title {
+					t.Errorf("item %d = %+v, want %v", i, got[i], p)
+				}
+			}
+		})
+	}
+}
+
+func TestChunkWriterArrayFails(t *testing.T) {
+	w, _ := collectChunks()
+	broken := errors.New("stream broken")
+	err := w.array("posts.json", func() (proto.Message, error) { return nil, broken })
+	if !errors.Is(err, broken) {
+		t.Fatalf("got %v, want the error of the stream", err)
+	}
+}
@@ -0,0 +1,1004 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"user-service/config"
+	"user-service/pkg/hash"
+	l "user-service/pkg/logger"
+	pbc "user-service/protos/comment-service"
+	pbp "user-service/protos/post-service"
+	pbu "user-service/protos/user-service"
+	"user-service/storage/repo"
+
+	"shared/auth"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeStorage serves the repos a test sets, using one it did not set panics
+type fakeStorage struct {
+	user          repo.UserStorageI
+	refreshToken  repo.RefreshTokenStorageI
+	passwordReset repo.PasswordResetStorageI
+	emailChange   repo.EmailChangeStorageI
+	twoFactor     repo.TwoFactorStorageI
+	loginAttempt  repo.LoginAttemptStorageI
+	follow        repo.FollowStorageI
+	block         repo.BlockStorageI
+}
+
+func (s *fakeStorage) User() repo.UserStorageI                   { return s.user }
+func (s *fakeStorage) RefreshToken() repo.RefreshTokenStorageI   { return s.refreshToken }
+func (s *fakeStorage) PasswordReset() repo.PasswordResetStorageI { return s.passwordReset }
+func (s *fakeStorage) EmailChange() repo.EmailChangeStorageI     { return s.emailChange }
+func (s *fakeStorage) TwoFactor() repo.TwoFactorStorageI         { return s.twoFactor }
+func (s *fakeStorage) LoginAttempt() repo.LoginAttemptStorageI   { return s.loginAttempt }
+func (s *fakeStorage) Follow() repo.FollowStorageI               { return s.follow }
+func (s *fakeStorage) Block() repo.BlockStorageI                 { return s.block }
+
+// fakeUserRepo keeps users and registrations in maps, the methods it does not
+// override panic through the nil embedded interface
+type fakeUserRepo struct {
+	repo.UserStorageI
+
+	mu            sync.Mutex
+	users         map[string]*pbu.User
+	registrations map[string]*repo.Registration
+	// deleted users are only found by GetRestorable until they are restored
+	deleted map[string]bool
+	// purgeWindow is the restore window SchedulePurges was last called with
+	purgeWindow time.Duration
+	// createErr is returned by Create instead of storing the user
+	createErr error
+}
+
+func newFakeUserRepo() *fakeUserRepo {
+	return &fakeUserRepo{
+		users:         map[string]*pbu.User{},
+		registrations: map[string]*repo.Registration{},
+		deleted:       map[string]bool{},
+	}
+}
+
+func (r *fakeUserRepo) Create(user *pbu.User) (*pbu.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.createErr != nil {
+		return nil, r.createErr
+	}
+	created := proto.Clone(user).(*pbu.User)
+	if created.Id == "" {
+		created.Id = "user-" + created.UserName
+	}
+	r.users[created.Id] = created
+	return proto.Clone(created).(*pbu.User), nil
+}
+
+func (r *fakeUserRepo) Get(req *pbu.GetUserReq) (*pbu.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, u := range r.users {
+		if !r.deleted[u.Id] && fieldValue(u, req.Field) == req.Value {
+			return proto.Clone(u).(*pbu.User), nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (r *fakeUserRepo) GetRestorable(req *pbu.GetUserReq) (*pbu.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, u := range r.users {
+		if r.deleted[u.Id] && fieldValue(u, req.Field) == req.Value {
+			return proto.Clone(u).(*pbu.User), nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (r *fakeUserRepo) Restore(userId string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.deleted[userId] {
+		return false, nil
+	}
+	delete(r.deleted, userId)
+	return true, nil
+}
+
+func (r *fakeUserRepo) Purge(userId string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.deleted[userId] {
+		return false, nil
+	}
+	delete(r.users, userId)
+	delete(r.deleted, userId)
+	return true, nil
+}
+
+func (r *fakeUserRepo) SchedulePurges(restoreWindow time.Duration) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.purgeWindow = restoreWindow
+	return 0, nil
+}
+
+func (r *fakeUserRepo) CheckUniques(req *pbu.CheckUniqReq) (*pbu.CheckUniqResp, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, u := range r.users {
+		if fieldValue(u, req.Field) == req.Value {
+			return &pbu.CheckUniqResp{IsUnique: false}, nil
+		}
+	}
+	return &pbu.CheckUniqResp{IsUnique: true}, nil
+}
+
+func (r *fakeUserRepo) SaveRegistration(reg *repo.Registration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	saved := *reg
+	r.registrations[reg.User.Email] = &saved
+	return nil
+}
+
+func (r *fakeUserRepo) GetRegistration(email string) (*repo.Registration, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	reg, ok := r.registrations[email]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	res := *reg
+	return &res, nil
+}
+
+func (r *fakeUserRepo) IncrRegistrationAttempts(email string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if reg, ok := r.registrations[email]; ok {
+		reg.Attempts++
+	}
+	return nil
+}
+
+func (r *fakeUserRepo) DeleteRegistration(email string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.registrations, email)
+	return nil
+}
+
+func (r *fakeUserRepo) UpdateEmail(userId, email string) (*pbu.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.users[userId]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	u.Email = email
+	return proto.Clone(u).(*pbu.User), nil
+}
+
+// GetByIds leaves deleted and unknown users out
+func (r *fakeUserRepo) GetByIds(ids []string) ([]*pbu.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var users []*pbu.User
+	for _, id := range ids {
+		if u, ok := r.users[id]; ok && !r.deleted[id] {
+			users = append(users, proto.Clone(u).(*pbu.User))
+		}
+	}
+	return users, nil
+}
+
+// SetPrivate only flips the flag, the postgres repo also approves the
+// pending requests when the account goes public
+func (r *fakeUserRepo) SetPrivate(userId string, private bool) (*pbu.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.users[userId]
+	if !ok || r.deleted[userId] {
+		return nil, sql.ErrNoRows
+	}
+	u.IsPrivate = private
+	return proto.Clone(u).(*pbu.User), nil
+}
+
+func (r *fakeUserRepo) UpdatePassword(userId, password string) (*pbu.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	u, ok := r.users[userId]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	u.Password = password
+	return proto.Clone(u).(*pbu.User), nil
+}
+
+func fieldValue(u *pbu.User, field string) string {
+	switch strings.ToLower(field) {
+	case "id":
+		return u.Id
+	case "email":
+		return u.Email
+	case "username", "user_name":
+		return u.UserName
+	}
+	return ""
+}
+
+// fakeLoginAttemptRepo keeps the failed logins of each key
+type fakeLoginAttemptRepo struct {
+	mu       sync.Mutex
+	attempts map[string]*repo.LoginAttempt
+}
+
+func newFakeLoginAttemptRepo() *fakeLoginAttemptRepo {
+	return &fakeLoginAttemptRepo{attempts: map[string]*repo.LoginAttempt{}}
+}
+
+func (r *fakeLoginAttemptRepo) Get(key string) (*repo.LoginAttempt, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	a, ok := r.attempts[key]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	res := *a
+	return &res, nil
+}
+
+func (r *fakeLoginAttemptRepo) RecordFailure(key string, window time.Duration) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	a, ok := r.attempts[key]
+	if !ok {
+		a = &repo.LoginAttempt{Key: key}
+		r.attempts[key] = a
+	}
+	if now.Sub(a.LastFailureAt) > window {
+		a.Failures = 0
+	}
+	a.Failures++
+	a.LastFailureAt = now
+	return a.Failures, nil
+}
+
+func (r *fakeLoginAttemptRepo) Lock(key string, until time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if a, ok := r.attempts[key]; ok {
+		a.LockedUntil = &until
+	}
+	return nil
+}
+
+func (r *fakeLoginAttemptRepo) Delete(key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.attempts, key)
+	return nil
+}
+
+// fakeFollowRepo keeps follows as edges and pages through them by keyset
+// like the postgres repo, users are looked up in users
+type fakeFollowRepo struct {
+	repo.FollowStorageI
+
+	mu       sync.Mutex
+	users    *fakeUserRepo
+	follows  []fakeFollow
+	requests []fakeFollow
+}
+
+type fakeFollow struct {
+	followerId, followeeId string
+	at                     time.Time
+}
+
+func newFakeFollowRepo(users *fakeUserRepo) *fakeFollowRepo {
+	return &fakeFollowRepo{users: users}
+}
+
+func (r *fakeFollowRepo) add(followerId, followeeId string, at time.Time) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.follows = append(r.follows, fakeFollow{followerId: followerId, followeeId: followeeId, at: at})
+}
+
+func (r *fakeFollowRepo) Follow(followerId, followeeId string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if indexOfFollow(r.follows, followerId, followeeId) >= 0 {
+		return false, nil
+	}
+	r.follows = append(r.follows, fakeFollow{followerId: followerId, followeeId: followeeId, at: time.Now()})
+	return true, nil
+}
+
+func (r *fakeFollowRepo) Unfollow(followerId, followeeId string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return removeFollow(&r.follows, followerId, followeeId), nil
+}
+
+func (r *fakeFollowRepo) IsFollowing(followerId, followeeId string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return indexOfFollow(r.follows, followerId, followeeId) >= 0, nil
+}
+
+func (r *fakeFollowRepo) RequestFollow(followerId, followeeId string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if indexOfFollow(r.requests, followerId, followeeId) >= 0 {
+		return false, nil
+	}
+	r.requests = append(r.requests, fakeFollow{followerId: followerId, followeeId: followeeId, at: time.Now()})
+	return true, nil
+}
+
+func (r *fakeFollowRepo) ApproveRequest(followerId, followeeId string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !removeFollow(&r.requests, followerId, followeeId) {
+		return false, nil
+	}
+	if indexOfFollow(r.follows, followerId, followeeId) < 0 {
+		r.follows = append(r.follows, fakeFollow{followerId: followerId, followeeId: followeeId, at: time.Now()})
+	}
+	return true, nil
+}
+
+func (r *fakeFollowRepo) DeleteRequest(followerId, followeeId string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return removeFollow(&r.requests, followerId, followeeId), nil
+}
+
+func (r *fakeFollowRepo) VisibleOwners(viewerId string, ownerIds []string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var visible []string
+	for _, id := range ownerIds {
+		owner, err := r.users.Get(&pbu.GetUserReq{Field: "id", Value: id})
+		if err != nil {
+			continue
+		}
+		if !owner.IsPrivate || id == viewerId || indexOfFollow(r.follows, viewerId, id) >= 0 {
+			visible = append(visible, id)
+		}
+	}
+	return visible, nil
+}
+
+func (r *fakeFollowRepo) ListFollowers(userId string, after *repo.Cursor, limit int64) ([]*repo.FollowEdge, error) {
+	return r.list(&r.follows, after, limit, func(f fakeFollow) (string, bool) { return f.followerId, f.followeeId == userId })
+}
+
+func (r *fakeFollowRepo) ListFollowing(userId string, after *repo.Cursor, limit int64) ([]*repo.FollowEdge, error) {
+	return r.list(&r.follows, after, limit, func(f fakeFollow) (string, bool) { return f.followeeId, f.followerId == userId })
+}
+
+func (r *fakeFollowRepo) ListRequests(userId string, after *repo.Cursor, limit int64) ([]*repo.FollowEdge, error) {
+	return r.list(&r.requests, after, limit, func(f fakeFollow) (string, bool) { return f.followerId, f.followeeId == userId })
+}
+
+// FollowingIds includes pending requests like the postgres repo
+func (r *fakeFollowRepo) FollowingIds(userId string) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var ids []string
+	for _, f := range append(append([]fakeFollow(nil), r.follows...), r.requests...) {
+		if f.followerId == userId {
+			ids = append(ids, f.followeeId)
+		}
+	}
+	return ids, nil
+}
+
+func (r *fakeFollowRepo) FriendsOfFriends(userId string, limit int64) ([]*repo.UserScore, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	mutual := map[string]int64{}
+	for _, f1 := range r.follows {
+		if f1.followerId != userId {
+			continue
+		}
+		for _, f2 := range r.follows {
+			if f2.followerId == f1.followeeId && f2.followeeId != userId && indexOfFollow(r.follows, userId, f2.followeeId) < 0 {
+				mutual[f2.followeeId]++
+			}
+		}
+	}
+	var scores []*repo.UserScore
+	for id, n := range mutual {
+		scores = append(scores, &repo.UserScore{UserId: id, Score: n})
+	}
+	sort.Slice(scores, func(i, j int) bool { return scores[i].Score > scores[j].Score })
+	if int64(len(scores)) > limit {
+		scores = scores[:limit]
+	}
+	return scores, nil
+}
+
+func indexOfFollow(follows []fakeFollow, followerId, followeeId string) int {
+	for i, f := range follows {
+		if f.followerId == followerId && f.followeeId == followeeId {
+			return i
+		}
+	}
+	return -1
+}
+
+func removeFollow(follows *[]fakeFollow, followerId, followeeId string) bool {
+	i := indexOfFollow(*follows, followerId, followeeId)
+	if i < 0 {
+		return false
+	}
+	*follows = append((*follows)[:i], (*follows)[i+1:]...)
+	return true
+}
+
+// list orders the edges of from that match picks by time and then id, both
+// descending, and returns the ones after the cursor
+func (r *fakeFollowRepo) list(from *[]fakeFollow, after *repo.Cursor, limit int64, match func(fakeFollow) (string, bool)) ([]*repo.FollowEdge, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var edges []*repo.FollowEdge
+	for _, f := range *from {
+		other, ok := match(f)
+		if !ok {
+			continue
+		}
+		user, err := r.users.Get(&pbu.GetUserReq{Field: "id", Value: other})
+		if err != nil {
+			continue
+		}
+		edges = append(edges, &repo.FollowEdge{User: user, FollowedAt: f.at})
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if !edges[i].FollowedAt.Equal(edges[j].FollowedAt) {
+			return edges[i].FollowedAt.After(edges[j].FollowedAt)
+		}
+		return edges[i].User.Id > edges[j].User.Id
+	})
+
+	var page []*repo.FollowEdge
+	for _, e := range edges {
+		if after != nil && !(e.FollowedAt.Before(after.CreatedAt) ||
+			e.FollowedAt.Equal(after.CreatedAt) && e.User.Id < after.Id) {
+			continue
+		}
+		if int64(len(page)) == limit {
+			break
+		}
+		page = append(page, e)
+	}
+	return page, nil
+}
+
+// fakeBlockRepo keeps who blocked and who muted whom
+type fakeBlockRepo struct {
+	repo.BlockStorageI
+
+	blocked map[[2]string]bool
+	muted   map[[2]string]bool
+}
+
+func (r *fakeBlockRepo) IsBlocked(blockerId, blockedId string) (bool, error) {
+	return r.blocked[[2]string{blockerId, blockedId}], nil
+}
+
+// Blocked goes both ways like the postgres repo
+func (r *fakeBlockRepo) Blocked(userId string) ([]string, error) {
+	var ids []string
+	for pair := range r.blocked {
+		if pair[0] == userId {
+			ids = append(ids, pair[1])
+		}
+		if pair[1] == userId {
+			ids = append(ids, pair[0])
+		}
+	}
+	return ids, nil
+}
+
+func (r *fakeBlockRepo) Muted(userId string) ([]string, error) {
+	var ids []string
+	for pair := range r.muted {
+		if pair[0] == userId {
+			ids = append(ids, pair[1])
+		}
+	}
+	return ids, nil
+}
+
+// fakePostClient records the feeds post-service was asked to rebuild
+type fakePostClient struct {
+	pbp.PostServiceClient
+
+	mu      sync.Mutex
+	rebuilt []string
+	// neighbours answers GetCategoryNeighbours, neighboursErr fails it
+	neighbours    []*pbp.UserScore
+	neighboursErr error
+	// purged holds the owners PurgeOwner was asked for and the role sent
+	purged []string
+}
+
+func (c *fakePostClient) PurgeOwner(ctx context.Context, in *pbp.GetPostsByOwnerIdRequest, opts ...grpc.CallOption) (*pbp.PurgeRes, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.purged = append(c.purged, in.OwnerId+" as "+sentRole(ctx))
+	return &pbp.PurgeRes{}, nil
+}
+
+func (c *fakePostClient) GetCategoryNeighbours(ctx context.Context, in *pbp.GetPostsByOwnerIdRequest, opts ...grpc.CallOption) (*pbp.UserScores, error) {
+	if c.neighboursErr != nil {
+		return nil, c.neighboursErr
+	}
+	return &pbp.UserScores{Scores: c.neighbours}, nil
+}
+
+func (c *fakePostClient) RebuildFeed(ctx context.Context, in *pbp.RebuildFeedReq, opts ...grpc.CallOption) (*pbp.RebuildFeedRes, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rebuilt = append(c.rebuilt, in.UserId)
+	return &pbp.RebuildFeedRes{}, nil
+}
+
+// waitRebuilt waits for n rebuilds, they run in the background
+func (c *fakePostClient) waitRebuilt(t *testing.T, n int) []string {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for {
+		c.mu.Lock()
+		rebuilt := append([]string(nil), c.rebuilt...)
+		c.mu.Unlock()
+		if len(rebuilt) >= n {
+			sort.Strings(rebuilt)
+			return rebuilt
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d feeds rebuilt, want %d", len(rebuilt), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// fakeCommentClient answers GetCoCommenters, coCommentersErr fails it
+type fakeCommentClient struct {
+	pbc.CommentServiceClient
+
+	coCommenters    []*pbc.UserScore
+	coCommentersErr error
+	// purged holds the users PurgeComments was asked for and the role sent
+	purged []string
+}
+
+func (c *fakeCommentClient) PurgeComments(ctx context.Context, in *pbc.PurgeCommentsReq, opts ...grpc.CallOption) (*pbc.PurgeRes, error) {
+	c.purged = append(c.purged, in.UserId+" as "+sentRole(ctx))
+	return &pbc.PurgeRes{}, nil
+}
+
+// sentRole is the role a call made with ctx carries to the other services
+func sentRole(ctx context.Context) string {
+	var role string
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		if v := md.Get(auth.RoleKey); len(v) > 0 {
+			role = v[0]
+		}
+		return nil
+	}
+	auth.ClientInterceptor("secret")(ctx, "", nil, nil, nil, invoker)
+	return role
+}
+
+func (c *fakeCommentClient) GetCoCommenters(ctx context.Context, in *pbc.IdRequst, opts ...grpc.CallOption) (*pbc.UserScores, error) {
+	if c.coCommentersErr != nil {
+		return nil, c.coCommentersErr
+	}
+	return &pbc.UserScores{Scores: c.coCommenters}, nil
+}
+
+// fakeClients serves the fakes of the other services
+type fakeClients struct {
+	post    *fakePostClient
+	comment *fakeCommentClient
+}
+
+func (c *fakeClients) PostService() pbp.PostServiceClient       { return c.post }
+func (c *fakeClients) CommentService() pbc.CommentServiceClient { return c.comment }
+
+// fakeEmailChangeRepo follows the contract of repo.EmailChangeStorageI
+type fakeEmailChangeRepo struct {
+	mu      sync.Mutex
+	changes map[string]*repo.EmailChange
+}
+
+func newFakeEmailChangeRepo() *fakeEmailChangeRepo {
+	return &fakeEmailChangeRepo{changes: map[string]*repo.EmailChange{}}
+}
+
+func (r *fakeEmailChangeRepo) Save(change *repo.EmailChange) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	saved := *change
+	saved.OldConfirmed, saved.NewConfirmed, saved.Attempts = false, false, 0
+	r.changes[change.UserId] = &saved
+	return nil
+}
+
+func (r *fakeEmailChangeRepo) Get(userId string) (*repo.EmailChange, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	change, ok := r.changes[userId]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	res := *change
+	return &res, nil
+}
+
+func (r *fakeEmailChangeRepo) IncrAttempts(userId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if change, ok := r.changes[userId]; ok {
+		change.Attempts++
+	}
+	return nil
+}
+
+func (r *fakeEmailChangeRepo) Confirm(userId, codeHash string) (*repo.EmailChange, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	change, ok := r.changes[userId]
+	if !ok || !change.ExpiresAt.After(time.Now()) || (change.OldCodeHash != codeHash && change.NewCodeHash != codeHash) {
+		return nil, sql.ErrNoRows
+	}
+	change.OldConfirmed = change.OldConfirmed || change.OldCodeHash == codeHash
+	change.NewConfirmed = change.NewConfirmed || change.NewCodeHash == codeHash
+	res := *change
+	return &res, nil
+}
+
+func (r *fakeEmailChangeRepo) Complete(userId string) (*repo.EmailChange, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	change, ok := r.changes[userId]
+	if !ok || !change.OldConfirmed || !change.NewConfirmed {
+		return nil, sql.ErrNoRows
+	}
+	delete(r.changes, userId)
+	return change, nil
+}
+
+// fakePasswordResetRepo follows the contract of repo.PasswordResetStorageI
+// with a clock the test moves
+type fakePasswordResetRepo struct {
+	mu     sync.Mutex
+	now    time.Time
+	resets map[string]*fakeReset
+}
+
+type fakeReset struct {
+	codeHash  string
+	attempts  int
+	expiresAt time.Time
+	issuedAt  time.Time
+}
+
+func newFakePasswordResetRepo() *fakePasswordResetRepo {
+	return &fakePasswordResetRepo{now: time.Now(), resets: map[string]*fakeReset{}}
+}
+
+func (r *fakePasswordResetRepo) advance(d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.now = r.now.Add(d)
+}
+
+func (r *fakePasswordResetRepo) Save(reset *repo.PasswordReset, maxAttempts int, resendAfter time.Duration) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ttl := reset.ExpiresAt.Sub(time.Now())
+	prev, ok := r.resets[reset.UserId]
+	attempts := 0
+	if ok && prev.expiresAt.After(r.now) {
+		if prev.attempts >= maxAttempts || r.now.Sub(prev.issuedAt) < resendAfter {
+			return false, nil
+		}
+		attempts = prev.attempts
+	}
+	r.resets[reset.UserId] = &fakeReset{
+		codeHash:  reset.CodeHash,
+		attempts:  attempts,
+		expiresAt: r.now.Add(ttl),
+		issuedAt:  r.now,
+	}
+	return true, nil
+}
+
+func (r *fakePasswordResetRepo) Consume(userId, codeHash string, maxAttempts int) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	reset, ok := r.resets[userId]
+	if !ok || reset.attempts >= maxAttempts || !reset.expiresAt.After(r.now) {
+		return false, nil
+	}
+	reset.attempts++
+	if reset.codeHash != codeHash {
+		return false, nil
+	}
+	reset.expiresAt = r.now
+	return true, nil
+}
+
+// fakeRefreshTokenRepo keeps the tokens by id and records whose sessions
+// were revoked
+type fakeRefreshTokenRepo struct {
+	repo.RefreshTokenStorageI
+
+	mu      sync.Mutex
+	tokens  map[string]*repo.RefreshToken
+	revoked []string
+	// now is the time Rotate checks expiry against, time.Now when nil
+	now func() time.Time
+	// beforeRotate runs inside Rotate first, to race it with another request
+	beforeRotate func()
+}
+
+func (r *fakeRefreshTokenRepo) Create(token *repo.RefreshToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.tokens == nil {
+		r.tokens = map[string]*repo.RefreshToken{}
+	}
+	saved := *token
+	r.tokens[token.Id] = &saved
+	return nil
+}
+
+func (r *fakeRefreshTokenRepo) Get(id string) (*repo.RefreshToken, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	token, ok := r.tokens[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	found := *token
+	return &found, nil
+}
+
+func (r *fakeRefreshTokenRepo) Rotate(id string, next *repo.RefreshToken) (bool, error) {
+	if r.beforeRotate != nil {
+		r.beforeRotate()
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	if r.now != nil {
+		now = r.now()
+	}
+	token, ok := r.tokens[id]
+	if !ok || token.UsedAt != nil || token.RevokedAt != nil || !token.ExpiresAt.After(now) {
+		return false, nil
+	}
+	token.UsedAt = &now
+	saved := *next
+	r.tokens[next.Id] = &saved
+	return true, nil
+}
+
+func (r *fakeRefreshTokenRepo) RevokeFamily(familyId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	for _, token := range r.tokens {
+		if token.FamilyId == familyId && token.RevokedAt == nil {
+			token.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+func (r *fakeRefreshTokenRepo) RevokeUser(userId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.revoked = append(r.revoked, userId)
+	return nil
+}
+
+// fakeTwoFactorRepo keeps the TOTP secrets, recovery code hashes and login
+// challenges in maps
+type fakeTwoFactorRepo struct {
+	mu         sync.Mutex
+	totps      map[string]*repo.Totp
+	recovery   map[string]map[string]bool
+	challenges map[string]*repo.LoginChallenge
+}
+
+func newFakeTwoFactorRepo() *fakeTwoFactorRepo {
+	return &fakeTwoFactorRepo{
+		totps:      map[string]*repo.Totp{},
+		recovery:   map[string]map[string]bool{},
+		challenges: map[string]*repo.LoginChallenge{},
+	}
+}
+
+func (r *fakeTwoFactorRepo) SaveTotp(userId, secret string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.totps[userId] = &repo.Totp{UserId: userId, Secret: secret}
+	return nil
+}
+
+func (r *fakeTwoFactorRepo) GetTotp(userId string) (*repo.Totp, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, ok := r.totps[userId]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	res := *t
+	return &res, nil
+}
+
+func (r *fakeTwoFactorRepo) EnableTotp(userId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	r.totps[userId].EnabledAt = &now
+	return nil
+}
+
+func (r *fakeTwoFactorRepo) UseTotpStep(userId string, step int64) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t := r.totps[userId]
+	if step <= t.LastStep {
+		return false, nil
+	}
+	t.LastStep = step
+	return true, nil
+}
+
+func (r *fakeTwoFactorRepo) DeleteTotp(userId string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.totps, userId)
+	delete(r.recovery, userId)
+	return nil
+}
+
+func (r *fakeTwoFactorRepo) ReplaceRecoveryCodes(userId string, codeHashes []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recovery[userId] = map[string]bool{}
+	for _, h := range codeHashes {
+		r.recovery[userId][h] = true
+	}
+	return nil
+}
+
+func (r *fakeTwoFactorRepo) UseRecoveryCode(userId, codeHash string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.recovery[userId][codeHash] {
+		return false, nil
+	}
+	delete(r.recovery[userId], codeHash)
+	return true, nil
+}
+
+func (r *fakeTwoFactorRepo) CreateChallenge(challenge *repo.LoginChallenge) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	saved := *challenge
+	r.challenges[challenge.IdHash] = &saved
+	return nil
+}
+
+func (r *fakeTwoFactorRepo) GetChallenge(idHash string) (*repo.LoginChallenge, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.challenges[idHash]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	res := *c
+	return &res, nil
+}
+
+func (r *fakeTwoFactorRepo) IncrChallengeAttempts(idHash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if c, ok := r.challenges[idHash]; ok {
+		c.Attempts++
+	}
+	return nil
+}
+
+func (r *fakeTwoFactorRepo) DeleteChallenge(idHash string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.challenges, idHash)
+	return nil
+}
+
+// fakeMailer records the mails instead of sending them
+type fakeMailer struct {
+	mu   sync.Mutex
+	sent []fakeMail
+	err  error
+}
+
+type fakeMail struct {
+	to, subject, body string
+}
+
+// waitSent waits for n mails, some are sent in the background
+func (m *fakeMailer) waitSent(t *testing.T, n int) []fakeMail {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for {
+		m.mu.Lock()
+		sent := append([]fakeMail(nil), m.sent...)
+		m.mu.Unlock()
+		if len(sent) >= n {
+			return sent
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d mails sent, want %d", len(sent), n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func (m *fakeMailer) Send(to, subject, body string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, fakeMail{to: to, subject: subject, body: body})
+	return nil
+}
+
+// testLogger is shared, creating a logger sets package state of pkg/logger
+// that mails sent in the background of earlier tests still read
+var testLogger = l.New("error", "test")
+
+// newTestService returns a UserService over st with cheap hashing
+func newTestService(st *fakeStorage, mail *fakeMailer, cfg config.Config) *UserService {
+	return &UserService{
+		storage: st,
+		logger:  testLogger,
+		client:  &fakeClients{post: &fakePostClient{}, comment: &fakeCommentClient{}},
+		cfg:     cfg,
+		mailer:  mail,
+		hasher: hash.NewArgon2id(hash.Params{
+			Memory:      64,
+			Iterations:  1,
+			Parallelism: 1,
+			SaltLength:  16,
+			KeyLength:   32,
+		}),
+		suggestions: newSuggestionCache(0),
+	}
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	l "user-service/pkg/logger"
+	pbp "user-service/protos/post-service"
+	pbu "user-service/protos/user-service"
+)
+
+// feedRebuildTimeout bounds the rebuild of one user's feed in post-service
+const feedRebuildTimeout = 30 * time.Second
+
+// GetFollowerIds is asked by post-service to fan a new post out to the
+// followers of its author
+func (s *UserService) GetFollowerIds(ctx context.Context, req *pbu.FollowIdsReq) (*pbu.FollowIds, error) {
+	ids, err := s.storage.Follow().FollowerIds(req.UserId, req.Limit)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return &pbu.FollowIds{Ids: nonNil(ids)}, nil
+}
+
+// GetFolloweeIds is asked by post-service to read and rebuild feeds
+func (s *UserService) GetFolloweeIds(ctx context.Context, req *pbu.FollowIdsReq) (*pbu.FollowIds, error) {
+	ids, err := s.storage.Follow().FolloweeIds(req.UserId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return &pbu.FollowIds{Ids: nonNil(ids)}, nil
+}
+
+// rebuildFeeds has post-service rebuild the feeds of users whose follows
+// changed. Feeds are derived from the follows, so it runs in the background
+// and failures are only logged.
+func (s *UserService) rebuildFeeds(userIds ...string) {
+	go func() {
+		for _, id := range userIds {
+			ctx, cancel := context.WithTimeout(context.Background(), feedRebuildTimeout)
+			_, err := s.client.PostService().RebuildFeed(ctx, &pbp.RebuildFeedReq{UserId: id})
+			cancel()
+			if err != nil {
+				s.logger.Error("failed to rebuild feed", l.String("user_id", id), l.Error(err))
+			}
+		}
+	}()
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"time"
+
+	pbu "user-service/protos/user-service"
+	"user-service/storage/repo"
+
+	"shared/paging"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// userFilter validates the paging and the created_at range of a list
+// request. Field and sortby are checked against the whitelist in storage.
+func userFilter(req *pbu.GetAllUsersReq) (*repo.UserFilter, error) {
+	from, err := parseRangeTime(req.StartedAt, false)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "started_at must be an RFC3339 time or an ISO date")
+	}
+	to, err := parseRangeTime(req.EndedAt, true)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "ended_at must be an RFC3339 time or an ISO date")
+	}
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return nil, status.Error(codes.InvalidArgument, "started_at must be before ended_at")
+	}
+
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	limit := paging.Size(req.Limit)
+	return &repo.UserFilter{
+		Field:  req.Field,
+		Value:  req.Value,
+		SortBy: req.Sortby,
+		From:   from,
+		To:     to,
+		Limit:  limit,
+		Offset: (page - 1) * limit,
+	}, nil
+}
+
+// parseRangeTime accepts RFC3339 times and ISO dates. The range is half
+// open, so a date given as its end covers that whole day.
+func parseRangeTime(value string, end bool) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), nil
+	}
+	t, err := time.Parse(dateLayout, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if end {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t, nil
+}
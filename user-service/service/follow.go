@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	pbu "user-service/protos/user-service"
+	"user-service/storage/repo"
+
+	"shared/auth"
+	"shared/paging"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *UserService) Follow(ctx context.Context, req *pbu.FollowReq) (*pbu.Status, error) {
+	if err := auth.CanModify(ctx, req.FollowerId); err != nil {
+		return nil, err
+	}
+	if req.FollowerId == req.FolloweeId {
+		return nil, status.Error(codes.InvalidArgument, "users can not follow themselves")
+	}
+	followee, err := s.storage.User().Get(&pbu.GetUserReq{Field: "id", Value: req.FolloweeId})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if err := s.checkNotBlocked(req.FollowerId, req.FolloweeId); err != nil {
+		return nil, err
+	}
+
+	if followee.IsPrivate {
+		following, err := s.storage.Follow().IsFollowing(req.FollowerId, req.FolloweeId)
+		if err != nil {
+			s.logger.Error(err.Error())
+			return nil, err
+		}
+		if following {
+			return &pbu.Status{Message: "already following"}, nil
+		}
+		if _, err := s.storage.Follow().RequestFollow(req.FollowerId, req.FolloweeId); err != nil {
+			s.logger.Error(err.Error())
+			return nil, err
+		}
+		s.suggestions.forget(req.FollowerId)
+		return &pbu.Status{Message: "follow requested"}, nil
+	}
+
+	created, err := s.storage.Follow().Follow(req.FollowerId, req.FolloweeId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	s.suggestions.forget(req.FollowerId)
+	if !created {
+		return &pbu.Status{Message: "already following"}, nil
+	}
+	s.rebuildFeeds(req.FollowerId)
+	return &pbu.Status{Message: "followed"}, nil
+}
+
+func (s *UserService) Unfollow(ctx context.Context, req *pbu.FollowReq) (*pbu.Status, error) {
+	if err := auth.CanModify(ctx, req.FollowerId); err != nil {
+		return nil, err
+	}
+
+	removed, err := s.storage.Follow().Unfollow(req.FollowerId, req.FolloweeId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if removed {
+		s.rebuildFeeds(req.FollowerId)
+		return &pbu.Status{Message: "unfollowed"}, nil
+	}
+
+	// unfollowing a private account that has not answered yet withdraws the request
+	cancelled, err := s.storage.Follow().DeleteRequest(req.FollowerId, req.FolloweeId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if !cancelled {
+		return nil, status.Error(codes.NotFound, "not following")
+	}
+	return &pbu.Status{Message: "follow request cancelled"}, nil
+}
+
+func (s *UserService) IsFollowing(ctx context.Context, req *pbu.FollowReq) (*pbu.IsFollowingRes, error) {
+	following, err := s.storage.Follow().IsFollowing(req.FollowerId, req.FolloweeId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return &pbu.IsFollowingRes{Following: following}, nil
+}
+
+func (s *UserService) ListFollowers(ctx context.Context, req *pbu.FollowListReq) (*pbu.FollowListRes, error) {
+	return s.listFollows(req, s.storage.Follow().ListFollowers)
+}
+
+func (s *UserService) ListFollowing(ctx context.Context, req *pbu.FollowListReq) (*pbu.FollowListRes, error) {
+	return s.listFollows(req, s.storage.Follow().ListFollowing)
+}
+
+func (s *UserService) listFollows(req *pbu.FollowListReq, list func(string, *repo.Cursor, int64) ([]*repo.FollowEdge, error)) (*pbu.FollowListRes, error) {
+	after, err := paging.DecodeCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkUserExists(req.UserId); err != nil {
+		return nil, err
+	}
+
+	limit := paging.Size(req.Limit)
+	edges, err := list(req.UserId, after, limit)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	res := &pbu.FollowListRes{Users: []*pbu.User{}}
+	for _, edge := range edges {
+		res.Users = append(res.Users, edge.User)
+	}
+	// a full page means there may be more
+	if int64(len(edges)) == limit {
+		last := edges[len(edges)-1]
+		res.NextCursor = paging.EncodeCursor(repo.Cursor{CreatedAt: last.FollowedAt, Id: last.User.Id})
+	}
+	return res, nil
+}
+
+func (s *UserService) checkUserExists(userId string) error {
+	_, err := s.storage.User().Get(&pbu.GetUserReq{Field: "id", Value: userId})
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+	return nil
+}
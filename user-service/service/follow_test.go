@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"user-service/config"
+	pbu "user-service/protos/user-service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// followFixture is user-1 and users a to e, who all follow user-1. b and c
+// followed at the same time, so their order comes from the id
+func followFixture() (*UserService, *fakeFollowRepo) {
+	users := newFakeUserRepo()
+	users.users["user-1"] = &pbu.User{Id: "user-1", UserName: "ann"}
+	follows := newFakeFollowRepo(users)
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	for i, id := range []string{"a", "b", "c", "d", "e"} {
+		users.users[id] = &pbu.User{Id: id, UserName: "user-" + id}
+		at := start.Add(time.Duration(i) * time.Minute)
+		if id == "c" {
+			at = start.Add(time.Minute)
+		}
+		follows.add(id, "user-1", at)
+	}
+	s := newTestService(&fakeStorage{user: users, follow: follows}, &fakeMailer{}, config.Config{})
+	return s, follows
+}
+
+func TestListFollowersPages(t *testing.T) {
+	s, _ := followFixture()
+
+	var (
+		pages  [][]string
+		cursor string
+	)
+	for i := 0; i < 5; i++ {
+		res, err := s.ListFollowers(context.Background(), &pbu.FollowListReq{UserId: "user-1", Limit: 2, Cursor: cursor})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, u := range res.Users {
+			ids = append(ids, u.Id)
+		}
+		pages = append(pages, ids)
+		if res.NextCursor == "" {
+			break
+		}
+		cursor = res.NextCursor
+	}
+
+	want := [][]string{{"e", "d"}, {"c", "b"}, {"a"}}
+	if !reflect.DeepEqual(pages, want) {
+		t.Errorf("pages = %v, want %v", pages, want)
+	}
+}
+
+func TestListFollowersFullLastPage(t *testing.T) {
+	s, _ := followFixture()
+
+	res, err := s.ListFollowers(context.Background(), &pbu.FollowListReq{UserId: "user-1", Limit: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Users) != 5 || res.NextCursor == "" {
+		t.Fatalf("got %d users and cursor %q, want 5 and a cursor", len(res.Users), res.NextCursor)
+	}
+	// a full page can not tell whether more follow, the next one is empty
+	res, err = s.ListFollowers(context.Background(), &pbu.FollowListReq{UserId: "user-1", Limit: 5, Cursor: res.NextCursor})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Users) != 0 || res.NextCursor != "" {
+		t.Errorf("got %d users and cursor %q after the last page", len(res.Users), res.NextCursor)
+	}
+}
+
+func TestListFollowing(t *testing.T) {
+	s, follows := followFixture()
+	follows.add("user-1", "a", time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	res, err := s.ListFollowing(context.Background(), &pbu.FollowListReq{UserId: "user-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Users) != 1 || res.Users[0].Id != "a" || res.NextCursor != "" {
+		t.Errorf("ListFollowing = %v", res)
+	}
+}
+
+func TestListFollowersErrors(t *testing.T) {
+	s, _ := followFixture()
+	tests := []struct {
+		req  *pbu.FollowListReq
+		want codes.Code
+	}{
+		{&pbu.FollowListReq{UserId: "unknown"}, codes.NotFound},
+		{&pbu.FollowListReq{UserId: "user-1", Cursor: "garbage!"}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.want), func(t *testing.T) {
+			if _, err := s.ListFollowers(context.Background(), tt.req); status.Code(err) != tt.want {
+				t.Errorf("ListFollowers(%v) error = %v, want %v", tt.req, err, tt.want)
+			}
+		})
+	}
+}
@@ -6,6 +6,8 @@ import (
 	pbc "user-service/protos/comment-service"
 	pbp "user-service/protos/post-service"
 
+	"shared/auth"
+
 	"google.golang.org/grpc"
 )
 
@@ -23,16 +25,20 @@ type serviceManager struct {
 func New(cfg config.Config) (IServiceManager, error) {
 	// dail to post-service
 	connPost, err := grpc.Dial(
-		fmt.Sprintf("%s:%d", cfg.PostgresUser, cfg.PostServicePort),
+		fmt.Sprintf("%s:%d", cfg.PostServiceHost, cfg.PostServicePort),
 		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(auth.ClientInterceptor(cfg.InternalSecret)),
+		grpc.WithStreamInterceptor(auth.StreamClientInterceptor(cfg.InternalSecret)),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("user service dail host: %s port : %d", cfg.PostServiceHost, cfg.PostServicePort)
+		return nil, fmt.Errorf("post service dail host: %s port : %d", cfg.PostServiceHost, cfg.PostServicePort)
 	}
 	// dail to comment-service
 	connComment, err := grpc.Dial(
 		fmt.Sprintf("%s:%d", cfg.CommentServiceHost, cfg.CommentServicePort),
 		grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(auth.ClientInterceptor(cfg.InternalSecret)),
+		grpc.WithStreamInterceptor(auth.StreamClientInterceptor(cfg.InternalSecret)),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("comment service dail host: %s port : %d", cfg.CommentServiceHost, cfg.CommentServicePort)
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"time"
+
+	pbu "user-service/protos/user-service"
+
+	"shared/auth"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func userAttemptKey(userId string) string {
+	return "user:" + userId
+}
+
+func ipAttemptKey(ip string) string {
+	return "ip:" + ip
+}
+
+// checkLocked fails with ResourceExhausted while the key is locked, the
+// error carries a RetryInfo with the time left
+func (s *UserService) checkLocked(key string) error {
+	attempt, err := s.storage.LoginAttempt().Get(key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+	if attempt.LockedUntil == nil || !time.Now().Before(*attempt.LockedUntil) {
+		return nil
+	}
+
+	st, err := status.New(codes.ResourceExhausted, "too many failed login attempts, try again later").
+		WithDetails(&errdetails.RetryInfo{
+			RetryDelay: durationpb.New(time.Until(*attempt.LockedUntil).Round(time.Second)),
+		})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return status.Error(codes.ResourceExhausted, "too many failed login attempts, try again later")
+	}
+	return st.Err()
+}
+
+// recordFailure counts a failed login for the key. Past the free attempts
+// every failure doubles the wait before the next try, and reaching
+// maxFailures locks the key for the whole lockout.
+func (s *UserService) recordFailure(key string, maxFailures int) {
+	lockout := time.Minute * time.Duration(s.cfg.LoginLockout)
+
+	failures, err := s.storage.LoginAttempt().RecordFailure(key, lockout)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return
+	}
+
+	var delay time.Duration
+	switch {
+	case failures >= maxFailures:
+		delay = lockout
+	case failures > s.cfg.LoginFreeAttempts:
+		seconds := math.Pow(2, float64(failures-s.cfg.LoginFreeAttempts-1))
+		delay = time.Second * time.Duration(math.Min(seconds, float64(s.cfg.LoginMaxDelay)))
+	default:
+		return
+	}
+
+	if err := s.storage.LoginAttempt().Lock(key, time.Now().Add(delay)); err != nil {
+		s.logger.Error(err.Error())
+	}
+}
+
+// UnlockUser lifts a lockout of the account, admin only
+func (s *UserService) UnlockUser(ctx context.Context, req *pbu.UnlockUserReq) (*pbu.Status, error) {
+	if !auth.IsAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "only an admin can unlock users")
+	}
+
+	_, err := s.storage.User().Get(&pbu.GetUserReq{Field: "id", Value: req.UserId})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	if err := s.storage.LoginAttempt().Delete(userAttemptKey(req.UserId)); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return &pbu.Status{Message: "user unlocked"}, nil
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"user-service/config"
+	pbu "user-service/protos/user-service"
+
+	"shared/auth"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var lockoutConfig = config.Config{
+	LoginFreeAttempts:     3,
+	LoginMaxFailures:      8,
+	LoginMaxFailuresPerIP: 100,
+	LoginLockout:          15,
+	LoginMaxDelay:         5,
+}
+
+func TestRecordFailureBackoff(t *testing.T) {
+	attempts := newFakeLoginAttemptRepo()
+	s := newTestService(&fakeStorage{loginAttempt: attempts}, &fakeMailer{}, lockoutConfig)
+
+	// the delay after each failure, zero for none
+	want := []time.Duration{
+		0, 0, 0,
+		time.Second, 2 * time.Second, 4 * time.Second,
+		// capped at LoginMaxDelay
+		5 * time.Second,
+		// LoginMaxFailures locks for the whole lockout
+		15 * time.Minute,
+	}
+	for i, delay := range want {
+		s.recordFailure("user:user-1", s.cfg.LoginMaxFailures)
+
+		a, err := attempts.Get("user:user-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.Failures != i+1 {
+			t.Fatalf("failure %d: counted %d", i+1, a.Failures)
+		}
+		if delay == 0 {
+			if a.LockedUntil != nil {
+				t.Errorf("failure %d: locked for %v, want no delay", i+1, time.Until(*a.LockedUntil))
+			}
+			continue
+		}
+		if a.LockedUntil == nil {
+			t.Fatalf("failure %d: not locked, want %v", i+1, delay)
+		}
+		if got := time.Until(*a.LockedUntil); got > delay || got < delay-time.Second {
+			t.Errorf("failure %d: locked for %v, want %v", i+1, got, delay)
+		}
+	}
+}
+
+func TestCheckLocked(t *testing.T) {
+	attempts := newFakeLoginAttemptRepo()
+	s := newTestService(&fakeStorage{loginAttempt: attempts}, &fakeMailer{}, lockoutConfig)
+
+	if err := s.checkLocked("user:unknown"); err != nil {
+		t.Errorf("checkLocked(no failures) = %v, want nil", err)
+	}
+
+	s.recordFailure("user:user-1", s.cfg.LoginMaxFailures)
+	if err := s.checkLocked("user:user-1"); err != nil {
+		t.Errorf("checkLocked(free attempt) = %v, want nil", err)
+	}
+
+	past := time.Now().Add(-time.Second)
+	attempts.attempts["user:user-1"].LockedUntil = &past
+	if err := s.checkLocked("user:user-1"); err != nil {
+		t.Errorf("checkLocked(expired lock) = %v, want nil", err)
+	}
+
+	until := time.Now().Add(time.Minute)
+	attempts.attempts["user:user-1"].LockedUntil = &until
+	err := s.checkLocked("user:user-1")
+	st := status.Convert(err)
+	if st.Code() != codes.ResourceExhausted {
+		t.Fatalf("checkLocked(locked) = %v, want ResourceExhausted", err)
+	}
+	var retry *errdetails.RetryInfo
+	for _, d := range st.Details() {
+		if r, ok := d.(*errdetails.RetryInfo); ok {
+			retry = r
+		}
+	}
+	if retry == nil || retry.RetryDelay.AsDuration() != time.Minute {
+		t.Errorf("retry info = %v, want a minute", retry)
+	}
+}
+
+func TestLoginLockoutAndUnlock(t *testing.T) {
+	users := newFakeUserRepo()
+	attempts := newFakeLoginAttemptRepo()
+	s := newTestService(&fakeStorage{
+		user:         users,
+		twoFactor:    newFakeTwoFactorRepo(),
+		loginAttempt: attempts,
+	}, &fakeMailer{}, lockoutConfig)
+	s.cfg.LoginFreeAttempts, s.cfg.LoginMaxFailures = 0, 2
+	hashed, err := s.hasher.Hash(testPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	users.users["user-1"] = &pbu.User{Id: "user-1", Email: "ann@example.com", UserName: "ann", Password: hashed}
+
+	login := func(password, ip string) error {
+		_, err := s.Login(context.Background(), &pbu.LoginUserReq{Email: "ann@example.com", Password: password, ClientIp: ip})
+		return err
+	}
+
+	if err := login("wrong", "10.0.0.1"); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("first wrong password = %v, want Unauthenticated", err)
+	}
+	// the first failure already has to wait a second, both for the address
+	// and for the account from anywhere else
+	if err := login(testPassword, "10.0.0.1"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("login from the same address = %v, want ResourceExhausted", err)
+	}
+	if err := login(testPassword, "10.0.0.2"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("login from another address = %v, want ResourceExhausted", err)
+	}
+
+	if _, err := s.UnlockUser(asUser("user-2"), &pbu.UnlockUserReq{UserId: "user-1"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("UnlockUser by a user = %v, want PermissionDenied", err)
+	}
+	admin := metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.UserIdKey, "admin-1", auth.RoleKey, "admin"))
+	if _, err := s.UnlockUser(admin, &pbu.UnlockUserReq{UserId: "unknown"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("UnlockUser(unknown) = %v, want NotFound", err)
+	}
+	if _, err := s.UnlockUser(admin, &pbu.UnlockUserReq{UserId: "user-1"}); err != nil {
+		t.Fatalf("UnlockUser: %v", err)
+	}
+
+	// unlocking lifts the account lock but not the one on the address
+	if err := login(testPassword, "10.0.0.1"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("login from the same address after unlock = %v, want ResourceExhausted", err)
+	}
+	if err := login(testPassword, "10.0.0.2"); err != nil {
+		t.Fatalf("login from another address after unlock = %v", err)
+	}
+	if _, err := attempts.Get(userAttemptKey("user-1")); err == nil {
+		t.Error("failures of user-1 kept after a successful login")
+	}
+}
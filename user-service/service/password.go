@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"user-service/pkg/etc"
+	l "user-service/pkg/logger"
+	pbu "user-service/protos/user-service"
+	"user-service/storage/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// forgotPasswordMessage is returned whether or not the email is known, so the
+// endpoint cannot be used to find out who has an account
+const forgotPasswordMessage = "if the email is registered, a reset code has been sent"
+
+// ForgotPassword answers the same for unknown emails, throttled requests and
+// failed mails. The mail is sent in the background so the time the answer
+// takes does not tell either.
+func (s *UserService) ForgotPassword(ctx context.Context, req *pbu.ForgotPasswordReq) (*pbu.Status, error) {
+	user, err := s.storage.User().Get(&pbu.GetUserReq{Field: "email", Value: req.Email})
+	if errors.Is(err, sql.ErrNoRows) {
+		return &pbu.Status{Message: forgotPasswordMessage}, nil
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	code, err := etc.GenerateCode(6)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, "failed to generate code")
+	}
+	saved, err := s.storage.PasswordReset().Save(&repo.PasswordReset{
+		UserId:    user.Id,
+		CodeHash:  etc.HashCode(code),
+		ExpiresAt: time.Now().Add(time.Minute * time.Duration(s.cfg.PasswordResetCodeTTL)),
+	}, maxCodeAttempts, time.Duration(s.cfg.PasswordResetResendInterval)*time.Second)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if !saved {
+		return &pbu.Status{Message: forgotPasswordMessage}, nil
+	}
+
+	body := fmt.Sprintf("Your password reset code is %s. It expires in %d minutes.\n"+
+		"If you did not ask to reset your password, ignore this email.", code, s.cfg.PasswordResetCodeTTL)
+	s.sendMail(user.Email, "Password reset", body)
+
+	return &pbu.Status{Message: forgotPasswordMessage}, nil
+}
+
+func (s *UserService) ResetPassword(ctx context.Context, req *pbu.ResetPasswordReq) (*pbu.Status, error) {
+	if req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "new_password is required")
+	}
+	invalid := status.Error(codes.InvalidArgument, "invalid or expired reset code")
+
+	user, err := s.storage.User().Get(&pbu.GetUserReq{Field: "email", Value: req.Email})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, invalid
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	consumed, err := s.storage.PasswordReset().Consume(user.Id, etc.HashCode(req.Code), maxCodeAttempts)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if !consumed {
+		return nil, invalid
+	}
+
+	hashed, err := s.hasher.Hash(req.NewPassword)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, "failed to hash password")
+	}
+	if _, err := s.storage.User().UpdatePassword(user.Id, hashed); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	// sessions opened with the old password must not outlive it
+	if err := s.storage.RefreshToken().RevokeUser(user.Id); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &pbu.Status{Message: "password has been reset"}, nil
+}
+
+// sendMail sends in the background, failures are only logged
+func (s *UserService) sendMail(to, subject, body string) {
+	go func() {
+		if err := s.mailer.Send(to, subject, body); err != nil {
+			s.logger.Error("failed to send mail", l.String("subject", subject), l.Error(err))
+		}
+	}()
+}
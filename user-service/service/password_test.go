@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"user-service/config"
+	pbu "user-service/protos/user-service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type resetFixture struct {
+	s      *UserService
+	resets *fakePasswordResetRepo
+	tokens *fakeRefreshTokenRepo
+	mail   *fakeMailer
+}
+
+func newResetFixture() *resetFixture {
+	users := newFakeUserRepo()
+	users.users["user-1"] = &pbu.User{Id: "user-1", Email: "ann@example.com", UserName: "ann"}
+	f := &resetFixture{
+		resets: newFakePasswordResetRepo(),
+		tokens: &fakeRefreshTokenRepo{},
+		mail:   &fakeMailer{},
+	}
+	f.s = newTestService(&fakeStorage{
+		user:          users,
+		passwordReset: f.resets,
+		refreshToken:  f.tokens,
+	}, f.mail, config.Config{PasswordResetCodeTTL: 15, PasswordResetResendInterval: 60})
+	return f
+}
+
+// forgot asks for a code and returns the one mailed, n is the mails expected
+// so far
+func (f *resetFixture) forgot(t *testing.T, n int) string {
+	t.Helper()
+	res, err := f.s.ForgotPassword(context.Background(), &pbu.ForgotPasswordReq{Email: "ann@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Message != forgotPasswordMessage {
+		t.Fatalf("message = %q", res.Message)
+	}
+	return mailedCode.FindString(f.mail.waitSent(t, n)[n-1].body)
+}
+
+func (f *resetFixture) reset(code string) error {
+	_, err := f.s.ResetPassword(context.Background(), &pbu.ResetPasswordReq{
+		Email:       "ann@example.com",
+		Code:        code,
+		NewPassword: "new password",
+	})
+	return err
+}
+
+func TestForgotPasswordAnswersAlike(t *testing.T) {
+	f := newResetFixture()
+	f.mail.err = errors.New("smtp down")
+
+	for _, email := range []string{"ann@example.com", "nobody@example.com"} {
+		res, err := f.s.ForgotPassword(context.Background(), &pbu.ForgotPasswordReq{Email: email})
+		if err != nil {
+			t.Fatalf("%s: %v", email, err)
+		}
+		if res.Message != forgotPasswordMessage {
+			t.Fatalf("%s: message = %q", email, res.Message)
+		}
+	}
+}
+
+func TestForgotPasswordThrottlesReissue(t *testing.T) {
+	f := newResetFixture()
+	first := f.forgot(t, 1)
+
+	// too soon, the answer is the same but no new code is sent
+	if _, err := f.s.ForgotPassword(context.Background(), &pbu.ForgotPasswordReq{Email: "ann@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if n := len(f.mail.waitSent(t, 1)); n != 1 {
+		t.Fatalf("%d mails sent, want 1", n)
+	}
+
+	f.resets.advance(time.Minute)
+	second := f.forgot(t, 2)
+	if err := f.reset(first); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("replaced code: got %v, want InvalidArgument", err)
+	}
+	if err := f.reset(second); err != nil {
+		t.Fatalf("current code: %v", err)
+	}
+}
+
+func TestResetPasswordSpendsCodeOnce(t *testing.T) {
+	f := newResetFixture()
+	code := f.forgot(t, 1)
+
+	if err := f.reset(code); err != nil {
+		t.Fatal(err)
+	}
+	if len(f.tokens.revoked) != 1 {
+		t.Fatal("sessions were not revoked")
+	}
+	if err := f.reset(code); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("second use: got %v, want InvalidArgument", err)
+	}
+}
+
+func TestResetPasswordAttemptsSurviveReissue(t *testing.T) {
+	f := newResetFixture()
+	f.forgot(t, 1)
+	for i := 0; i < maxCodeAttempts-1; i++ {
+		if err := f.reset("wrong"); status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("attempt %d: %v", i, err)
+		}
+	}
+
+	// a new code does not give new attempts
+	f.resets.advance(time.Minute)
+	code := f.forgot(t, 2)
+	if err := f.reset("wrong"); status.Code(err) != codes.InvalidArgument {
+		t.Fatal(err)
+	}
+	if err := f.reset(code); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("right code after the last attempt: got %v, want InvalidArgument", err)
+	}
+
+	// nor does asking again while the spent code is live
+	f.resets.advance(time.Minute)
+	if _, err := f.s.ForgotPassword(context.Background(), &pbu.ForgotPasswordReq{Email: "ann@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	if n := len(f.mail.waitSent(t, 2)); n != 2 {
+		t.Fatalf("%d mails sent, want 2", n)
+	}
+
+	// once it expires the user can start over
+	f.resets.advance(15 * time.Minute)
+	code = f.forgot(t, 3)
+	if err := f.reset(code); err != nil {
+		t.Fatal(err)
+	}
+}
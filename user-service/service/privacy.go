@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	pbu "user-service/protos/user-service"
+
+	"shared/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *UserService) SetPrivacy(ctx context.Context, req *pbu.SetPrivacyReq) (*pbu.User, error) {
+	if err := auth.CanModify(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	// going public approves the pending requests, their feeds are rebuilt
+	var requests []exportedRelation
+	if !req.IsPrivate {
+		var err error
+		requests, err = exportRelations(req.UserId, s.storage.Follow().ListRequests)
+		if err != nil {
+			s.logger.Error(err.Error())
+			return nil, err
+		}
+	}
+
+	user, err := s.storage.User().SetPrivate(req.UserId, req.IsPrivate)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if len(requests) > 0 {
+		ids := make([]string, 0, len(requests))
+		for _, r := range requests {
+			ids = append(ids, r.Id)
+		}
+		s.rebuildFeeds(ids...)
+	}
+
+	user.Password = ""
+	return user, nil
+}
+
+// ApproveFollowRequest is called by the followee
+func (s *UserService) ApproveFollowRequest(ctx context.Context, req *pbu.FollowReq) (*pbu.Status, error) {
+	if err := auth.CanModify(ctx, req.FolloweeId); err != nil {
+		return nil, err
+	}
+
+	approved, err := s.storage.Follow().ApproveRequest(req.FollowerId, req.FolloweeId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if !approved {
+		return nil, status.Error(codes.NotFound, "follow request not found")
+	}
+	s.rebuildFeeds(req.FollowerId)
+	return &pbu.Status{Message: "follow request approved"}, nil
+}
+
+// RejectFollowRequest is called by the followee
+func (s *UserService) RejectFollowRequest(ctx context.Context, req *pbu.FollowReq) (*pbu.Status, error) {
+	if err := auth.CanModify(ctx, req.FolloweeId); err != nil {
+		return nil, err
+	}
+
+	rejected, err := s.storage.Follow().DeleteRequest(req.FollowerId, req.FolloweeId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if !rejected {
+		return nil, status.Error(codes.NotFound, "follow request not found")
+	}
+	return &pbu.Status{Message: "follow request rejected"}, nil
+}
+
+// ListFollowRequests lists the pending requests to follow user_id, only the
+// user itself can see them
+func (s *UserService) ListFollowRequests(ctx context.Context, req *pbu.FollowListReq) (*pbu.FollowListRes, error) {
+	if err := auth.CanModify(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	return s.listFollows(req, s.storage.Follow().ListRequests)
+}
+
+// FilterVisibleOwners is asked by post-service before it shows posts, owners
+// missing from the answer are private accounts the viewer does not follow.
+// Only the viewer's own call or an admin's may ask for a viewer, without one
+// just the public owners are visible.
+func (s *UserService) FilterVisibleOwners(ctx context.Context, req *pbu.VisibleOwnersReq) (*pbu.VisibleOwnersRes, error) {
+	if req.ViewerId != "" {
+		if err := auth.CanModify(ctx, req.ViewerId); err != nil {
+			return nil, err
+		}
+	}
+	res := &pbu.VisibleOwnersRes{OwnerIds: []string{}}
+	if len(req.OwnerIds) == 0 {
+		return res, nil
+	}
+
+	visible, err := s.storage.Follow().VisibleOwners(req.ViewerId, req.OwnerIds)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	res.OwnerIds = append(res.OwnerIds, visible...)
+	return res, nil
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"user-service/config"
+	pbu "user-service/protos/user-service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// privacyFixture is the public user ann and the private users bob and cid
+func privacyFixture() (*UserService, *fakeFollowRepo, *fakePostClient) {
+	users := newFakeUserRepo()
+	users.users["ann"] = &pbu.User{Id: "ann", UserName: "ann"}
+	users.users["bob"] = &pbu.User{Id: "bob", UserName: "bob", IsPrivate: true}
+	users.users["cid"] = &pbu.User{Id: "cid", UserName: "cid", IsPrivate: true}
+	follows := newFakeFollowRepo(users)
+	s := newTestService(&fakeStorage{
+		user:   users,
+		follow: follows,
+		block:  &fakeBlockRepo{},
+	}, &fakeMailer{}, config.Config{})
+	return s, follows, s.client.(*fakeClients).post
+}
+
+func TestFollowPrivateAccountAsksFirst(t *testing.T) {
+	s, follows, posts := privacyFixture()
+	ctx := asUser("ann")
+
+	res, err := s.Follow(asUser("ann"), &pbu.FollowReq{FollowerId: "ann", FolloweeId: "bob"})
+	if err != nil || res.Message != "follow requested" {
+		t.Fatalf("Follow = %v, %v, want follow requested", res, err)
+	}
+	if following, _ := follows.IsFollowing("ann", "bob"); following {
+		t.Fatal("following before the request was approved")
+	}
+	visible, err := s.FilterVisibleOwners(ctx, &pbu.VisibleOwnersReq{ViewerId: "ann", OwnerIds: []string{"ann", "bob"}})
+	if err != nil || !reflect.DeepEqual(visible.OwnerIds, []string{"ann"}) {
+		t.Fatalf("FilterVisibleOwners = %v, %v, want only ann", visible, err)
+	}
+
+	// a second request is not an error
+	if _, err := s.Follow(asUser("ann"), &pbu.FollowReq{FollowerId: "ann", FolloweeId: "bob"}); err != nil {
+		t.Fatalf("Follow again: %v", err)
+	}
+	if _, err := s.ListFollowRequests(asUser("ann"), &pbu.FollowListReq{UserId: "bob"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("ListFollowRequests by another user = %v, want PermissionDenied", err)
+	}
+	requests, err := s.ListFollowRequests(asUser("bob"), &pbu.FollowListReq{UserId: "bob"})
+	if err != nil || len(requests.Users) != 1 || requests.Users[0].Id != "ann" {
+		t.Fatalf("ListFollowRequests = %v, %v, want ann", requests, err)
+	}
+
+	if _, err := s.ApproveFollowRequest(asUser("ann"), &pbu.FollowReq{FollowerId: "ann", FolloweeId: "bob"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("ApproveFollowRequest by the follower = %v, want PermissionDenied", err)
+	}
+	if _, err := s.ApproveFollowRequest(asUser("bob"), &pbu.FollowReq{FollowerId: "ann", FolloweeId: "bob"}); err != nil {
+		t.Fatalf("ApproveFollowRequest: %v", err)
+	}
+	if following, _ := follows.IsFollowing("ann", "bob"); !following {
+		t.Fatal("not following after the request was approved")
+	}
+	if rebuilt := posts.waitRebuilt(t, 1); !reflect.DeepEqual(rebuilt, []string{"ann"}) {
+		t.Errorf("rebuilt feeds of %v, want ann", rebuilt)
+	}
+	visible, err = s.FilterVisibleOwners(ctx, &pbu.VisibleOwnersReq{ViewerId: "ann", OwnerIds: []string{"bob", "cid"}})
+	if err != nil || !reflect.DeepEqual(visible.OwnerIds, []string{"bob"}) {
+		t.Fatalf("FilterVisibleOwners = %v, %v, want only bob", visible, err)
+	}
+
+	if _, err := s.ApproveFollowRequest(asUser("bob"), &pbu.FollowReq{FollowerId: "ann", FolloweeId: "bob"}); status.Code(err) != codes.NotFound {
+		t.Errorf("approving twice = %v, want NotFound", err)
+	}
+	res, err = s.Follow(asUser("ann"), &pbu.FollowReq{FollowerId: "ann", FolloweeId: "bob"})
+	if err != nil || res.Message != "already following" {
+		t.Errorf("Follow after approval = %v, %v, want already following", res, err)
+	}
+}
+
+func TestRejectAndCancelFollowRequest(t *testing.T) {
+	s, follows, _ := privacyFixture()
+	follows.RequestFollow("ann", "bob")
+	follows.RequestFollow("ann", "cid")
+
+	if _, err := s.RejectFollowRequest(asUser("bob"), &pbu.FollowReq{FollowerId: "ann", FolloweeId: "bob"}); err != nil {
+		t.Fatalf("RejectFollowRequest: %v", err)
+	}
+	if _, err := s.RejectFollowRequest(asUser("bob"), &pbu.FollowReq{FollowerId: "ann", FolloweeId: "bob"}); status.Code(err) != codes.NotFound {
+		t.Errorf("rejecting twice = %v, want NotFound", err)
+	}
+
+	// unfollowing withdraws a pending request
+	res, err := s.Unfollow(asUser("ann"), &pbu.FollowReq{FollowerId: "ann", FolloweeId: "cid"})
+	if err != nil || res.Message != "follow request cancelled" {
+		t.Fatalf("Unfollow = %v, %v, want follow request cancelled", res, err)
+	}
+	if _, err := s.Unfollow(asUser("ann"), &pbu.FollowReq{FollowerId: "ann", FolloweeId: "cid"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Unfollow with nothing pending = %v, want NotFound", err)
+	}
+	if len(follows.requests) != 0 || len(follows.follows) != 0 {
+		t.Errorf("left requests %v and follows %v", follows.requests, follows.follows)
+	}
+}
+
+func TestFollowBlocked(t *testing.T) {
+	s, follows, _ := privacyFixture()
+	s.storage.(*fakeStorage).block = &fakeBlockRepo{blocked: map[[2]string]bool{{"bob", "ann"}: true}}
+
+	if _, err := s.Follow(asUser("ann"), &pbu.FollowReq{FollowerId: "ann", FolloweeId: "bob"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("Follow of a user who blocked the follower = %v, want PermissionDenied", err)
+	}
+	if len(follows.requests) != 0 {
+		t.Errorf("request %v made to a user who blocked the follower", follows.requests)
+	}
+}
+
+func TestSetPrivacy(t *testing.T) {
+	s, follows, posts := privacyFixture()
+	follows.RequestFollow("ann", "bob")
+	follows.RequestFollow("cid", "bob")
+
+	if _, err := s.SetPrivacy(asUser("ann"), &pbu.SetPrivacyReq{UserId: "bob"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("SetPrivacy of another user = %v, want PermissionDenied", err)
+	}
+
+	user, err := s.SetPrivacy(asUser("bob"), &pbu.SetPrivacyReq{UserId: "bob", IsPrivate: false})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.IsPrivate {
+		t.Error("still private")
+	}
+	// the requests are approved by the repo, whose followers get new feeds
+	if rebuilt := posts.waitRebuilt(t, 2); !reflect.DeepEqual(rebuilt, []string{"ann", "cid"}) {
+		t.Errorf("rebuilt feeds of %v, want ann and cid", rebuilt)
+	}
+
+	if _, err := s.SetPrivacy(asUser("ann"), &pbu.SetPrivacyReq{UserId: "ann", IsPrivate: true}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.SetPrivacy(asUser("dan"), &pbu.SetPrivacyReq{UserId: "dan", IsPrivate: true}); status.Code(err) != codes.NotFound {
+		t.Errorf("SetPrivacy of an unknown user = %v, want NotFound", err)
+	}
+}
+
+func TestFilterVisibleOwnersChecksViewer(t *testing.T) {
+	s, _, _ := privacyFixture()
+	req := &pbu.VisibleOwnersReq{ViewerId: "bob", OwnerIds: []string{"ann", "bob"}}
+
+	if _, err := s.FilterVisibleOwners(asUser("ann"), req); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("asking as another viewer = %v, want PermissionDenied", err)
+	}
+	visible, err := s.FilterVisibleOwners(asAdmin(), req)
+	if err != nil || !reflect.DeepEqual(visible.OwnerIds, []string{"ann", "bob"}) {
+		t.Fatalf("asking as an admin = %v, %v, want ann and bob", visible, err)
+	}
+	visible, err = s.FilterVisibleOwners(context.Background(), &pbu.VisibleOwnersReq{OwnerIds: []string{"ann", "bob"}})
+	if err != nil || !reflect.DeepEqual(visible.OwnerIds, []string{"ann"}) {
+		t.Fatalf("asking without a viewer = %v, %v, want only ann", visible, err)
+	}
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"user-service/pkg/phone"
+	pbu "user-service/protos/user-service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	dateLayout      = "2006-01-02"
+	maxBiographyLen = 500
+)
+
+var genders = map[string]bool{
+	"":       true,
+	"male":   true,
+	"female": true,
+	"other":  true,
+}
+
+// normalizeProfile validates the optional profile fields of the user in place,
+// the phone number is rewritten to E.164
+func normalizeProfile(user *pbu.User) error {
+	if user.BirthDate != "" {
+		birth, err := time.Parse(dateLayout, user.BirthDate)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "birth_date must be an ISO date, e.g. 2000-01-31")
+		}
+		if birth.After(time.Now()) || birth.Year() < 1900 {
+			return status.Error(codes.InvalidArgument, "birth_date is out of range")
+		}
+	}
+	if !genders[user.Gender] {
+		return status.Error(codes.InvalidArgument, "gender must be one of male, female or other")
+	}
+	if utf8.RuneCountInString(user.Biography) > maxBiographyLen {
+		return status.Errorf(codes.InvalidArgument, "biography must be at most %d characters", maxBiographyLen)
+	}
+	if user.PhoneNumber != "" {
+		normalized, err := phone.Normalize(user.PhoneNumber)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		user.PhoneNumber = normalized
+	}
+	return nil
+}
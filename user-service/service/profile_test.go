@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"user-service/config"
+	pbu "user-service/protos/user-service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestNormalizeProfile(t *testing.T) {
+	tests := []struct {
+		name      string
+		user      *pbu.User
+		wantPhone string
+		wantErr   bool
+	}{
+		{name: "empty", user: &pbu.User{}},
+		{name: "full", user: &pbu.User{BirthDate: "2000-01-31", Gender: "female", Biography: "hi", PhoneNumber: "+998 90 123-45-67"}, wantPhone: "+998901234567"},
+		{name: "phone with 00 prefix", user: &pbu.User{PhoneNumber: "00998901234567"}, wantPhone: "+998901234567"},
+		{name: "phone without country code", user: &pbu.User{PhoneNumber: "901234567"}, wantErr: true},
+		{name: "birth date not iso", user: &pbu.User{BirthDate: "31.01.2000"}, wantErr: true},
+		{name: "birth date in the future", user: &pbu.User{BirthDate: time.Now().AddDate(1, 0, 0).Format(dateLayout)}, wantErr: true},
+		{name: "birth date too early", user: &pbu.User{BirthDate: "1899-12-31"}, wantErr: true},
+		{name: "unknown gender", user: &pbu.User{Gender: "robot"}, wantErr: true},
+		{name: "biography at the limit", user: &pbu.User{Biography: strings.Repeat("ж", maxBiographyLen)}},
+		{name: "biography too long", user: &pbu.User{Biography: strings.Repeat("a", maxBiographyLen+1)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := normalizeProfile(tt.user)
+			if tt.wantErr {
+				if status.Code(err) != codes.InvalidArgument {
+					t.Fatalf("error = %v, want InvalidArgument", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.user.PhoneNumber != tt.wantPhone {
+				t.Errorf("phone = %q, want %q", tt.user.PhoneNumber, tt.wantPhone)
+			}
+		})
+	}
+}
+
+func TestRegisterNormalizesPhone(t *testing.T) {
+	users := newFakeUserRepo()
+	s := newTestService(&fakeStorage{user: users}, &fakeMailer{}, config.Config{RegistrationCodeTTL: 10})
+
+	_, err := s.Register(context.Background(), &pbu.CreateUserReq{
+		Email:       "ann@example.com",
+		UserName:    "ann",
+		Password:    testPassword,
+		PhoneNumber: "00 998 (90) 123-45-67",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := users.registrations["ann@example.com"].User.PhoneNumber; got != "+998901234567" {
+		t.Errorf("stored phone = %q, want +998901234567", got)
+	}
+
+	_, err = s.Register(context.Background(), &pbu.CreateUserReq{
+		Email:       "bob@example.com",
+		UserName:    "bob",
+		Password:    testPassword,
+		PhoneNumber: "90 123 45 67",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Register with a local number = %v, want InvalidArgument", err)
+	}
+	if _, ok := users.registrations["bob@example.com"]; ok {
+		t.Error("registration saved with an invalid phone")
+	}
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	l "user-service/pkg/logger"
+	pbc "user-service/protos/comment-service"
+	pbp "user-service/protos/post-service"
+
+	"shared/auth"
+)
+
+const (
+	// purgeBatchSize is how many accounts one run of the purge job removes
+	purgeBatchSize = 100
+	// purgeTimeout bounds the calls made to remove a single account
+	purgeTimeout = time.Minute
+)
+
+// PurgeDeletedUsers removes the accounts whose restore window is over every
+// AccountPurgeInterval minutes, until ctx is done
+func (s *UserService) PurgeDeletedUsers(ctx context.Context) {
+	s.schedulePurges()
+
+	interval := time.Duration(s.cfg.AccountPurgeInterval) * time.Minute
+	if interval <= 0 {
+		s.logger.Info("account purge job is disabled")
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.purgeDue(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// schedulePurges sets the purge time of accounts deleted before there was
+// one, from the configured restore window
+func (s *UserService) schedulePurges() {
+	window := time.Duration(s.cfg.AccountRestoreDays) * 24 * time.Hour
+	scheduled, err := s.storage.User().SchedulePurges(window)
+	if err != nil {
+		s.logger.Error("failed to schedule purges", l.Error(err))
+		return
+	}
+	if scheduled > 0 {
+		s.logger.Info("scheduled purges of deleted users", l.Int("users", int(scheduled)))
+	}
+}
+
+// restoreUser brings back a deleted account while its restore window is
+// open, restored is false when the window closed in the meantime
+func (s *UserService) restoreUser(userId string) (restored bool, err error) {
+	restored, err = s.storage.User().Restore(userId)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return false, err
+	}
+	if restored {
+		s.logger.Info("user restored", l.String("user_id", userId))
+	}
+	return restored, nil
+}
+
+func (s *UserService) purgeDue(ctx context.Context) {
+	ids, err := s.storage.User().DuePurges(purgeBatchSize)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return
+	}
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := s.purgeUser(ctx, id); err != nil {
+			s.logger.Error("failed to purge user", l.String("user_id", id), l.Error(err))
+		}
+	}
+}
+
+// purgeUser removes the comments and posts of a deleted user and then the
+// user row. Every step can be repeated, so a failed purge is retried as is.
+// The other services only take these calls from a service.
+func (s *UserService) purgeUser(ctx context.Context, userId string) error {
+	ctx, cancel := context.WithTimeout(auth.AsService(ctx), purgeTimeout)
+	defer cancel()
+
+	if _, err := s.client.CommentService().PurgeComments(ctx, &pbc.PurgeCommentsReq{UserId: userId}); err != nil {
+		return err
+	}
+	if _, err := s.client.PostService().PurgeOwner(ctx, &pbp.GetPostsByOwnerIdRequest{OwnerId: userId}); err != nil {
+		return err
+	}
+	if _, err := s.storage.User().Purge(userId); err != nil {
+		return err
+	}
+
+	if err := s.storage.LoginAttempt().Delete(userAttemptKey(userId)); err != nil {
+		s.logger.Error(err.Error())
+	}
+	s.suggestions.forget(userId)
+	s.logger.Info("user purged", l.String("user_id", userId))
+	return nil
+}
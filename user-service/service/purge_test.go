@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"user-service/config"
+	pbu "user-service/protos/user-service"
+
+	"shared/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testPassword = "correct horse battery"
+
+// deletedUserFixture is user-1 deleted within the restore window
+func deletedUserFixture(t *testing.T, withTwoFactor bool) (*UserService, *fakeUserRepo, *twoFactorFixture) {
+	t.Helper()
+	var (
+		s     *UserService
+		users *fakeUserRepo
+		f     *twoFactorFixture
+	)
+	if withTwoFactor {
+		f = newTwoFactorFixture(t)
+		s = f.s
+		users = s.storage.User().(*fakeUserRepo)
+	} else {
+		users = newFakeUserRepo()
+		users.users["user-1"] = &pbu.User{Id: "user-1", Email: "ann@example.com", UserName: "ann"}
+		s = newTestService(&fakeStorage{user: users, twoFactor: newFakeTwoFactorRepo()}, &fakeMailer{}, config.Config{})
+	}
+	st := s.storage.(*fakeStorage)
+	st.loginAttempt = newFakeLoginAttemptRepo()
+	st.refreshToken = &fakeRefreshTokenRepo{}
+	s.cfg.LoginMaxFailures, s.cfg.LoginMaxFailuresPerIP, s.cfg.LoginFreeAttempts = 10, 10, 10
+
+	hashed, err := s.hasher.Hash(testPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	users.users["user-1"].Password = hashed
+	users.deleted["user-1"] = true
+	return s, users, f
+}
+
+func TestLoginRestoresWithoutTwoFactor(t *testing.T) {
+	s, users, _ := deletedUserFixture(t, false)
+
+	res, err := s.Login(context.Background(), &pbu.LoginUserReq{Email: "ann@example.com", Password: testPassword})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Restored || res.User.GetId() != "user-1" {
+		t.Fatalf("res = %v, want user-1 restored", res)
+	}
+	if users.deleted["user-1"] {
+		t.Fatal("the account is still deleted")
+	}
+}
+
+func TestLoginRestoresOnlyAfterSecondFactor(t *testing.T) {
+	s, users, f := deletedUserFixture(t, true)
+
+	res, err := s.Login(context.Background(), &pbu.LoginUserReq{Email: "ann@example.com", Password: testPassword})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.MfaRequired || res.Restored || res.User != nil {
+		t.Fatalf("res = %v, want only a challenge", res)
+	}
+	if !users.deleted["user-1"] {
+		t.Fatal("the password alone restored the account")
+	}
+
+	_, err = s.VerifyLoginChallenge(context.Background(), &pbu.LoginChallengeReq{Challenge: res.Challenge, Code: "000000"})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("wrong code: %v", err)
+	}
+	if !users.deleted["user-1"] {
+		t.Fatal("a wrong code restored the account")
+	}
+
+	res, err = s.VerifyLoginChallenge(context.Background(), &pbu.LoginChallengeReq{Challenge: res.Challenge, Code: f.codes[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Restored || res.User.GetId() != "user-1" {
+		t.Fatalf("res = %v, want user-1 restored", res)
+	}
+	if users.deleted["user-1"] {
+		t.Fatal("the account is still deleted")
+	}
+}
+
+func TestLoginWithWrongPasswordDoesNotRestore(t *testing.T) {
+	s, users, _ := deletedUserFixture(t, false)
+
+	_, err := s.Login(context.Background(), &pbu.LoginUserReq{Email: "ann@example.com", Password: "wrong"})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("got %v, want Unauthenticated", err)
+	}
+	if !users.deleted["user-1"] {
+		t.Fatal("a wrong password restored the account")
+	}
+}
+
+func TestSchedulePurgesUsesConfiguredWindow(t *testing.T) {
+	users := newFakeUserRepo()
+	s := newTestService(&fakeStorage{user: users}, &fakeMailer{}, config.Config{AccountRestoreDays: 14})
+
+	s.schedulePurges()
+	if want := 14 * 24 * time.Hour; users.purgeWindow != want {
+		t.Fatalf("restore window %v, want %v", users.purgeWindow, want)
+	}
+}
+
+func TestPurgeUserCallsAsService(t *testing.T) {
+	s, users, _ := deletedUserFixture(t, false)
+	clients := s.client.(*fakeClients)
+
+	// the job runs without a user, a call made while serving one must not
+	// pass its role on either
+	if err := s.purgeUser(asUser("user-2"), "user-1"); err != nil {
+		t.Fatal(err)
+	}
+	want := "user-1 as " + auth.RoleService
+	if len(clients.comment.purged) != 1 || clients.comment.purged[0] != want {
+		t.Fatalf("comments purged %v, want %q", clients.comment.purged, want)
+	}
+	if len(clients.post.purged) != 1 || clients.post.purged[0] != want {
+		t.Fatalf("posts purged %v, want %q", clients.post.purged, want)
+	}
+	if _, ok := users.users["user-1"]; ok {
+		t.Fatal("the user row is still there")
+	}
+}
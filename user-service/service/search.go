@@ -0,0 +1,42 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	pbu "user-service/protos/user-service"
+
+	"shared/paging"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxSearchLen = 100
+
+func (s *UserService) SearchUsers(ctx context.Context, req *pbu.SearchUsersReq) (*pbu.GetAllUsersRes, error) {
+	q := strings.TrimSpace(req.Q)
+	if q == "" {
+		return nil, status.Error(codes.InvalidArgument, "q is required")
+	}
+	if utf8.RuneCountInString(q) > maxSearchLen {
+		return nil, status.Errorf(codes.InvalidArgument, "q must be at most %d characters", maxSearchLen)
+	}
+
+	page := req.Page
+	if page < 1 {
+		page = 1
+	}
+	limit := paging.Size(req.Limit)
+	users, err := s.storage.User().Search(q, limit, (page-1)*limit)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	for _, user := range users.AllUsers {
+		user.Password = ""
+	}
+	return users, nil
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"user-service/config"
+	pbu "user-service/protos/user-service"
+	"user-service/storage/repo"
+
+	"shared/paging"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeSearchRepo records the arguments of Search
+type fakeSearchRepo struct {
+	repo.UserStorageI
+
+	q             string
+	limit, offset int64
+}
+
+func (r *fakeSearchRepo) Search(q string, limit, offset int64) (*pbu.GetAllUsersRes, error) {
+	r.q, r.limit, r.offset = q, limit, offset
+	return &pbu.GetAllUsersRes{
+		AllUsers: []*pbu.User{{Id: "user-1", UserName: "ann", Password: "hash"}},
+		Count:    1,
+	}, nil
+}
+
+func TestSearchUsers(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        *pbu.SearchUsersReq
+		wantQ      string
+		wantLimit  int64
+		wantOffset int64
+	}{
+		{"defaults", &pbu.SearchUsersReq{Q: "ann"}, "ann", paging.DefaultSize, 0},
+		{"trimmed", &pbu.SearchUsersReq{Q: "  ann  "}, "ann", paging.DefaultSize, 0},
+		{"third page", &pbu.SearchUsersReq{Q: "ann", Page: 3, Limit: 10}, "ann", 10, 20},
+		{"limit capped", &pbu.SearchUsersReq{Q: "ann", Page: 2, Limit: 1000}, "ann", paging.MaxSize, paging.MaxSize},
+		{"longest query", &pbu.SearchUsersReq{Q: strings.Repeat("ж", maxSearchLen)}, strings.Repeat("ж", maxSearchLen), paging.DefaultSize, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &fakeSearchRepo{}
+			s := newTestService(&fakeStorage{user: users}, &fakeMailer{}, config.Config{})
+
+			res, err := s.SearchUsers(context.Background(), tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if users.q != tt.wantQ || users.limit != tt.wantLimit || users.offset != tt.wantOffset {
+				t.Errorf("Search(%q, %d, %d), want (%q, %d, %d)", users.q, users.limit, users.offset, tt.wantQ, tt.wantLimit, tt.wantOffset)
+			}
+			if res.AllUsers[0].Password != "" {
+				t.Error("password hash returned")
+			}
+		})
+	}
+}
+
+func TestSearchUsersInvalid(t *testing.T) {
+	s := newTestService(&fakeStorage{user: &fakeSearchRepo{}}, &fakeMailer{}, config.Config{})
+	for _, q := range []string{"", "   ", strings.Repeat("a", maxSearchLen+1)} {
+		if _, err := s.SearchUsers(context.Background(), &pbu.SearchUsersReq{Q: q}); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("SearchUsers(%q) error = %v, want InvalidArgument", q, err)
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	pbc "user-service/protos/comment-service"
+	pbp "user-service/protos/post-service"
+	pbu "user-service/protos/user-service"
+
+	"shared/auth"
+	"shared/paging"
+)
+
+const (
+	// maxSuggestions is how many ranked suggestions are kept per user
+	maxSuggestions = 100
+	// maxCachedSuggestions bounds how many users' suggestions stay in memory
+	maxCachedSuggestions = 10000
+
+	mutualFollowWeight   = 3
+	sharedPostWeight     = 2
+	sharedCategoryWeight = 1
+)
+
+// SuggestUsers ranks people the user may want to follow by mutual follows,
+// posts both commented on and shared post categories
+func (s *UserService) SuggestUsers(ctx context.Context, req *pbu.SuggestUsersReq) (*pbu.SuggestUsersRes, error) {
+	if err := auth.CanModify(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	if err := s.checkUserExists(req.UserId); err != nil {
+		return nil, err
+	}
+
+	suggestions, ok := s.suggestions.get(req.UserId)
+	if !ok {
+		var err error
+		suggestions, err = s.rankSuggestions(ctx, req.UserId)
+		if err != nil {
+			return nil, err
+		}
+		s.suggestions.put(req.UserId, suggestions)
+	}
+
+	if limit := paging.Size(req.Limit); int64(len(suggestions)) > limit {
+		suggestions = suggestions[:limit]
+	}
+	return &pbu.SuggestUsersRes{Suggestions: suggestions}, nil
+}
+
+func (s *UserService) rankSuggestions(ctx context.Context, userId string) ([]*pbu.Suggestion, error) {
+	candidates := map[string]*pbu.Suggestion{}
+	candidate := func(id string) *pbu.Suggestion {
+		c, ok := candidates[id]
+		if !ok {
+			c = &pbu.Suggestion{}
+			candidates[id] = c
+		}
+		return c
+	}
+
+	mutuals, err := s.storage.Follow().FriendsOfFriends(userId, maxSuggestions)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	for _, m := range mutuals {
+		candidate(m.UserId).MutualFollows = m.Score
+	}
+
+	// the other services only sharpen the ranking, so a failing one is skipped
+	coCommenters, err := s.client.CommentService().GetCoCommenters(ctx, &pbc.IdRequst{Id: userId})
+	if err != nil {
+		s.logger.Error("failed to get co-commenters: " + err.Error())
+	} else {
+		for _, c := range coCommenters.Scores {
+			candidate(c.UserId).SharedPosts = c.Score
+		}
+	}
+	neighbours, err := s.client.PostService().GetCategoryNeighbours(ctx, &pbp.GetPostsByOwnerIdRequest{OwnerId: userId})
+	if err != nil {
+		s.logger.Error("failed to get category neighbours: " + err.Error())
+	} else {
+		for _, n := range neighbours.Scores {
+			candidate(n.UserId).SharedCategories = n.Score
+		}
+	}
+
+	excluded, err := s.excludedFromSuggestions(userId)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(candidates))
+	for id, c := range candidates {
+		if excluded[id] {
+			continue
+		}
+		c.Score = mutualFollowWeight*c.MutualFollows +
+			sharedPostWeight*c.SharedPosts +
+			sharedCategoryWeight*c.SharedCategories
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, b := candidates[ids[i]], candidates[ids[j]]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return ids[i] < ids[j]
+	})
+	if len(ids) > maxSuggestions {
+		ids = ids[:maxSuggestions]
+	}
+
+	users, err := s.storage.User().GetByIds(ids)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	for _, user := range users {
+		user.Password = ""
+		candidates[user.Id].User = user
+	}
+
+	// deleted users come back without a profile and are dropped
+	suggestions := make([]*pbu.Suggestion, 0, len(ids))
+	for _, id := range ids {
+		if c := candidates[id]; c.User != nil {
+			suggestions = append(suggestions, c)
+		}
+	}
+	return suggestions, nil
+}
+
+// excludedFromSuggestions is the user, whoever they follow or asked to
+// follow, everyone blocked either way and everyone they muted
+func (s *UserService) excludedFromSuggestions(userId string) (map[string]bool, error) {
+	excluded := map[string]bool{userId: true}
+	lists := []func(string) ([]string, error){
+		s.storage.Follow().FollowingIds,
+		s.storage.Block().Blocked,
+		s.storage.Block().Muted,
+	}
+	for _, list := range lists {
+		ids, err := list(userId)
+		if err != nil {
+			s.logger.Error(err.Error())
+			return nil, err
+		}
+		for _, id := range ids {
+			excluded[id] = true
+		}
+	}
+	return excluded, nil
+}
+
+// suggestionCache keeps each user's ranked suggestions for a while, since
+// ranking them fans out to the other services
+type suggestionCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cachedSuggestions
+}
+
+type cachedSuggestions struct {
+	suggestions []*pbu.Suggestion
+	expiresAt   time.Time
+}
+
+func newSuggestionCache(ttl time.Duration) *suggestionCache {
+	return &suggestionCache{ttl: ttl, entries: map[string]cachedSuggestions{}}
+}
+
+func (c *suggestionCache) get(userId string) ([]*pbu.Suggestion, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[userId]
+	if !ok || time.Now().After(entry.expiresAt) {
+		delete(c.entries, userId)
+		return nil, false
+	}
+	return entry.suggestions, true
+}
+
+// forget drops a user's suggestions once they follow, block or mute someone
+func (c *suggestionCache) forget(userId string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, userId)
+}
+
+func (c *suggestionCache) put(userId string, suggestions []*pbu.Suggestion) {
+	if c.ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if len(c.entries) >= maxCachedSuggestions {
+		for id, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, id)
+			}
+		}
+		// still full of live entries, drop an arbitrary one
+		for id := range c.entries {
+			if len(c.entries) < maxCachedSuggestions {
+				break
+			}
+			delete(c.entries, id)
+		}
+	}
+	c.entries[userId] = cachedSuggestions{suggestions: suggestions, expiresAt: now.Add(c.ttl)}
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"user-service/config"
+	pbc "user-service/protos/comment-service"
+	pbp "user-service/protos/post-service"
+	pbu "user-service/protos/user-service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// suggestFixture is ann, who follows bob and cid and asked to follow fay.
+// bob follows dan and eve and cid follows dan, so dan shares two follows
+// with ann and eve one. ivy blocked ann, ann muted jay and gus is deleted.
+func suggestFixture(t *testing.T) (*UserService, *fakeClients) {
+	t.Helper()
+	users := newFakeUserRepo()
+	for _, id := range []string{"ann", "bob", "cid", "dan", "eve", "fay", "gus", "hal", "ivy", "jay"} {
+		users.users[id] = &pbu.User{Id: id, UserName: id, Password: "hash"}
+	}
+	users.deleted["gus"] = true
+
+	follows := newFakeFollowRepo(users)
+	for _, f := range [][2]string{{"ann", "bob"}, {"ann", "cid"}, {"bob", "dan"}, {"bob", "eve"}, {"cid", "dan"}} {
+		follows.add(f[0], f[1], time.Now())
+	}
+	follows.RequestFollow("ann", "fay")
+
+	s := newTestService(&fakeStorage{
+		user:   users,
+		follow: follows,
+		block: &fakeBlockRepo{
+			blocked: map[[2]string]bool{{"ivy", "ann"}: true},
+			muted:   map[[2]string]bool{{"ann", "jay"}: true},
+		},
+	}, &fakeMailer{}, config.Config{})
+
+	clients := s.client.(*fakeClients)
+	clients.comment.coCommenters = []*pbc.UserScore{
+		{UserId: "eve", Score: 2}, {UserId: "hal", Score: 1}, {UserId: "fay", Score: 5}, {UserId: "gus", Score: 4},
+	}
+	clients.post.neighbours = []*pbp.UserScore{
+		{UserId: "hal", Score: 3}, {UserId: "dan", Score: 1}, {UserId: "bob", Score: 9}, {UserId: "ivy", Score: 9}, {UserId: "jay", Score: 9},
+	}
+	return s, clients
+}
+
+func suggestedIds(res *pbu.SuggestUsersRes) []string {
+	var ids []string
+	for _, s := range res.Suggestions {
+		ids = append(ids, s.User.Id)
+	}
+	return ids
+}
+
+func TestSuggestUsers(t *testing.T) {
+	s, _ := suggestFixture(t)
+
+	res, err := s.SuggestUsers(asUser("ann"), &pbu.SuggestUsersReq{UserId: "ann"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// dan and eve tie at 7 and are ordered by id, hal has 5
+	if ids := suggestedIds(res); !reflect.DeepEqual(ids, []string{"dan", "eve", "hal"}) {
+		t.Fatalf("suggested %v, want dan, eve and hal", ids)
+	}
+	dan := res.Suggestions[0]
+	if dan.MutualFollows != 2 || dan.SharedPosts != 0 || dan.SharedCategories != 1 || dan.Score != 7 {
+		t.Errorf("dan scored %+v", dan)
+	}
+	if eve := res.Suggestions[1]; eve.MutualFollows != 1 || eve.SharedPosts != 2 || eve.Score != 7 {
+		t.Errorf("eve scored %+v", eve)
+	}
+	for _, sug := range res.Suggestions {
+		if sug.User.Password != "" {
+			t.Errorf("password hash of %s returned", sug.User.Id)
+		}
+	}
+
+	res, err = s.SuggestUsers(asUser("ann"), &pbu.SuggestUsersReq{UserId: "ann", Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := suggestedIds(res); !reflect.DeepEqual(ids, []string{"dan", "eve"}) {
+		t.Errorf("suggested %v with a limit of 2", ids)
+	}
+}
+
+func TestSuggestUsersWithoutOtherServices(t *testing.T) {
+	s, clients := suggestFixture(t)
+	clients.comment.coCommentersErr = status.Error(codes.Unavailable, "down")
+	clients.post.neighboursErr = status.Error(codes.Unavailable, "down")
+
+	res, err := s.SuggestUsers(asUser("ann"), &pbu.SuggestUsersReq{UserId: "ann"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := suggestedIds(res); !reflect.DeepEqual(ids, []string{"dan", "eve"}) {
+		t.Errorf("suggested %v from the follow graph, want dan and eve", ids)
+	}
+}
+
+func TestSuggestUsersErrors(t *testing.T) {
+	s, _ := suggestFixture(t)
+	if _, err := s.SuggestUsers(asUser("bob"), &pbu.SuggestUsersReq{UserId: "ann"}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("suggestions of another user = %v, want PermissionDenied", err)
+	}
+	if _, err := s.SuggestUsers(asUser("zed"), &pbu.SuggestUsersReq{UserId: "zed"}); status.Code(err) != codes.NotFound {
+		t.Errorf("suggestions of an unknown user = %v, want NotFound", err)
+	}
+}
+
+func TestSuggestUsersCached(t *testing.T) {
+	s, clients := suggestFixture(t)
+	s.suggestions = newSuggestionCache(time.Minute)
+
+	if _, err := s.SuggestUsers(asUser("ann"), &pbu.SuggestUsersReq{UserId: "ann"}); err != nil {
+		t.Fatal(err)
+	}
+	// served from the cache, the other services are not asked again
+	clients.comment.coCommentersErr = errors.New("not cached")
+	res, err := s.SuggestUsers(asUser("ann"), &pbu.SuggestUsersReq{UserId: "ann"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := suggestedIds(res); !reflect.DeepEqual(ids, []string{"dan", "eve", "hal"}) {
+		t.Errorf("cached suggestions %v", ids)
+	}
+
+	// following someone starts over
+	if _, err := s.Follow(asUser("ann"), &pbu.FollowReq{FollowerId: "ann", FolloweeId: "dan"}); err != nil {
+		t.Fatal(err)
+	}
+	s.client.(*fakeClients).post.waitRebuilt(t, 1)
+	res, err = s.SuggestUsers(asUser("ann"), &pbu.SuggestUsersReq{UserId: "ann"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := suggestedIds(res); !reflect.DeepEqual(ids, []string{"eve", "hal"}) {
+		t.Errorf("suggested %v after following dan, want eve and hal", ids)
+	}
+}
+
+func TestSuggestionCache(t *testing.T) {
+	suggestions := []*pbu.Suggestion{{Score: 1}}
+
+	off := newSuggestionCache(0)
+	off.put("ann", suggestions)
+	if _, ok := off.get("ann"); ok {
+		t.Error("cached with a ttl of 0")
+	}
+
+	c := newSuggestionCache(time.Minute)
+	c.put("ann", suggestions)
+	if got, ok := c.get("ann"); !ok || !reflect.DeepEqual(got, suggestions) {
+		t.Errorf("get = %v, %v", got, ok)
+	}
+	c.forget("ann")
+	if _, ok := c.get("ann"); ok {
+		t.Error("kept after forget")
+	}
+
+	short := newSuggestionCache(time.Millisecond)
+	short.put("ann", suggestions)
+	time.Sleep(2 * time.Millisecond)
+	if _, ok := short.get("ann"); ok {
+		t.Error("kept after the ttl")
+	}
+
+	for i := 0; i <= maxCachedSuggestions; i++ {
+		c.put(fmt.Sprint(i), suggestions)
+	}
+	if len(c.entries) > maxCachedSuggestions {
+		t.Errorf("%d entries cached, want at most %d", len(c.entries), maxCachedSuggestions)
+	}
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	l "user-service/pkg/logger"
+	pbu "user-service/protos/user-service"
+	"user-service/storage/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *UserService) SaveRefreshToken(ctx context.Context, req *pbu.RefreshToken) (*pbu.Status, error) {
+	expiresAt, err := time.Parse(time.RFC3339, req.ExpiresAt)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "expires_at must be RFC3339")
+	}
+
+	err = s.storage.RefreshToken().Create(&repo.RefreshToken{
+		Id:        req.Id,
+		FamilyId:  req.FamilyId,
+		UserId:    req.UserId,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return &pbu.Status{Message: "refresh token saved"}, nil
+}
+
+// RotateRefreshToken spends a refresh token and stores its successor in the same
+// family. Presenting a token that was already spent revokes the whole family,
+// since either the client or an attacker holds a stolen copy.
+func (s *UserService) RotateRefreshToken(ctx context.Context, req *pbu.RotateRefreshTokenReq) (*pbu.AuthRes, error) {
+	if req.Next == nil {
+		return nil, status.Error(codes.InvalidArgument, "next token is required")
+	}
+	expiresAt, err := time.Parse(time.RFC3339, req.Next.ExpiresAt)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "expires_at must be RFC3339")
+	}
+
+	token, err := s.storage.RefreshToken().Get(req.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.Unauthenticated, "unknown refresh token")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	switch {
+	case token.RevokedAt != nil:
+		return nil, status.Error(codes.Unauthenticated, "refresh token revoked")
+	case token.UsedAt != nil:
+		return nil, s.revokeFamily(token)
+	case time.Now().After(token.ExpiresAt):
+		return nil, status.Error(codes.Unauthenticated, "refresh token expired")
+	}
+
+	rotated, err := s.storage.RefreshToken().Rotate(token.Id, &repo.RefreshToken{
+		Id:        req.Next.Id,
+		FamilyId:  token.FamilyId,
+		UserId:    token.UserId,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if !rotated {
+		return nil, s.rotateFailed(token.Id)
+	}
+
+	user, err := s.storage.User().Get(&pbu.GetUserReq{Field: "id", Value: token.UserId})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.Unauthenticated, "user not found")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	user.Password = ""
+	return &pbu.AuthRes{User: user}, nil
+}
+
+// rotateFailed tells why a token that looked fine could not be spent. Only
+// losing a race against another request spending it counts as reuse, a token
+// that expired or was revoked in the meantime leaves its family alone.
+func (s *UserService) rotateFailed(id string) error {
+	token, err := s.storage.RefreshToken().Get(id)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+	switch {
+	case token.RevokedAt != nil:
+		return status.Error(codes.Unauthenticated, "refresh token revoked")
+	case token.UsedAt != nil:
+		return s.revokeFamily(token)
+	default:
+		return status.Error(codes.Unauthenticated, "refresh token expired")
+	}
+}
+
+func (s *UserService) revokeFamily(token *repo.RefreshToken) error {
+	s.logger.Warn("refresh token reuse detected",
+		l.String("user_id", token.UserId),
+		l.String("family_id", token.FamilyId))
+
+	if err := s.storage.RefreshToken().RevokeFamily(token.FamilyId); err != nil {
+		s.logger.Error(err.Error())
+		return err
+	}
+	return status.Error(codes.Unauthenticated, "refresh token reuse detected")
+}
+
+// RevokeRefreshToken revokes the family of the given token, used on logout
+func (s *UserService) RevokeRefreshToken(ctx context.Context, req *pbu.RefreshToken) (*pbu.Status, error) {
+	token, err := s.storage.RefreshToken().Get(req.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "unknown refresh token")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if token.UserId != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "refresh token belongs to another user")
+	}
+
+	if err := s.storage.RefreshToken().RevokeFamily(token.FamilyId); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return &pbu.Status{Message: "refresh token revoked"}, nil
+}
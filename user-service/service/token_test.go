@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"user-service/config"
+	pbu "user-service/protos/user-service"
+	"user-service/storage/repo"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type tokenFixture struct {
+	s      *UserService
+	tokens *fakeRefreshTokenRepo
+}
+
+// newTokenFixture stores token-1 of family-1 for user-1, valid for an hour
+func newTokenFixture(t *testing.T) *tokenFixture {
+	users := newFakeUserRepo()
+	users.users["user-1"] = &pbu.User{Id: "user-1", UserName: "ann", Password: "hash"}
+	f := &tokenFixture{tokens: &fakeRefreshTokenRepo{}}
+	f.s = newTestService(&fakeStorage{user: users, refreshToken: f.tokens}, &fakeMailer{}, config.Config{})
+	f.save(t, "token-1", time.Now().Add(time.Hour))
+	return f
+}
+
+func (f *tokenFixture) save(t *testing.T, id string, expiresAt time.Time) {
+	t.Helper()
+	if err := f.tokens.Create(&repo.RefreshToken{Id: id, FamilyId: "family-1", UserId: "user-1", ExpiresAt: expiresAt}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (f *tokenFixture) rotate(id, next string) (*pbu.AuthRes, error) {
+	return f.s.RotateRefreshToken(context.Background(), &pbu.RotateRefreshTokenReq{
+		Id: id,
+		Next: &pbu.RefreshToken{
+			Id:        next,
+			ExpiresAt: time.Now().Add(2 * time.Hour).UTC().Format(time.RFC3339),
+		},
+	})
+}
+
+// familyRevoked reports whether every token of family-1 is revoked
+func (f *tokenFixture) familyRevoked(t *testing.T) bool {
+	t.Helper()
+	f.tokens.mu.Lock()
+	defer f.tokens.mu.Unlock()
+	for _, token := range f.tokens.tokens {
+		if token.RevokedAt == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func wantUnauthenticated(t *testing.T, err error, msg string) {
+	t.Helper()
+	if status.Code(err) != codes.Unauthenticated || !strings.Contains(status.Convert(err).Message(), msg) {
+		t.Fatalf("err = %v, want Unauthenticated %q", err, msg)
+	}
+}
+
+func TestRotateRefreshToken(t *testing.T) {
+	f := newTokenFixture(t)
+
+	res, err := f.rotate("token-1", "token-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.User.Id != "user-1" || res.User.Password != "" {
+		t.Fatalf("user = %v, want user-1 without its password", res.User)
+	}
+	spent, _ := f.tokens.Get("token-1")
+	next, err := f.tokens.Get("token-2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spent.UsedAt == nil || next.FamilyId != "family-1" || next.UserId != "user-1" || next.UsedAt != nil {
+		t.Fatalf("spent %+v, next %+v", spent, next)
+	}
+
+	if _, err := f.rotate("token-2", "token-3"); err != nil {
+		t.Fatalf("rotating the successor: %v", err)
+	}
+}
+
+func TestRotateRefreshTokenReuse(t *testing.T) {
+	f := newTokenFixture(t)
+	if _, err := f.rotate("token-1", "token-2"); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := f.rotate("token-1", "token-3")
+	wantUnauthenticated(t, err, "reuse")
+	if !f.familyRevoked(t) {
+		t.Fatal("reuse left tokens of the family usable")
+	}
+	// the successor the legitimate client holds is gone too
+	_, err = f.rotate("token-2", "token-4")
+	wantUnauthenticated(t, err, "revoked")
+}
+
+func TestRotateRefreshTokenRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(t *testing.T, f *tokenFixture)
+		id      string
+		want    string
+		revoked bool
+	}{
+		{
+			name: "unknown",
+			id:   "token-9",
+			want: "unknown",
+		},
+		{
+			name: "expired",
+			prepare: func(t *testing.T, f *tokenFixture) {
+				f.save(t, "token-old", time.Now().Add(-time.Minute))
+			},
+			id:   "token-old",
+			want: "expired",
+		},
+		{
+			name: "revoked",
+			prepare: func(t *testing.T, f *tokenFixture) {
+				f.tokens.RevokeFamily("family-1")
+			},
+			id:      "token-1",
+			want:    "revoked",
+			revoked: true,
+		},
+		{
+			name: "expires between get and rotate",
+			prepare: func(t *testing.T, f *tokenFixture) {
+				f.tokens.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+			},
+			id:   "token-1",
+			want: "expired",
+		},
+		{
+			name: "spent by a concurrent request",
+			prepare: func(t *testing.T, f *tokenFixture) {
+				f.tokens.beforeRotate = func() {
+					f.tokens.beforeRotate = nil
+					if _, err := f.rotate("token-1", "token-other"); err != nil {
+						t.Error(err)
+					}
+				}
+			},
+			id:      "token-1",
+			want:    "reuse",
+			revoked: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newTokenFixture(t)
+			if tt.prepare != nil {
+				tt.prepare(t, f)
+			}
+
+			_, err := f.rotate(tt.id, "token-2")
+			wantUnauthenticated(t, err, tt.want)
+			if got := f.familyRevoked(t); got != tt.revoked {
+				t.Fatalf("family revoked = %v, want %v", got, tt.revoked)
+			}
+			if _, err := f.tokens.Get("token-2"); err == nil {
+				t.Fatal("successor stored for a rejected token")
+			}
+		})
+	}
+}
+
+func TestRotateRefreshTokenInvalidNext(t *testing.T) {
+	f := newTokenFixture(t)
+	for _, next := range []*pbu.RefreshToken{nil, {Id: "token-2", ExpiresAt: "tomorrow"}} {
+		_, err := f.s.RotateRefreshToken(context.Background(), &pbu.RotateRefreshTokenReq{Id: "token-1", Next: next})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Fatalf("next %v: err = %v, want InvalidArgument", next, err)
+		}
+	}
+	if token, _ := f.tokens.Get("token-1"); token.UsedAt != nil {
+		t.Fatal("token spent by an invalid request")
+	}
+}
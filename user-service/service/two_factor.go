@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"user-service/pkg/etc"
+	"user-service/pkg/totp"
+	pbu "user-service/protos/user-service"
+	"user-service/storage/repo"
+
+	"shared/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// recoveryCodeCount is how many recovery codes a user gets at a time
+const recoveryCodeCount = 10
+
+// EnrollTotp creates a new secret, two-factor authentication is only turned
+// on once ConfirmTotp got a valid code for it
+func (s *UserService) EnrollTotp(ctx context.Context, req *pbu.TotpReq) (*pbu.TotpEnrollment, error) {
+	if err := auth.CanModify(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	user, err := s.storage.User().Get(&pbu.GetUserReq{Field: "id", Value: req.UserId})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	current, err := s.storage.TwoFactor().GetTotp(req.UserId)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if current != nil && current.EnabledAt != nil {
+		return nil, status.Error(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, "failed to generate secret")
+	}
+	if err := s.storage.TwoFactor().SaveTotp(req.UserId, secret); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return &pbu.TotpEnrollment{
+		Secret: secret,
+		Uri:    totp.URI(secret, s.cfg.TotpIssuer, user.Email),
+	}, nil
+}
+
+// ConfirmTotp turns two-factor authentication on and hands out the first
+// recovery codes
+func (s *UserService) ConfirmTotp(ctx context.Context, req *pbu.TotpReq) (*pbu.RecoveryCodes, error) {
+	if err := auth.CanModify(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	current, err := s.storage.TwoFactor().GetTotp(req.UserId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.FailedPrecondition, "two-factor authentication is not enrolled")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if current.EnabledAt != nil {
+		return nil, status.Error(codes.FailedPrecondition, "two-factor authentication is already enabled")
+	}
+
+	ok, err := s.checkTotp(current, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid code")
+	}
+	if err := s.storage.TwoFactor().EnableTotp(req.UserId); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	return s.newRecoveryCodes(req.UserId)
+}
+
+// GenerateRecoveryCodes replaces the recovery codes of the user, a current
+// TOTP code is required
+func (s *UserService) GenerateRecoveryCodes(ctx context.Context, req *pbu.TotpReq) (*pbu.RecoveryCodes, error) {
+	if err := auth.CanModify(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	current, err := s.enabledTotp(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := s.checkTotp(current, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid code")
+	}
+
+	return s.newRecoveryCodes(req.UserId)
+}
+
+// DisableTotp turns two-factor authentication off, it takes a TOTP code or
+// a recovery code
+func (s *UserService) DisableTotp(ctx context.Context, req *pbu.TotpReq) (*pbu.Status, error) {
+	if err := auth.CanModify(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	current, err := s.enabledTotp(req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := s.checkSecondFactor(current, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid code")
+	}
+
+	if err := s.storage.TwoFactor().DeleteTotp(req.UserId); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return &pbu.Status{Message: "two-factor authentication disabled"}, nil
+}
+
+// VerifyLoginChallenge is the second step of a login with two-factor
+// authentication, it exchanges the challenge from Login and a TOTP or
+// recovery code for the user
+func (s *UserService) VerifyLoginChallenge(ctx context.Context, req *pbu.LoginChallengeReq) (*pbu.AuthRes, error) {
+	invalid := status.Error(codes.Unauthenticated, "invalid or expired challenge")
+
+	idHash := etc.HashCode(req.Challenge)
+	challenge, err := s.storage.TwoFactor().GetChallenge(idHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, invalid
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if time.Now().After(challenge.ExpiresAt) || challenge.Attempts >= maxCodeAttempts {
+		if err := s.storage.TwoFactor().DeleteChallenge(idHash); err != nil {
+			s.logger.Error(err.Error())
+		}
+		return nil, invalid
+	}
+
+	current, err := s.enabledTotp(challenge.UserId)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := s.checkSecondFactor(current, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if err := s.storage.TwoFactor().IncrChallengeAttempts(idHash); err != nil {
+			s.logger.Error(err.Error())
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid code")
+	}
+	if err := s.storage.TwoFactor().DeleteChallenge(idHash); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if challenge.Restore {
+		restored, err := s.restoreUser(challenge.UserId)
+		if err != nil {
+			return nil, err
+		}
+		if !restored {
+			// the purge job got to it first
+			return nil, invalid
+		}
+	}
+
+	user, err := s.storage.User().Get(&pbu.GetUserReq{Field: "id", Value: challenge.UserId})
+	if errors.Is(err, sql.ErrNoRows) {
+		// deleted while the challenge was pending
+		return nil, invalid
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	user.Password = ""
+	return &pbu.AuthRes{User: user, Restored: challenge.Restore}, nil
+}
+
+// loginChallenge is returned by Login instead of the user when two-factor
+// authentication is on, ok is false when it is off. restore carries a pending
+// restore of a deleted account over to VerifyLoginChallenge.
+func (s *UserService) loginChallenge(userId string, restore bool) (res *pbu.AuthRes, ok bool, err error) {
+	current, err := s.storage.TwoFactor().GetTotp(userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, false, err
+	}
+	if current.EnabledAt == nil {
+		return nil, false, nil
+	}
+
+	token, err := etc.GenerateToken(32)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, false, status.Error(codes.Internal, "failed to generate challenge")
+	}
+	err = s.storage.TwoFactor().CreateChallenge(&repo.LoginChallenge{
+		IdHash:    etc.HashCode(token),
+		UserId:    userId,
+		Restore:   restore,
+		ExpiresAt: time.Now().Add(time.Minute * time.Duration(s.cfg.MfaChallengeTTL)),
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, false, err
+	}
+
+	return &pbu.AuthRes{MfaRequired: true, Challenge: token}, true, nil
+}
+
+func (s *UserService) enabledTotp(userId string) (*repo.Totp, error) {
+	current, err := s.storage.TwoFactor().GetTotp(userId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if current.EnabledAt == nil {
+		return nil, status.Error(codes.FailedPrecondition, "two-factor authentication is not enabled")
+	}
+	return current, nil
+}
+
+// checkTotp accepts a TOTP code once, a replayed code is rejected
+func (s *UserService) checkTotp(current *repo.Totp, code string) (bool, error) {
+	step, ok := totp.Validate(current.Secret, code, time.Now())
+	if !ok || step <= current.LastStep {
+		return false, nil
+	}
+
+	used, err := s.storage.TwoFactor().UseTotpStep(current.UserId, step)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return false, err
+	}
+	return used, nil
+}
+
+// checkSecondFactor accepts a TOTP code or an unused recovery code
+func (s *UserService) checkSecondFactor(current *repo.Totp, code string) (bool, error) {
+	ok, err := s.checkTotp(current, code)
+	if err != nil || ok {
+		return ok, err
+	}
+
+	used, err := s.storage.TwoFactor().UseRecoveryCode(current.UserId, etc.HashCode(normalizeRecoveryCode(code)))
+	if err != nil {
+		s.logger.Error(err.Error())
+		return false, err
+	}
+	return used, nil
+}
+
+func (s *UserService) newRecoveryCodes(userId string) (*pbu.RecoveryCodes, error) {
+	var (
+		res    pbu.RecoveryCodes
+		hashes []string
+	)
+	for i := 0; i < recoveryCodeCount; i++ {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			s.logger.Error(err.Error())
+			return nil, status.Error(codes.Internal, "failed to generate recovery codes")
+		}
+		res.Codes = append(res.Codes, code)
+		hashes = append(hashes, etc.HashCode(normalizeRecoveryCode(code)))
+	}
+
+	if err := s.storage.TwoFactor().ReplaceRecoveryCodes(userId, hashes); err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	return &res, nil
+}
+
+// generateRecoveryCode returns a code like "k3j9a-x2mq7"
+func generateRecoveryCode() (string, error) {
+	b := make([]byte, 7)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	code := strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b))[:10]
+	return code[:5] + "-" + code[5:], nil
+}
+
+// normalizeRecoveryCode lets users type codes without the dash or in upper case
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"user-service/config"
+	"user-service/pkg/etc"
+	pbu "user-service/protos/user-service"
+
+	"shared/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// totpCode is what an authenticator app shows for secret at t, computed here
+// independently of pkg/totp
+func totpCode(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(at.Unix()/30))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	return fmt.Sprintf("%06d", (binary.BigEndian.Uint32(sum[offset:])&0x7fffffff)%1000000)
+}
+
+// asUser is the context of a call the gateway makes for userId
+func asUser(userId string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.UserIdKey, userId, auth.RoleKey, "user"))
+}
+
+// asAdmin is the context of a call the gateway makes for an admin
+func asAdmin() context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.UserIdKey, "admin-1", auth.RoleKey, auth.RoleAdmin))
+}
+
+type twoFactorFixture struct {
+	s      *UserService
+	tf     *fakeTwoFactorRepo
+	secret string
+	codes  []string
+}
+
+// newTwoFactorFixture enrolls user-1 and returns its recovery codes
+func newTwoFactorFixture(t *testing.T) *twoFactorFixture {
+	t.Helper()
+	users := newFakeUserRepo()
+	users.users["user-1"] = &pbu.User{Id: "user-1", Email: "ann@example.com", UserName: "ann"}
+	f := &twoFactorFixture{tf: newFakeTwoFactorRepo()}
+	f.s = newTestService(&fakeStorage{user: users, twoFactor: f.tf}, &fakeMailer{}, config.Config{TotpIssuer: "test", MfaChallengeTTL: 5})
+
+	ctx := asUser("user-1")
+	enroll, err := f.s.EnrollTotp(ctx, &pbu.TotpReq{UserId: "user-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.secret = enroll.Secret
+	// the step before, so the first code of the test is still unused
+	confirm := totpCode(t, f.secret, time.Now().Add(-30*time.Second))
+	codes, err := f.s.ConfirmTotp(ctx, &pbu.TotpReq{UserId: "user-1", Code: confirm})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.codes = codes.Codes
+	return f
+}
+
+func TestRecoveryCodesAreSingleUse(t *testing.T) {
+	f := newTwoFactorFixture(t)
+	if len(f.codes) != recoveryCodeCount {
+		t.Fatalf("%d recovery codes, want %d", len(f.codes), recoveryCodeCount)
+	}
+	current, err := f.tf.GetTotp("user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ok, err := f.s.checkSecondFactor(current, f.codes[0])
+	if err != nil || !ok {
+		t.Fatalf("first use: ok = %v, err = %v", ok, err)
+	}
+	ok, err = f.s.checkSecondFactor(current, f.codes[0])
+	if err != nil || ok {
+		t.Fatalf("second use: ok = %v, err = %v", ok, err)
+	}
+	// the others are still good, typed the way users type them
+	typed := strings.ToUpper(strings.ReplaceAll(f.codes[1], "-", ""))
+	if ok, _ := f.s.checkSecondFactor(current, " "+typed+" "); !ok {
+		t.Fatalf("%q was not accepted for %q", typed, f.codes[1])
+	}
+}
+
+func TestRecoveryCodesReplaced(t *testing.T) {
+	f := newTwoFactorFixture(t)
+	fresh, err := f.s.GenerateRecoveryCodes(asUser("user-1"), &pbu.TotpReq{
+		UserId: "user-1",
+		Code:   totpCode(t, f.secret, time.Now()),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, _ := f.tf.GetTotp("user-1")
+	if ok, _ := f.s.checkSecondFactor(current, f.codes[0]); ok {
+		t.Fatal("a replaced recovery code was accepted")
+	}
+	if ok, _ := f.s.checkSecondFactor(current, fresh.Codes[0]); !ok {
+		t.Fatal("a new recovery code was not accepted")
+	}
+}
+
+func TestTotpCodeIsNotReplayed(t *testing.T) {
+	f := newTwoFactorFixture(t)
+	code := totpCode(t, f.secret, time.Now())
+
+	current, _ := f.tf.GetTotp("user-1")
+	if ok, err := f.s.checkTotp(current, code); err != nil || !ok {
+		t.Fatalf("first use: ok = %v, err = %v", ok, err)
+	}
+	current, _ = f.tf.GetTotp("user-1")
+	if ok, _ := f.s.checkTotp(current, code); ok {
+		t.Fatal("the same code was accepted twice")
+	}
+	// nor is the code of an earlier step once a later one was used
+	earlier := totpCode(t, f.secret, time.Now().Add(-30*time.Second))
+	if ok, _ := f.s.checkTotp(current, earlier); ok {
+		t.Fatal("an older code was accepted after a newer one")
+	}
+}
+
+func TestVerifyLoginChallengeAttempts(t *testing.T) {
+	f := newTwoFactorFixture(t)
+	res, ok, err := f.s.loginChallenge("user-1", false)
+	if err != nil || !ok {
+		t.Fatalf("loginChallenge: ok = %v, err = %v", ok, err)
+	}
+
+	for i := 0; i < maxCodeAttempts; i++ {
+		_, err := f.s.VerifyLoginChallenge(context.Background(), &pbu.LoginChallengeReq{Challenge: res.Challenge, Code: "000000"})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("attempt %d: %v", i, err)
+		}
+	}
+	// out of attempts, even a good code does not pass
+	_, err = f.s.VerifyLoginChallenge(context.Background(), &pbu.LoginChallengeReq{Challenge: res.Challenge, Code: f.codes[0]})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("after the last attempt: %v", err)
+	}
+	if _, err := f.tf.GetChallenge(etc.HashCode(res.Challenge)); err == nil {
+		t.Fatal("the spent challenge was kept")
+	}
+}
+
+func TestNormalizeRecoveryCode(t *testing.T) {
+	tests := map[string]string{
+		"k3j9a-x2mq7":   "k3j9ax2mq7",
+		"K3J9A-X2MQ7":   "k3j9ax2mq7",
+		" k3j9ax2mq7\n": "k3j9ax2mq7",
+		"k3j9a--x2mq7":  "k3j9ax2mq7",
+	}
+	for in, want := range tests {
+		if got := normalizeRecoveryCode(in); got != want {
+			t.Errorf("normalizeRecoveryCode(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
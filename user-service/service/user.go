@@ -2,94 +2,487 @@ package service
 
 import (
 	"context"
+	"crypto/subtle"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"user-service/config"
+	"user-service/pkg/etc"
+	"user-service/pkg/hash"
 	l "user-service/pkg/logger"
+	"user-service/pkg/mailer"
+	"user-service/pkg/query"
 
 	// pbp "user-service/protos/post-service"
 	pbu "user-service/protos/user-service"
 	"user-service/storage"
+	"user-service/storage/repo"
 
 	grpcClient "user-service/service/grpc_client"
 
+	"shared/auth"
+
 	"github.com/jmoiron/sqlx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// maxCodeAttempts is how many wrong verification codes a registration survives
+const maxCodeAttempts = 5
+
 // UserService ...
 type UserService struct {
 	pbu.UnimplementedUserServiceServer
 	storage storage.IStorage
 	logger  l.Logger
 	client  grpcClient.IServiceManager
+	cfg     config.Config
+	mailer  mailer.Mailer
+	hasher  hash.PasswordHasher
+
+	suggestions *suggestionCache
 }
 
 // NewUserService ...
-func NewUserService(db *sqlx.DB, log l.Logger, client grpcClient.IServiceManager) *UserService {
+func NewUserService(db *sqlx.DB, log l.Logger, client grpcClient.IServiceManager, cfg config.Config, mail mailer.Mailer) *UserService {
 	return &UserService{
 		storage: storage.NewStoragePg(db),
 		logger:  log,
 		client:  client,
+		cfg:     cfg,
+		mailer:  mail,
+		hasher: hash.NewArgon2id(hash.Params{
+			Memory:      uint32(cfg.Argon2Memory),
+			Iterations:  uint32(cfg.Argon2Iterations),
+			Parallelism: uint8(cfg.Argon2Parallelism),
+			SaltLength:  16,
+			KeyLength:   32,
+		}),
+		suggestions: newSuggestionCache(time.Duration(cfg.SuggestionCacheTTL) * time.Minute),
+	}
+}
+
+func (s *UserService) Register(ctx context.Context, req *pbu.CreateUserReq) (*pbu.RegisterRes, error) {
+	if req.Email == "" || req.UserName == "" || req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "email, user_name and password are required")
+	}
+	if err := s.checkAvailable(req.Email, req.UserName); err != nil {
+		return nil, err
+	}
+
+	user := newUser(req)
+	if err := normalizeProfile(user); err != nil {
+		return nil, err
+	}
+
+	hashed, err := s.hasher.Hash(req.Password)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, "failed to hash password")
+	}
+	user.Password = hashed
+	code, err := etc.GenerateCode(6)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, "failed to generate code")
+	}
+
+	err = s.storage.User().SaveRegistration(&repo.Registration{
+		User:      user,
+		CodeHash:  etc.HashCode(code),
+		ExpiresAt: time.Now().Add(time.Minute * time.Duration(s.cfg.RegistrationCodeTTL)),
+	})
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	body := fmt.Sprintf("Your verification code is %s. It expires in %d minutes.", code, s.cfg.RegistrationCodeTTL)
+	if err := s.mailer.Send(user.Email, "Confirm your email", body); err != nil {
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Unavailable, "failed to send verification code")
+	}
+
+	user.Password = ""
+	return &pbu.RegisterRes{User: user}, nil
+}
+
+func (s *UserService) Authorization(ctx context.Context, req *pbu.AuthUser) (*pbu.AuthRes, error) {
+	reg, err := s.storage.User().GetRegistration(req.Email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "no pending registration for this email")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	if time.Now().After(reg.ExpiresAt) || reg.Attempts >= maxCodeAttempts {
+		if err := s.storage.User().DeleteRegistration(req.Email); err != nil {
+			s.logger.Error(err.Error())
+		}
+		return nil, status.Error(codes.DeadlineExceeded, "verification code expired, register again")
+	}
+	if subtle.ConstantTimeCompare([]byte(reg.CodeHash), []byte(etc.HashCode(req.Code))) != 1 {
+		if err := s.storage.User().IncrRegistrationAttempts(req.Email); err != nil {
+			s.logger.Error(err.Error())
+		}
+		return nil, status.Error(codes.InvalidArgument, "invalid verification code")
+	}
+
+	// someone may have taken the username while the code was pending
+	if err := s.checkAvailable(reg.User.Email, reg.User.UserName); err != nil {
+		return nil, err
+	}
+	user, err := s.storage.User().Create(reg.User)
+	if errors.Is(err, repo.ErrTaken) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if err := s.storage.User().DeleteRegistration(req.Email); err != nil {
+		s.logger.Error(err.Error())
+	}
+
+	user.Password = ""
+	return &pbu.AuthRes{User: user}, nil
+}
+
+func (s *UserService) Login(ctx context.Context, req *pbu.LoginUserReq) (*pbu.AuthRes, error) {
+	if req.ClientIp != "" {
+		if err := s.checkLocked(ipAttemptKey(req.ClientIp)); err != nil {
+			return nil, err
+		}
+	}
+	failed := func(userId string) error {
+		if req.ClientIp != "" {
+			s.recordFailure(ipAttemptKey(req.ClientIp), s.cfg.LoginMaxFailuresPerIP)
+		}
+		if userId != "" {
+			s.recordFailure(userAttemptKey(userId), s.cfg.LoginMaxFailures)
+		}
+		return status.Error(codes.Unauthenticated, "invalid credentials")
+	}
+
+	lookup := &pbu.GetUserReq{Field: "email", Value: req.Email}
+	if req.Email == "" {
+		lookup = &pbu.GetUserReq{Field: "username", Value: req.UserName}
+	}
+
+	user, err := s.storage.User().Get(lookup)
+	restoring := false
+	if errors.Is(err, sql.ErrNoRows) {
+		// logging in within the restore window brings a deleted account back
+		user, err = s.storage.User().GetRestorable(lookup)
+		restoring = err == nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, failed("")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if err := s.checkLocked(userAttemptKey(user.Id)); err != nil {
+		return nil, err
+	}
+
+	ok, rehash, err := s.hasher.Verify(req.Password, user.Password)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, failed(user.Id)
+	}
+	if !ok {
+		return nil, failed(user.Id)
+	}
+	if err := s.storage.LoginAttempt().Delete(userAttemptKey(user.Id)); err != nil {
+		s.logger.Error(err.Error())
+	}
+	if rehash {
+		// a failed upgrade must not fail the login, it is retried next time
+		if hashed, err := s.hasher.Hash(req.Password); err != nil {
+			s.logger.Error(err.Error())
+		} else if _, err := s.storage.User().UpdatePassword(user.Id, hashed); err != nil {
+			s.logger.Error(err.Error())
+		}
+	}
+
+	// with two-factor authentication on the account is only restored once
+	// the second step succeeds
+	challenge, ok, err := s.loginChallenge(user.Id, restoring)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return challenge, nil
+	}
+	if restoring {
+		restored, err := s.restoreUser(user.Id)
+		if err != nil {
+			return nil, err
+		}
+		if !restored {
+			// the purge job got to it first
+			return nil, failed("")
+		}
 	}
+
+	user.Password = ""
+	return &pbu.AuthRes{User: user, Restored: restoring}, nil
 }
 
-func (s *UserService) GetUserByEmail(ctx context.Context, email *pbu.ByEmail) (*pbu.User, error) {
-	user, err := s.storage.User().GetUserByEmail(email)
+func (s *UserService) UpdatePassword(ctx context.Context, req *pbu.UpdatePasswordReq) (*pbu.User, error) {
+	user, err := s.storage.User().Get(&pbu.GetUserReq{Field: "email", Value: req.Email})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
+	ok, _, err := s.hasher.Verify(req.OldPassword, user.Password)
+	if err != nil {
+		s.logger.Error(err.Error())
+	}
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "old password is incorrect")
+	}
+
+	hashed, err := s.hasher.Hash(req.NewPassword)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, "failed to hash password")
+	}
+	user, err = s.storage.User().UpdatePassword(user.Id, hashed)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	user.Password = ""
 	return user, nil
 }
 
-func (s *UserService) CheckUniques(ctx context.Context, req *pbu.CheckUniquesRequest) (*pbu.CheckUniquesResponse, error) {
+func (s *UserService) CheckUniques(ctx context.Context, req *pbu.CheckUniqReq) (*pbu.CheckUniqResp, error) {
 	check, err := s.storage.User().CheckUniques(req)
+	if errors.Is(err, query.ErrUnknownField) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
+		s.logger.Error(err.Error())
 		return nil, err
 	}
 
 	return check, nil
 }
 
-func (s *UserService) Create(ctx context.Context, req *pbu.User) (*pbu.User, error) {
-	user, err := s.storage.User().Create(req)
+func (s *UserService) Create(ctx context.Context, req *pbu.CreateUserReq) (*pbu.User, error) {
+	if err := s.checkAvailable(req.Email, req.UserName); err != nil {
+		return nil, err
+	}
+	user := newUser(req)
+	if err := normalizeProfile(user); err != nil {
+		return nil, err
+	}
+	hashed, err := s.hasher.Hash(req.Password)
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, status.Error(codes.Internal, "failed to hash password")
+	}
+	user.Password = hashed
+
+	user, err = s.storage.User().Create(user)
+	if errors.Is(err, repo.ErrTaken) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
+
+	user.Password = ""
 	return user, nil
 }
 
-func (s *UserService) Update(ctx context.Context, req *pbu.User) (*pbu.User, error) {
-	user, err := s.storage.User().Update(req)
+func (s *UserService) Update(ctx context.Context, req *pbu.UpdateUserReq) (*pbu.User, error) {
+	if err := auth.CanModify(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	user, err := s.storage.User().Get(&pbu.GetUserReq{Field: "id", Value: req.Id})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
+
+	if req.UserName != "" && req.UserName != user.UserName {
+		if err := s.checkAvailable("", req.UserName); err != nil {
+			return nil, err
+		}
+		user.UserName = req.UserName
+	}
+	if req.FirstName != "" {
+		user.FirstName = req.FirstName
+	}
+	if req.LastName != "" {
+		user.LastName = req.LastName
+	}
+	if req.BirthDate != "" {
+		user.BirthDate = req.BirthDate
+	}
+	if req.Biography != "" {
+		user.Biography = req.Biography
+	}
+	if req.Gender != "" {
+		user.Gender = req.Gender
+	}
+	if req.ProfilePicture != "" {
+		user.ProfilePicture = req.ProfilePicture
+	}
+	if req.PhoneNumber != "" {
+		user.PhoneNumber = req.PhoneNumber
+	}
+	if err := normalizeProfile(user); err != nil {
+		return nil, err
+	}
+
+	user, err = s.storage.User().Update(user)
+	if errors.Is(err, repo.ErrTaken) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+
+	user.Password = ""
 	return user, nil
 }
 
-func (s *UserService) Delete(ctx context.Context, req *pbu.UserRequest) (*pbu.CheckUniquesResponse, error) {
-	user, err := s.storage.User().Delete(req)
+// Delete starts the restore window of the account, or removes it right away
+// when is_hard_delete is set
+func (s *UserService) Delete(ctx context.Context, req *pbu.DeleteUserReq) (*pbu.Status, error) {
+	hard := false
+	if req.IsHardDelete != "" {
+		var err error
+		if hard, err = strconv.ParseBool(req.IsHardDelete); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "is_hard_delete must be true or false")
+		}
+	}
+
+	user, err := s.storage.User().Get(&pbu.GetUserReq{Field: req.Field, Value: req.Value})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	if err != nil {
+		s.logger.Error(err.Error())
+		return nil, err
+	}
+	if err := auth.CanModify(ctx, user.Id); err != nil {
+		return nil, err
+	}
+
+	window := time.Duration(s.cfg.AccountRestoreDays) * 24 * time.Hour
+	if hard {
+		window = 0
+	}
+	res, err := s.storage.User().Delete(&pbu.DeleteUserReq{Field: "id", Value: user.Id}, window)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
-	return user, nil
+	if err := s.storage.RefreshToken().RevokeUser(user.Id); err != nil {
+		s.logger.Error(err.Error())
+	}
+	if !hard {
+		return res, nil
+	}
+
+	if err := s.purgeUser(ctx, user.Id); err != nil {
+		// the purge job picks the account up on its next run
+		s.logger.Error("failed to purge user", l.String("user_id", user.Id), l.Error(err))
+		return &pbu.Status{Message: "user deleted, removing their posts and comments is pending"}, nil
+	}
+	return &pbu.Status{Message: "user deleted permanently"}, nil
 }
 
-func (s *UserService) Get(ctx context.Context, req *pbu.UserRequest) (*pbu.User, error) {
+func (s *UserService) Get(ctx context.Context, req *pbu.GetUserReq) (*pbu.User, error) {
 	user, err := s.storage.User().Get(req)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
+
+	user.Password = ""
 	return user, nil
 }
 
-func (s *UserService) GetAll(ctx context.Context, req *pbu.GetAllUsersRequest) (*pbu.GetAllUsersResponse, error) {
-	users, err := s.storage.User().GetAll(req)
+func (s *UserService) GetAll(ctx context.Context, req *pbu.GetAllUsersReq) (*pbu.GetAllUsersRes, error) {
+	filter, err := userFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := s.storage.User().GetAll(filter)
+	if errors.Is(err, query.ErrUnknownField) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		s.logger.Error(err.Error())
 		return nil, err
 	}
 
+	for _, user := range users.AllUsers {
+		user.Password = ""
+	}
 	return users, nil
 }
+
+// newUser copies the fields of a signup request, the password is left for
+// the caller to hash
+func newUser(req *pbu.CreateUserReq) *pbu.User {
+	return &pbu.User{
+		UserName:       req.UserName,
+		FirstName:      req.FirstName,
+		LastName:       req.LastName,
+		Email:          req.Email,
+		BirthDate:      req.BirthDate,
+		Biography:      req.Biography,
+		Gender:         req.Gender,
+		ProfilePicture: req.ProfilePicture,
+		PhoneNumber:    req.PhoneNumber,
+	}
+}
+
+// checkAvailable fails with AlreadyExists when the email or username is taken,
+// empty values are not checked
+func (s *UserService) checkAvailable(email, username string) error {
+	for field, value := range map[string]string{"email": email, "username": username} {
+		if value == "" {
+			continue
+		}
+		uniq, err := s.storage.User().CheckUniques(&pbu.CheckUniqReq{Field: field, Value: value})
+		if err != nil {
+			s.logger.Error(err.Error())
+			return err
+		}
+		if !uniq.IsUnique {
+			return status.Errorf(codes.AlreadyExists, "%s is already in use", field)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"user-service/config"
+	"user-service/pkg/etc"
+	pbu "user-service/protos/user-service"
+	"user-service/storage/repo"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var mailedCode = regexp.MustCompile(`\b\d{6}\b`)
+
+func register(t *testing.T, s *UserService, mail *fakeMailer, email string) string {
+	t.Helper()
+	res, err := s.Register(context.Background(), &pbu.CreateUserReq{
+		FirstName: "Ann",
+		Email:     email,
+		UserName:  "ann",
+		Password:  "correct horse",
+	})
+	if err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	if res.User.Password != "" {
+		t.Fatal("Register returned the password hash")
+	}
+	code := mailedCode.FindString(mail.sent[len(mail.sent)-1].body)
+	if code == "" {
+		t.Fatal("no code in the verification mail")
+	}
+	return code
+}
+
+func TestRegisterStoresOnlyCodeHash(t *testing.T) {
+	users := newFakeUserRepo()
+	mail := &fakeMailer{}
+	s := newTestService(&fakeStorage{user: users}, mail, config.Config{RegistrationCodeTTL: 10})
+
+	code := register(t, s, mail, "ann@example.com")
+
+	reg := users.registrations["ann@example.com"]
+	if reg.CodeHash == code {
+		t.Fatal("registration keeps the plaintext code")
+	}
+	if reg.CodeHash != etc.HashCode(code) {
+		t.Fatalf("CodeHash = %q, want the hash of the mailed code", reg.CodeHash)
+	}
+}
+
+func TestAuthorization(t *testing.T) {
+	tests := []struct {
+		name      string
+		code      func(mailed string) string
+		createErr error
+		want      codes.Code
+	}{
+		{"mailed code", func(c string) string { return c }, nil, codes.OK},
+		{"wrong code", func(string) string { return "000000x" }, nil, codes.InvalidArgument},
+		{"code hash", func(c string) string { return etc.HashCode(c) }, nil, codes.InvalidArgument},
+		{"taken by a concurrent signup", func(c string) string { return c }, repo.ErrTaken, codes.AlreadyExists},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := newFakeUserRepo()
+			mail := &fakeMailer{}
+			s := newTestService(&fakeStorage{user: users}, mail, config.Config{RegistrationCodeTTL: 10})
+			mailed := register(t, s, mail, "ann@example.com")
+			users.createErr = tt.createErr
+
+			res, err := s.Authorization(context.Background(), &pbu.AuthUser{
+				Email: "ann@example.com",
+				Code:  tt.code(mailed),
+			})
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("Authorization code = %v, want %v (%v)", got, tt.want, err)
+			}
+			if tt.want == codes.OK && res.User.Email != "ann@example.com" {
+				t.Fatalf("created user %+v", res.User)
+			}
+		})
+	}
+}
+
+func TestAuthorizationUnknownEmail(t *testing.T) {
+	s := newTestService(&fakeStorage{user: newFakeUserRepo()}, &fakeMailer{}, config.Config{})
+	_, err := s.Authorization(context.Background(), &pbu.AuthUser{Email: "nobody@example.com", Code: "123456"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("got %v, want NotFound", err)
+	}
+}
+
+func TestLoginUpgradesOutdatedHash(t *testing.T) {
+	bcrypted, err := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name        string
+		password    string
+		wantUpgrade bool
+	}{
+		{"right password", testPassword, true},
+		{"wrong password", "wrong", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := newFakeUserRepo()
+			users.users["user-1"] = &pbu.User{Id: "user-1", Email: "ann@example.com", UserName: "ann", Password: string(bcrypted)}
+			s := newTestService(&fakeStorage{
+				user:         users,
+				twoFactor:    newFakeTwoFactorRepo(),
+				loginAttempt: newFakeLoginAttemptRepo(),
+			}, &fakeMailer{}, config.Config{LoginMaxFailures: 10, LoginMaxFailuresPerIP: 10, LoginFreeAttempts: 10})
+
+			_, err := s.Login(context.Background(), &pbu.LoginUserReq{Email: "ann@example.com", Password: tt.password})
+			if (err == nil) != tt.wantUpgrade {
+				t.Fatalf("Login error = %v", err)
+			}
+
+			stored := users.users["user-1"].Password
+			if upgraded := strings.HasPrefix(stored, "$argon2id$"); upgraded != tt.wantUpgrade {
+				t.Fatalf("stored hash %q, want upgraded %v", stored, tt.wantUpgrade)
+			}
+			if ok, rehash, err := s.hasher.Verify(testPassword, stored); err != nil || !ok || rehash != !tt.wantUpgrade {
+				t.Errorf("Verify(stored) = %v, %v, %v", ok, rehash, err)
+			}
+		})
+	}
+}
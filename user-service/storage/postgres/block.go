@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type blockRepo struct {
+	db *sqlx.DB
+}
+
+// NewBlockRepo ...
+func NewBlockRepo(db *sqlx.DB) *blockRepo {
+	return &blockRepo{db: db}
+}
+
+// Block stores the block and drops the follows and follow requests in both
+// directions in one transaction. It returns false when the block already existed.
+func (r *blockRepo) Block(blockerId, blockedId string) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`
+	INSERT INTO blocks (
+		blocker_id,
+		blocked_id
+	)
+	VALUES ($1, $2)
+	ON CONFLICT DO NOTHING
+	`, blockerId, blockedId)
+	if err != nil {
+		return false, err
+	}
+	created, err := affected(res)
+	if err != nil {
+		return false, err
+	}
+
+	for _, table := range []string{"follows", "follow_requests"} {
+		_, err = tx.Exec(`
+		DELETE FROM
+			`+table+`
+		WHERE
+			(follower_id = $1 AND followee_id = $2)
+		OR
+			(follower_id = $2 AND followee_id = $1)
+		`, blockerId, blockedId)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return created, tx.Commit()
+}
+
+// Unblock returns false when there was nothing to remove
+func (r *blockRepo) Unblock(blockerId, blockedId string) (bool, error) {
+	res, err := r.db.Exec(`
+	DELETE FROM
+		blocks
+	WHERE
+		blocker_id = $1
+	AND
+		blocked_id = $2
+	`, blockerId, blockedId)
+	if err != nil {
+		return false, err
+	}
+	return affected(res)
+}
+
+func (r *blockRepo) IsBlocked(blockerId, blockedId string) (bool, error) {
+	query := `
+	SELECT EXISTS (
+		SELECT 1 FROM blocks WHERE blocker_id = $1 AND blocked_id = $2
+	)
+	`
+	var blocked bool
+	if err := r.db.QueryRow(query, blockerId, blockedId).Scan(&blocked); err != nil {
+		return false, err
+	}
+	return blocked, nil
+}
+
+// Mute returns false when the mute already existed
+func (r *blockRepo) Mute(muterId, mutedId string) (bool, error) {
+	res, err := r.db.Exec(`
+	INSERT INTO mutes (
+		muter_id,
+		muted_id
+	)
+	VALUES ($1, $2)
+	ON CONFLICT DO NOTHING
+	`, muterId, mutedId)
+	if err != nil {
+		return false, err
+	}
+	return affected(res)
+}
+
+// Unmute returns false when there was nothing to remove
+func (r *blockRepo) Unmute(muterId, mutedId string) (bool, error) {
+	res, err := r.db.Exec(`
+	DELETE FROM
+		mutes
+	WHERE
+		muter_id = $1
+	AND
+		muted_id = $2
+	`, muterId, mutedId)
+	if err != nil {
+		return false, err
+	}
+	return affected(res)
+}
+
+// Blocked returns the users userId blocked together with the users that
+// blocked userId
+func (r *blockRepo) Blocked(userId string) ([]string, error) {
+	query := `
+	SELECT blocked_id FROM blocks WHERE blocker_id = $1
+	UNION
+	SELECT blocker_id FROM blocks WHERE blocked_id = $1
+	`
+	var ids []string
+	if err := r.db.Select(&ids, query, userId); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Blocking returns only the users userId blocked
+func (r *blockRepo) Blocking(userId string) ([]string, error) {
+	query := `
+	SELECT blocked_id FROM blocks WHERE blocker_id = $1
+	`
+	var ids []string
+	if err := r.db.Select(&ids, query, userId); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *blockRepo) Muted(userId string) ([]string, error) {
+	query := `
+	SELECT muted_id FROM mutes WHERE muter_id = $1
+	`
+	var ids []string
+	if err := r.db.Select(&ids, query, userId); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func affected(res sql.Result) (bool, error) {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
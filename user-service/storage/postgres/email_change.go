@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"user-service/storage/repo"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type emailChangeRepo struct {
+	db *sqlx.DB
+}
+
+// NewEmailChangeRepo ...
+func NewEmailChangeRepo(db *sqlx.DB) *emailChangeRepo {
+	return &emailChangeRepo{db: db}
+}
+
+const emailChangeColumns = `
+	user_id,
+	new_email,
+	old_code_hash,
+	new_code_hash,
+	old_confirmed,
+	new_confirmed,
+	attempts,
+	expires_at
+`
+
+func scanEmailChange(row rowScanner) (*repo.EmailChange, error) {
+	var change repo.EmailChange
+	if err := row.Scan(
+		&change.UserId,
+		&change.NewEmail,
+		&change.OldCodeHash,
+		&change.NewCodeHash,
+		&change.OldConfirmed,
+		&change.NewConfirmed,
+		&change.Attempts,
+		&change.ExpiresAt,
+	); err != nil {
+		return nil, err
+	}
+	return &change, nil
+}
+
+// Save replaces any previous change of the user
+func (r *emailChangeRepo) Save(change *repo.EmailChange) error {
+	query := `
+	INSERT INTO email_changes (
+		user_id,
+		new_email,
+		old_code_hash,
+		new_code_hash,
+		expires_at
+	)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (user_id) DO UPDATE SET
+		new_email = EXCLUDED.new_email,
+		old_code_hash = EXCLUDED.old_code_hash,
+		new_code_hash = EXCLUDED.new_code_hash,
+		old_confirmed = FALSE,
+		new_confirmed = FALSE,
+		attempts = 0,
+		expires_at = EXCLUDED.expires_at,
+		created_at = CURRENT_TIMESTAMP
+	`
+	_, err := r.db.Exec(query, change.UserId, change.NewEmail, change.OldCodeHash, change.NewCodeHash, change.ExpiresAt)
+	return err
+}
+
+func (r *emailChangeRepo) Get(userId string) (*repo.EmailChange, error) {
+	query := `
+	SELECT` + emailChangeColumns + `
+	FROM
+		email_changes
+	WHERE
+		user_id = $1
+	`
+	return scanEmailChange(r.db.QueryRow(query, userId))
+}
+
+func (r *emailChangeRepo) IncrAttempts(userId string) error {
+	query := `
+	UPDATE
+		email_changes
+	SET
+		attempts = attempts + 1
+	WHERE
+		user_id = $1
+	`
+	_, err := r.db.Exec(query, userId)
+	return err
+}
+
+func (r *emailChangeRepo) Confirm(userId, codeHash string) (*repo.EmailChange, error) {
+	query := `
+	UPDATE
+		email_changes
+	SET
+		old_confirmed = old_confirmed OR old_code_hash = $2,
+		new_confirmed = new_confirmed OR new_code_hash = $2
+	WHERE
+		user_id = $1
+	AND
+		(old_code_hash = $2 OR new_code_hash = $2)
+	AND
+		expires_at > CURRENT_TIMESTAMP
+	RETURNING` + emailChangeColumns
+	return scanEmailChange(r.db.QueryRow(query, userId, codeHash))
+}
+
+func (r *emailChangeRepo) Complete(userId string) (*repo.EmailChange, error) {
+	query := `
+	DELETE FROM
+		email_changes
+	WHERE
+		user_id = $1
+	AND
+		old_confirmed
+	AND
+		new_confirmed
+	RETURNING` + emailChangeColumns
+	return scanEmailChange(r.db.QueryRow(query, userId))
+}
@@ -0,0 +1,325 @@
+package postgres
+
+import (
+	"fmt"
+	"time"
+
+	pbu "user-service/protos/user-service"
+	"user-service/storage/repo"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type followRepo struct {
+	db *sqlx.DB
+}
+
+// NewFollowRepo ...
+func NewFollowRepo(db *sqlx.DB) *followRepo {
+	return &followRepo{db: db}
+}
+
+// Follow returns false when the follow already existed
+func (r *followRepo) Follow(followerId, followeeId string) (bool, error) {
+	query := `
+	INSERT INTO follows (
+		follower_id,
+		followee_id
+	)
+	VALUES ($1, $2)
+	ON CONFLICT DO NOTHING
+	`
+	res, err := r.db.Exec(query, followerId, followeeId)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// Unfollow returns false when there was nothing to remove
+func (r *followRepo) Unfollow(followerId, followeeId string) (bool, error) {
+	query := `
+	DELETE FROM
+		follows
+	WHERE
+		follower_id = $1
+	AND
+		followee_id = $2
+	`
+	res, err := r.db.Exec(query, followerId, followeeId)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *followRepo) IsFollowing(followerId, followeeId string) (bool, error) {
+	query := `
+	SELECT EXISTS (
+		SELECT 1 FROM follows WHERE follower_id = $1 AND followee_id = $2
+	)
+	`
+	var following bool
+	if err := r.db.QueryRow(query, followerId, followeeId).Scan(&following); err != nil {
+		return false, err
+	}
+	return following, nil
+}
+
+func (r *followRepo) ListFollowers(userId string, after *repo.Cursor, limit int64) ([]*repo.FollowEdge, error) {
+	return r.list("follows", "followee_id", "follower_id", userId, after, limit)
+}
+
+func (r *followRepo) ListFollowing(userId string, after *repo.Cursor, limit int64) ([]*repo.FollowEdge, error) {
+	return r.list("follows", "follower_id", "followee_id", userId, after, limit)
+}
+
+// RequestFollow returns false when the request was already pending
+func (r *followRepo) RequestFollow(followerId, followeeId string) (bool, error) {
+	res, err := r.db.Exec(`
+	INSERT INTO follow_requests (
+		follower_id,
+		followee_id
+	)
+	VALUES ($1, $2)
+	ON CONFLICT DO NOTHING
+	`, followerId, followeeId)
+	if err != nil {
+		return false, err
+	}
+	return affected(res)
+}
+
+// ApproveRequest moves a pending request to follows, it returns false when
+// there was no such request
+func (r *followRepo) ApproveRequest(followerId, followeeId string) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`
+	DELETE FROM
+		follow_requests
+	WHERE
+		follower_id = $1
+	AND
+		followee_id = $2
+	`, followerId, followeeId)
+	if err != nil {
+		return false, err
+	}
+	if found, err := affected(res); err != nil || !found {
+		return false, err
+	}
+
+	_, err = tx.Exec(`
+	INSERT INTO follows (
+		follower_id,
+		followee_id
+	)
+	VALUES ($1, $2)
+	ON CONFLICT DO NOTHING
+	`, followerId, followeeId)
+	if err != nil {
+		return false, err
+	}
+	return true, tx.Commit()
+}
+
+// DeleteRequest rejects or cancels a pending request
+func (r *followRepo) DeleteRequest(followerId, followeeId string) (bool, error) {
+	res, err := r.db.Exec(`
+	DELETE FROM
+		follow_requests
+	WHERE
+		follower_id = $1
+	AND
+		followee_id = $2
+	`, followerId, followeeId)
+	if err != nil {
+		return false, err
+	}
+	return affected(res)
+}
+
+// ListRequests pages through the pending requests to follow userId
+func (r *followRepo) ListRequests(userId string, after *repo.Cursor, limit int64) ([]*repo.FollowEdge, error) {
+	return r.list("follow_requests", "followee_id", "follower_id", userId, after, limit)
+}
+
+// VisibleOwners returns the owners out of ownerIds whose content the viewer
+// may see: public accounts, the viewer itself and private accounts the
+// viewer follows
+func (r *followRepo) VisibleOwners(viewerId string, ownerIds []string) ([]string, error) {
+	query := `
+	SELECT
+		u.id
+	FROM
+		users u
+	WHERE
+		u.id = ANY($1::UUID[])
+	AND (
+		NOT u.is_private
+		OR u.id::TEXT = $2
+		OR EXISTS (
+			SELECT 1 FROM follows f WHERE f.followee_id = u.id AND f.follower_id::TEXT = $2
+		)
+	)
+	`
+	var ids []string
+	if err := r.db.Select(&ids, query, pq.Array(ownerIds), viewerId); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// FollowingIds returns everyone userId follows or asked to follow
+func (r *followRepo) FollowingIds(userId string) ([]string, error) {
+	query := `
+	SELECT followee_id FROM follows WHERE follower_id = $1
+	UNION
+	SELECT followee_id FROM follow_requests WHERE follower_id = $1
+	`
+	var ids []string
+	if err := r.db.Select(&ids, query, userId); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *followRepo) FollowerIds(userId string, limit int64) ([]string, error) {
+	query := `
+	SELECT follower_id FROM follows WHERE followee_id = $1 LIMIT NULLIF($2, 0)
+	`
+	var ids []string
+	if err := r.db.Select(&ids, query, userId, limit); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func (r *followRepo) FolloweeIds(userId string) ([]string, error) {
+	query := `
+	SELECT followee_id FROM follows WHERE follower_id = $1
+	`
+	var ids []string
+	if err := r.db.Select(&ids, query, userId); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// FriendsOfFriends returns the users followed by the users userId follows,
+// scored by how many of them follow each one. Users userId already follows
+// are left out.
+func (r *followRepo) FriendsOfFriends(userId string, limit int64) ([]*repo.UserScore, error) {
+	query := `
+	SELECT
+		f2.followee_id,
+		COUNT(*) AS mutual
+	FROM
+		follows f1
+	JOIN
+		follows f2 ON f2.follower_id = f1.followee_id
+	WHERE
+		f1.follower_id = $1
+	AND
+		f2.followee_id <> $1
+	AND NOT EXISTS (
+		SELECT 1 FROM follows f3 WHERE f3.follower_id = $1 AND f3.followee_id = f2.followee_id
+	)
+	GROUP BY
+		f2.followee_id
+	ORDER BY
+		mutual DESC
+	LIMIT $2
+	`
+	rows, err := r.db.Query(query, userId, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var scores []*repo.UserScore
+	for rows.Next() {
+		var score repo.UserScore
+		if err := rows.Scan(&score.UserId, &score.Score); err != nil {
+			return nil, err
+		}
+		scores = append(scores, &score)
+	}
+	return scores, rows.Err()
+}
+
+// list pages through the users on the other side of the rows of table for
+// userId, by is the column holding userId and other the one joined with users
+func (r *followRepo) list(table, by, other, userId string, after *repo.Cursor, limit int64) ([]*repo.FollowEdge, error) {
+	args := []interface{}{userId, limit}
+	keyset := ""
+	if after != nil {
+		args = append(args, after.CreatedAt, after.Id)
+		keyset = fmt.Sprintf("AND (f.created_at, f.%s) < ($3, $4)", other)
+	}
+
+	query := fmt.Sprintf(`
+	SELECT
+		u.id,
+		u.name,
+		COALESCE(u.last_name, ''),
+		u.username,
+		u.created_at,
+		f.created_at
+	FROM
+		%[4]s f
+	JOIN
+		users u ON u.id = f.%[2]s
+	WHERE
+		f.%[1]s = $1
+	AND
+		u.deleted_at IS NULL
+	%[3]s
+	ORDER BY
+		f.created_at DESC,
+		f.%[2]s DESC
+	LIMIT $2
+	`, by, other, keyset, table)
+
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var edges []*repo.FollowEdge
+	for rows.Next() {
+		var (
+			edge       repo.FollowEdge
+			followedAt time.Time
+		)
+		edge.User = new(pbu.User)
+		if err := rows.Scan(
+			&edge.User.Id,
+			&edge.User.FirstName,
+			&edge.User.LastName,
+			&edge.User.UserName,
+			&edge.User.CreatedAt,
+			&followedAt,
+		); err != nil {
+			return nil, err
+		}
+		edge.FollowedAt = followedAt
+		edges = append(edges, &edge)
+	}
+	return edges, rows.Err()
+}
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"user-service/storage/repo"
+
+	"shared/fakedb"
+)
+
+func TestListFollowersWithoutLastName(t *testing.T) {
+	followedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	db, fake := newFakeDB(t, fakedb.Result{
+		Columns: []string{"id", "name", "last_name", "username", "created_at", "followed_at"},
+		Rows: [][]driver.Value{
+			{"u2", "Bob", "", "bob", "2024-01-01T00:00:00Z", followedAt},
+			{"u3", "Cid", "Doe", "cid", "2024-01-02T00:00:00Z", followedAt.Add(-time.Hour)},
+		},
+	})
+
+	edges, err := NewFollowRepo(db).ListFollowers("u1", nil, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(edges) != 2 || edges[0].User.LastName != "" || edges[1].User.LastName != "Doe" {
+		t.Fatalf("edges = %+v", edges)
+	}
+	if !edges[0].FollowedAt.Equal(followedAt) {
+		t.Fatalf("followed at %v, want %v", edges[0].FollowedAt, followedAt)
+	}
+	// Postgres sends NULL for a missing last name, which a string can not hold
+	if !strings.Contains(fake.Queries[0], "COALESCE(u.last_name, '')") {
+		t.Fatalf("last_name is not coalesced:\n%s", fake.Queries[0])
+	}
+}
+
+func TestListFollowersAfterCursor(t *testing.T) {
+	db, fake := newFakeDB(t, fakedb.Result{
+		Columns: []string{"id", "name", "last_name", "username", "created_at", "followed_at"},
+	})
+	after := &repo.Cursor{CreatedAt: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), Id: "u2"}
+
+	if _, err := NewFollowRepo(db).ListFollowers("u1", after, 20); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(fake.Queries[0], "AND (f.created_at, f.follower_id) < ($3, $4)") {
+		t.Errorf("no keyset condition on the follower:\n%s", fake.Queries[0])
+	}
+	want := []driver.Value{"u1", int64(20), after.CreatedAt, "u2"}
+	if !reflect.DeepEqual(fake.Args[0], want) {
+		t.Errorf("args = %v, want %v", fake.Args[0], want)
+	}
+}
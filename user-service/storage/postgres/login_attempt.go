@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"time"
+
+	"user-service/storage/repo"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type loginAttemptRepo struct {
+	db *sqlx.DB
+}
+
+// NewLoginAttemptRepo ...
+func NewLoginAttemptRepo(db *sqlx.DB) *loginAttemptRepo {
+	return &loginAttemptRepo{db: db}
+}
+
+func (r *loginAttemptRepo) Get(key string) (*repo.LoginAttempt, error) {
+	query := `
+	SELECT
+		key,
+		failures,
+		last_failure_at,
+		locked_until
+	FROM
+		login_attempts
+	WHERE
+		key = $1
+	`
+	var attempt repo.LoginAttempt
+	if err := r.db.QueryRow(query, key).Scan(
+		&attempt.Key,
+		&attempt.Failures,
+		&attempt.LastFailureAt,
+		&attempt.LockedUntil,
+	); err != nil {
+		return nil, err
+	}
+
+	return &attempt, nil
+}
+
+// RecordFailure counts a failed login and returns the failures so far, the
+// count starts over when the previous failure is older than window
+func (r *loginAttemptRepo) RecordFailure(key string, window time.Duration) (int, error) {
+	query := `
+	INSERT INTO login_attempts (
+		key,
+		failures,
+		last_failure_at
+	)
+	VALUES ($1, 1, CURRENT_TIMESTAMP)
+	ON CONFLICT (key) DO UPDATE SET
+		failures = CASE
+			WHEN login_attempts.last_failure_at < CURRENT_TIMESTAMP - $2 * INTERVAL '1 second' THEN 1
+			ELSE login_attempts.failures + 1
+		END,
+		last_failure_at = CURRENT_TIMESTAMP
+	RETURNING
+		failures
+	`
+	var failures int
+	if err := r.db.QueryRow(query, key, window.Seconds()).Scan(&failures); err != nil {
+		return 0, err
+	}
+	return failures, nil
+}
+
+func (r *loginAttemptRepo) Lock(key string, until time.Time) error {
+	query := `
+	UPDATE
+		login_attempts
+	SET
+		locked_until = $2
+	WHERE
+		key = $1
+	`
+	_, err := r.db.Exec(query, key, until)
+	return err
+}
+
+func (r *loginAttemptRepo) Delete(key string) error {
+	_, err := r.db.Exec(`DELETE FROM login_attempts WHERE key = $1`, key)
+	return err
+}
@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"user-service/storage/repo"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type passwordResetRepo struct {
+	db *sqlx.DB
+}
+
+// NewPasswordResetRepo ...
+func NewPasswordResetRepo(db *sqlx.DB) *passwordResetRepo {
+	return &passwordResetRepo{db: db}
+}
+
+// Save replaces the previous code of the user. Asking for a new code does not
+// give new attempts: they only start over once the previous code expired or
+// was spent, and a code out of attempts is kept until it expires.
+func (r *passwordResetRepo) Save(reset *repo.PasswordReset, maxAttempts int, resendAfter time.Duration) (bool, error) {
+	query := `
+	INSERT INTO password_resets (
+		user_id,
+		code_hash,
+		expires_at
+	)
+	VALUES ($1, $2, $3)
+	ON CONFLICT (user_id) DO UPDATE SET
+		code_hash = EXCLUDED.code_hash,
+		attempts = CASE
+			WHEN password_resets.expires_at > CURRENT_TIMESTAMP THEN password_resets.attempts
+			ELSE 0
+		END,
+		expires_at = EXCLUDED.expires_at,
+		created_at = CURRENT_TIMESTAMP
+	WHERE
+		password_resets.expires_at <= CURRENT_TIMESTAMP
+	OR (
+		password_resets.attempts < $4
+		AND
+		password_resets.created_at <= CURRENT_TIMESTAMP - $5 * INTERVAL '1 second'
+	)
+	`
+	res, err := r.db.Exec(query, reset.UserId, reset.CodeHash, reset.ExpiresAt, maxAttempts, int64(resendAfter.Seconds()))
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// Consume spends a matching code by expiring it. The row lock of the UPDATE
+// makes concurrent requests wait and then see the new attempts and expiry.
+func (r *passwordResetRepo) Consume(userId, codeHash string, maxAttempts int) (bool, error) {
+	query := `
+	UPDATE
+		password_resets
+	SET
+		attempts = attempts + 1,
+		expires_at = CASE
+			WHEN code_hash = $2 THEN CURRENT_TIMESTAMP
+			ELSE expires_at
+		END
+	WHERE
+		user_id = $1
+	AND
+		attempts < $3
+	AND
+		expires_at > CURRENT_TIMESTAMP
+	RETURNING
+		code_hash = $2
+	`
+	var matched bool
+	err := r.db.QueryRow(query, userId, codeHash, maxAttempts).Scan(&matched)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return matched, nil
+}
@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"user-service/storage/repo"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type refreshTokenRepo struct {
+	db *sqlx.DB
+}
+
+// NewRefreshTokenRepo ...
+func NewRefreshTokenRepo(db *sqlx.DB) *refreshTokenRepo {
+	return &refreshTokenRepo{db: db}
+}
+
+func (r *refreshTokenRepo) Create(token *repo.RefreshToken) error {
+	query := `
+	INSERT INTO refresh_tokens (
+		id,
+		family_id,
+		user_id,
+		expires_at
+	)
+	VALUES ($1, $2, $3, $4)
+	`
+	_, err := r.db.Exec(query, token.Id, token.FamilyId, token.UserId, token.ExpiresAt)
+	return err
+}
+
+func (r *refreshTokenRepo) Get(id string) (*repo.RefreshToken, error) {
+	query := `
+	SELECT
+		id,
+		family_id,
+		user_id,
+		expires_at,
+		used_at,
+		revoked_at
+	FROM
+		refresh_tokens
+	WHERE
+		id = $1
+	`
+	var token repo.RefreshToken
+	if err := r.db.QueryRow(query, id).Scan(
+		&token.Id,
+		&token.FamilyId,
+		&token.UserId,
+		&token.ExpiresAt,
+		&token.UsedAt,
+		&token.RevokedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// Rotate marks the token as used and stores its successor in one transaction.
+// It reports false when the token was already used, revoked or expired.
+func (r *refreshTokenRepo) Rotate(id string, next *repo.RefreshToken) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`
+	UPDATE
+		refresh_tokens
+	SET
+		used_at = CURRENT_TIMESTAMP
+	WHERE
+		id = $1
+	AND
+		used_at IS NULL
+	AND
+		revoked_at IS NULL
+	AND
+		expires_at > CURRENT_TIMESTAMP
+	`, id)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	_, err = tx.Exec(`
+	INSERT INTO refresh_tokens (
+		id,
+		family_id,
+		user_id,
+		expires_at
+	)
+	VALUES ($1, $2, $3, $4)
+	`, next.Id, next.FamilyId, next.UserId, next.ExpiresAt)
+	if err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+func (r *refreshTokenRepo) RevokeFamily(familyId string) error {
+	query := `
+	UPDATE
+		refresh_tokens
+	SET
+		revoked_at = CURRENT_TIMESTAMP
+	WHERE
+		family_id = $1
+	AND
+		revoked_at IS NULL
+	`
+	_, err := r.db.Exec(query, familyId)
+	return err
+}
+
+func (r *refreshTokenRepo) RevokeUser(userId string) error {
+	query := `
+	UPDATE
+		refresh_tokens
+	SET
+		revoked_at = CURRENT_TIMESTAMP
+	WHERE
+		user_id = $1
+	AND
+		revoked_at IS NULL
+	`
+	_, err := r.db.Exec(query, userId)
+	return err
+}
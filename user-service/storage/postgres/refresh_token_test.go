@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"user-service/storage/repo"
+
+	"shared/fakedb"
+)
+
+func TestRotate(t *testing.T) {
+	next := &repo.RefreshToken{
+		Id:        "t2",
+		FamilyId:  "f1",
+		UserId:    "u1",
+		ExpiresAt: time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC),
+	}
+	insertErr := errors.New("duplicate key")
+	tests := []struct {
+		name    string
+		results []fakedb.Result
+		want    bool
+		wantErr error
+		queries int
+	}{
+		{
+			name:    "spends the token and stores its successor",
+			results: []fakedb.Result{{Rows: make([][]driver.Value, 1)}, {}},
+			want:    true,
+			queries: 2,
+		},
+		{
+			name:    "token not spendable",
+			results: []fakedb.Result{{}},
+			queries: 1,
+		},
+		{
+			name:    "successor not stored",
+			results: []fakedb.Result{{Rows: make([][]driver.Value, 1)}, {Err: insertErr}},
+			wantErr: insertErr,
+			queries: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, fake := newFakeDB(t, tt.results...)
+
+			rotated, err := NewRefreshTokenRepo(db).Rotate("t1", next)
+			if rotated != tt.want || !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Rotate = %v, %v, want %v, %v", rotated, err, tt.want, tt.wantErr)
+			}
+			if len(fake.Queries) != tt.queries {
+				t.Fatalf("ran %d statements, want %d", len(fake.Queries), tt.queries)
+			}
+			// a used, revoked or expired token is never spent twice
+			for _, cond := range []string{"used_at IS NULL", "revoked_at IS NULL", "expires_at > CURRENT_TIMESTAMP"} {
+				if !strings.Contains(fake.Queries[0], cond) {
+					t.Errorf("update does not check %s:\n%s", cond, fake.Queries[0])
+				}
+			}
+			if tt.queries > 1 {
+				want := []driver.Value{"t2", "f1", "u1", next.ExpiresAt}
+				if !reflect.DeepEqual(fake.Args[1], want) {
+					t.Errorf("insert args = %v, want %v", fake.Args[1], want)
+				}
+			}
+		})
+	}
+}
+
+func TestGetRefreshToken(t *testing.T) {
+	expires := time.Date(2024, 5, 2, 10, 0, 0, 0, time.UTC)
+	used := expires.Add(-time.Hour)
+	db, _ := newFakeDB(t, fakedb.Result{
+		Columns: []string{"id", "family_id", "user_id", "expires_at", "used_at", "revoked_at"},
+		Rows:    [][]driver.Value{{"t1", "f1", "u1", expires, used, nil}},
+	})
+
+	token, err := NewRefreshTokenRepo(db).Get("t1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.FamilyId != "f1" || token.UsedAt == nil || !token.UsedAt.Equal(used) || token.RevokedAt != nil {
+		t.Fatalf("token = %+v", token)
+	}
+}
+
+func TestRevokeFamily(t *testing.T) {
+	db, fake := newFakeDB(t, fakedb.Result{})
+
+	if err := NewRefreshTokenRepo(db).RevokeFamily("f1"); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(fake.Queries[0], "family_id = $1") || !reflect.DeepEqual(fake.Args[0], []driver.Value{"f1"}) {
+		t.Fatalf("revoked with %v:\n%s", fake.Args[0], fake.Queries[0])
+	}
+}
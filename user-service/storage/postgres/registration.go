@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	pbu "user-service/protos/user-service"
+	"user-service/storage/repo"
+)
+
+func (r *userRepo) SaveRegistration(reg *repo.Registration) error {
+	query := `
+	INSERT INTO user_registrations (
+		email,
+		name,
+		last_name,
+		username,
+		password,
+		birth_date,
+		biography,
+		gender,
+		profile_picture,
+		phone_number,
+		code_hash,
+		expires_at
+	)
+	VALUES ($1, $2, $3, $4, $5, NULLIF($6, '')::DATE, $7, $8, $9, $10, $11, $12)
+	ON CONFLICT (email) DO UPDATE SET
+		name = EXCLUDED.name,
+		last_name = EXCLUDED.last_name,
+		username = EXCLUDED.username,
+		password = EXCLUDED.password,
+		birth_date = EXCLUDED.birth_date,
+		biography = EXCLUDED.biography,
+		gender = EXCLUDED.gender,
+		profile_picture = EXCLUDED.profile_picture,
+		phone_number = EXCLUDED.phone_number,
+		code_hash = EXCLUDED.code_hash,
+		attempts = 0,
+		expires_at = EXCLUDED.expires_at,
+		created_at = CURRENT_TIMESTAMP
+	`
+	_, err := r.db.Exec(
+		query,
+		reg.User.Email,
+		reg.User.FirstName,
+		reg.User.LastName,
+		reg.User.UserName,
+		reg.User.Password,
+		reg.User.BirthDate,
+		reg.User.Biography,
+		reg.User.Gender,
+		reg.User.ProfilePicture,
+		reg.User.PhoneNumber,
+		reg.CodeHash,
+		reg.ExpiresAt,
+	)
+	return err
+}
+
+func (r *userRepo) GetRegistration(email string) (*repo.Registration, error) {
+	query := `
+	SELECT
+		email,
+		name,
+		COALESCE(last_name, ''),
+		username,
+		password,
+		COALESCE(to_char(birth_date, 'YYYY-MM-DD'), ''),
+		biography,
+		gender,
+		profile_picture,
+		phone_number,
+		code_hash,
+		attempts,
+		expires_at
+	FROM
+		user_registrations
+	WHERE
+		email = $1
+	`
+	reg := repo.Registration{User: &pbu.User{}}
+	err := r.db.QueryRow(query, email).Scan(
+		&reg.User.Email,
+		&reg.User.FirstName,
+		&reg.User.LastName,
+		&reg.User.UserName,
+		&reg.User.Password,
+		&reg.User.BirthDate,
+		&reg.User.Biography,
+		&reg.User.Gender,
+		&reg.User.ProfilePicture,
+		&reg.User.PhoneNumber,
+		&reg.CodeHash,
+		&reg.Attempts,
+		&reg.ExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reg, nil
+}
+
+func (r *userRepo) IncrRegistrationAttempts(email string) error {
+	query := `
+	UPDATE
+		user_registrations
+	SET
+		attempts = attempts + 1
+	WHERE
+		email = $1
+	`
+	_, err := r.db.Exec(query, email)
+	return err
+}
+
+func (r *userRepo) DeleteRegistration(email string) error {
+	query := `
+	DELETE FROM
+		user_registrations
+	WHERE
+		email = $1
+	`
+	_, err := r.db.Exec(query, email)
+	return err
+}
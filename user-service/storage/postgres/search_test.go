@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"shared/fakedb"
+)
+
+func TestLikeEscaper(t *testing.T) {
+	tests := []struct {
+		q, want string
+	}{
+		{"ann", "ann"},
+		{"100%", `100\%`},
+		{"a_b", `a\_b`},
+		{`back\slash`, `back\\slash`},
+		{`%_\`, `\%\_\\`},
+	}
+	for _, tt := range tests {
+		if got := likeEscaper.Replace(tt.q); got != tt.want {
+			t.Errorf("likeEscaper.Replace(%q) = %q, want %q", tt.q, got, tt.want)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	row := func(id, username string) []driver.Value {
+		return []driver.Value{id, "Ann", "", username, username + "@example.com", "hash", "", "", "", "", "", false, created, created, int64(3), int64(4), int64(12)}
+	}
+	db, fake := newFakeDB(t, fakedb.Result{
+		Columns: make([]string, 17),
+		Rows:    [][]driver.Value{row("u1", "ann_1"), row("u2", "anna")},
+	})
+
+	res, err := NewUserRepo(db).Search("ann_", 20, 40)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Count != 12 || len(res.AllUsers) != 2 || res.AllUsers[0].UserName != "ann_1" || res.AllUsers[1].FollowingCount != 4 {
+		t.Fatalf("Search = %+v", res)
+	}
+	// the raw input feeds similarity and full-text search, the prefix match
+	// gets it with the wildcards escaped
+	want := []driver.Value{"ann_", `ann\_%`, int64(20), int64(40)}
+	if !reflect.DeepEqual(fake.Args[0], want) {
+		t.Errorf("args = %q, want %q", fake.Args[0], want)
+	}
+	if !strings.Contains(fake.Queries[0], "deleted_at IS NULL") {
+		t.Errorf("deleted users are not left out:\n%s", fake.Queries[0])
+	}
+}
+
+func TestSearchVectorMatchesIndex(t *testing.T) {
+	migration, err := os.ReadFile("../../migrations/000010_user_search.up.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the planner only uses users_search_tsv_idx for the same expression
+	if !strings.Contains(string(migration), searchVector) {
+		t.Errorf("users_search_tsv_idx is not built on %s", searchVector)
+	}
+}
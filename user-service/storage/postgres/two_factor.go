@@ -0,0 +1,224 @@
+package postgres
+
+import (
+	"user-service/storage/repo"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type twoFactorRepo struct {
+	db *sqlx.DB
+}
+
+// NewTwoFactorRepo ...
+func NewTwoFactorRepo(db *sqlx.DB) *twoFactorRepo {
+	return &twoFactorRepo{db: db}
+}
+
+// SaveTotp starts a new enrollment, replacing a previous unconfirmed one
+func (r *twoFactorRepo) SaveTotp(userId, secret string) error {
+	query := `
+	INSERT INTO user_totp (
+		user_id,
+		secret
+	)
+	VALUES ($1, $2)
+	ON CONFLICT (user_id) DO UPDATE SET
+		secret = EXCLUDED.secret,
+		enabled_at = NULL,
+		last_step = 0,
+		created_at = CURRENT_TIMESTAMP
+	`
+	_, err := r.db.Exec(query, userId, secret)
+	return err
+}
+
+func (r *twoFactorRepo) GetTotp(userId string) (*repo.Totp, error) {
+	query := `
+	SELECT
+		user_id,
+		secret,
+		enabled_at,
+		last_step
+	FROM
+		user_totp
+	WHERE
+		user_id = $1
+	`
+	var totp repo.Totp
+	if err := r.db.QueryRow(query, userId).Scan(
+		&totp.UserId,
+		&totp.Secret,
+		&totp.EnabledAt,
+		&totp.LastStep,
+	); err != nil {
+		return nil, err
+	}
+
+	return &totp, nil
+}
+
+func (r *twoFactorRepo) EnableTotp(userId string) error {
+	query := `
+	UPDATE
+		user_totp
+	SET
+		enabled_at = CURRENT_TIMESTAMP
+	WHERE
+		user_id = $1
+	`
+	_, err := r.db.Exec(query, userId)
+	return err
+}
+
+// UseTotpStep records the time step of an accepted code, it fails when the
+// step or a later one was used already
+func (r *twoFactorRepo) UseTotpStep(userId string, step int64) (bool, error) {
+	query := `
+	UPDATE
+		user_totp
+	SET
+		last_step = $2
+	WHERE
+		user_id = $1
+	AND
+		last_step < $2
+	`
+	res, err := r.db.Exec(query, userId, step)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// DeleteTotp turns two-factor authentication off together with the recovery codes
+func (r *twoFactorRepo) DeleteTotp(userId string) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM recovery_codes WHERE user_id = $1`, userId); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`DELETE FROM user_totp WHERE user_id = $1`, userId); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// ReplaceRecoveryCodes drops the old codes of the user, used or not
+func (r *twoFactorRepo) ReplaceRecoveryCodes(userId string, codeHashes []string) error {
+	tx, err := r.db.Beginx()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(`DELETE FROM recovery_codes WHERE user_id = $1`, userId); err != nil {
+		return err
+	}
+	query := `
+	INSERT INTO recovery_codes (
+		user_id,
+		code_hash
+	)
+	VALUES ($1, $2)
+	`
+	for _, hash := range codeHashes {
+		if _, err := tx.Exec(query, userId, hash); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *twoFactorRepo) UseRecoveryCode(userId, codeHash string) (bool, error) {
+	query := `
+	UPDATE
+		recovery_codes
+	SET
+		used_at = CURRENT_TIMESTAMP
+	WHERE
+		user_id = $1
+	AND
+		code_hash = $2
+	AND
+		used_at IS NULL
+	`
+	res, err := r.db.Exec(query, userId, codeHash)
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *twoFactorRepo) CreateChallenge(challenge *repo.LoginChallenge) error {
+	query := `
+	INSERT INTO login_challenges (
+		id_hash,
+		user_id,
+		restore,
+		expires_at
+	)
+	VALUES ($1, $2, $3, $4)
+	`
+	_, err := r.db.Exec(query, challenge.IdHash, challenge.UserId, challenge.Restore, challenge.ExpiresAt)
+	return err
+}
+
+func (r *twoFactorRepo) GetChallenge(idHash string) (*repo.LoginChallenge, error) {
+	query := `
+	SELECT
+		id_hash,
+		user_id,
+		restore,
+		attempts,
+		expires_at
+	FROM
+		login_challenges
+	WHERE
+		id_hash = $1
+	`
+	var challenge repo.LoginChallenge
+	if err := r.db.QueryRow(query, idHash).Scan(
+		&challenge.IdHash,
+		&challenge.UserId,
+		&challenge.Restore,
+		&challenge.Attempts,
+		&challenge.ExpiresAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &challenge, nil
+}
+
+func (r *twoFactorRepo) IncrChallengeAttempts(idHash string) error {
+	query := `
+	UPDATE
+		login_challenges
+	SET
+		attempts = attempts + 1
+	WHERE
+		id_hash = $1
+	`
+	_, err := r.db.Exec(query, idHash)
+	return err
+}
+
+func (r *twoFactorRepo) DeleteChallenge(idHash string) error {
+	_, err := r.db.Exec(`DELETE FROM login_challenges WHERE id_hash = $1`, idHash)
+	return err
+}
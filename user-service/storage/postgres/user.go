@@ -1,122 +1,184 @@
 package postgres
 
 import (
+	"user-service/pkg/query"
 	pbu "user-service/protos/user-service"
+	"user-service/storage/repo"
 
+	"database/sql"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
-type userRepo struct {
-	db *sqlx.DB
+// lookupFields maps the fields a single user can be looked up by to their columns
+var lookupFields = map[string]string{
+	"id":        "id",
+	"email":     "email",
+	"username":  "username",
+	"user_name": "username",
 }
 
-// NewUserRepo ...
-func NewUserRepo(db *sqlx.DB) *userRepo {
-	return &userRepo{db: db}
+// userColumns is the column list scanUser expects, birth_date comes back as
+// an ISO date and is empty when unknown
+const userColumns = `
+	id,
+	name,
+	COALESCE(last_name, ''),
+	username,
+	email,
+	password,
+	COALESCE(to_char(birth_date, 'YYYY-MM-DD'), ''),
+	biography,
+	gender,
+	profile_picture,
+	phone_number,
+	is_private,
+	created_at,
+	updated_at`
+
+// followCountColumns may follow userColumns, scanUser reads them when asked to
+const followCountColumns = `,
+	(SELECT COUNT(*) FROM follows WHERE followee_id = users.id),
+	(SELECT COUNT(*) FROM follows WHERE follower_id = users.id)`
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
 }
 
-func (r *userRepo) GetUserByEmail(email *pbu.ByEmail) (*pbu.User, error) {
+// scanUser reads userColumns, followed by followCountColumns when asked to and
+// then by any extra columns of the query
+func scanUser(row rowScanner, withCounts bool, extra ...interface{}) (*pbu.User, error) {
 	var user pbu.User
-	query := `
-	SELECT 
-		id, 
-		name, 
-		last_name,
-		username,
-		email,
-		password,
-		created_at,
-		updated_at
-	FROM 
-		users 
-	WHERE 
-		email = $1
-	AND 
-		deleted_at IS NULL
-	`
-	err := r.db.QueryRow(query, email.Email).Scan(
+	dest := []interface{}{
 		&user.Id,
-		&user.Name,
+		&user.FirstName,
 		&user.LastName,
-		&user.Username,
+		&user.UserName,
 		&user.Email,
 		&user.Password,
+		&user.BirthDate,
+		&user.Biography,
+		&user.Gender,
+		&user.ProfilePicture,
+		&user.PhoneNumber,
+		&user.IsPrivate,
 		&user.CreatedAt,
 		&user.UpdatedAt,
-	)
-
-	if err != nil {
+	}
+	if withCounts {
+		dest = append(dest, &user.FollowersCount, &user.FollowingCount)
+	}
+	dest = append(dest, extra...)
+	if err := row.Scan(dest...); err != nil {
 		return nil, err
 	}
-
 	return &user, nil
 }
 
-func (r *userRepo) CheckUniques(req *pbu.CheckUniquesRequest) (*pbu.CheckUniquesResponse, error) {
+// takenError turns a violation of the unique email or username indexes into
+// repo.ErrTaken, it covers the race checkAvailable can not
+func takenError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return repo.ErrTaken
+	}
+	return err
+}
+
+// userQueryFields are the fields clients may filter and sort user lists by
+var userQueryFields = map[string]string{
+	"id":           "id",
+	"name":         "name",
+	"first_name":   "name",
+	"last_name":    "last_name",
+	"username":     "username",
+	"user_name":    "username",
+	"email":        "email",
+	"gender":       "gender",
+	"phone_number": "phone_number",
+	"birth_date":   "birth_date",
+	"created_at":   "created_at",
+	"updated_at":   "updated_at",
+}
+
+// uniqueFields are the fields CheckUniques can be asked about
+var uniqueFields = map[string]string{
+	"email":        "email",
+	"username":     "username",
+	"user_name":    "username",
+	"phone_number": "phone_number",
+}
+
+type userRepo struct {
+	db *sqlx.DB
+}
+
+// NewUserRepo ...
+func NewUserRepo(db *sqlx.DB) *userRepo {
+	return &userRepo{db: db}
+}
+
+func (r *userRepo) CheckUniques(req *pbu.CheckUniqReq) (*pbu.CheckUniqResp, error) {
+	q := query.New(uniqueFields)
+	if err := q.Eq(req.Field, req.Value); err != nil {
+		return nil, err
+	}
+	// deleted users keep their email and username until they are purged, so
+	// the account can still be restored
+
 	var exists int
-	query := fmt.Sprintf("SELECT count(1) FROM users WHERE %s = $1 AND deleted_at IS NULL", req.Field)
-	err := r.db.QueryRow(query, req.Value).Scan(&exists)
+	err := r.db.QueryRow("SELECT count(1) FROM users "+q.WhereClause(), q.Args()...).Scan(&exists)
 	if err != nil {
 		return nil, err
 	}
-	return &pbu.CheckUniquesResponse{
-		Check: exists > 0,
+	return &pbu.CheckUniqResp{
+		IsUnique: exists == 0,
 	}, nil
 }
 
 func (r *userRepo) Create(user *pbu.User) (*pbu.User, error) {
-	var respUser pbu.User
 	query := `
 		INSERT INTO users (
-			id, 
-			name, 
-			last_name,
-			username,
-			email,
-			password
-		) 
-		VALUES ($1, $2, $3, $4, $5, $6) 
-		RETURNING 
 			id, 
 			name, 
 			last_name,
 			username,
 			email,
 			password,
-			created_at,
-			updated_at
-	`
+			birth_date,
+			biography,
+			gender,
+			profile_picture,
+			phone_number
+		) 
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, '')::DATE, $8, $9, $10, $11) 
+		RETURNING` + userColumns
 	id := uuid.New().String()
-	err := r.db.QueryRow(
+	row := r.db.QueryRow(
 		query,
 		id,
-		user.Name,
+		user.FirstName,
 		user.LastName,
-		user.Username,
+		user.UserName,
 		user.Email,
 		user.Password,
-	).Scan(
-		&respUser.Id,
-		&respUser.Name,
-		&respUser.LastName,
-		&respUser.Username,
-		&respUser.Email,
-		&respUser.Password,
-		&respUser.CreatedAt,
-		&respUser.UpdatedAt,
+		user.BirthDate,
+		user.Biography,
+		user.Gender,
+		user.ProfilePicture,
+		user.PhoneNumber,
 	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &respUser, nil
+	user, err := scanUser(row, false)
+	return user, takenError(err)
 }
 
 func (r *userRepo) Update(user *pbu.User) (*pbu.User, error) {
-	var respUser pbu.User
 	query := `
 		UPDATE 
 			users 
@@ -125,60 +187,126 @@ func (r *userRepo) Update(user *pbu.User) (*pbu.User, error) {
 			name = $1,
 			last_name = $2, 
 			username = $3,
-			email = $4,
-			password = $5
+			birth_date = NULLIF($4, '')::DATE,
+			biography = $5,
+			gender = $6,
+			profile_picture = $7,
+			phone_number = $8
 		WHERE 
-			id = $6
+			id = $9
 		AND
 			deleted_at IS NULL
-		RETURNING
-			id, 
-			name, 
-			last_name,
-			username,
-			email,
-			password,
-			created_at,
-			updated_at
-	`
-	err := r.db.QueryRow(
+		RETURNING` + userColumns
+	row := r.db.QueryRow(
 		query,
-		user.Name,
+		user.FirstName,
 		user.LastName,
-		user.Username,
-		user.Email,
-		user.Password,
+		user.UserName,
+		user.BirthDate,
+		user.Biography,
+		user.Gender,
+		user.ProfilePicture,
+		user.PhoneNumber,
 		user.Id,
-	).Scan(
-		&respUser.Id,
-		&respUser.Name,
-		&respUser.LastName,
-		&respUser.Username,
-		&respUser.Email,
-		&respUser.Password,
-		&respUser.CreatedAt,
-		&respUser.UpdatedAt,
 	)
+	user, err := scanUser(row, false)
+	return user, takenError(err)
+}
+
+func (r *userRepo) UpdatePassword(userId, password string) (*pbu.User, error) {
+	query := `
+		UPDATE 
+			users 
+		SET 
+			updated_at = CURRENT_TIMESTAMP,
+			password = $1
+		WHERE 
+			id = $2
+		AND
+			deleted_at IS NULL
+		RETURNING` + userColumns
+	return scanUser(r.db.QueryRow(query, password, userId), false)
+}
+
+// UpdateEmail sets the email of the user, Update leaves it alone so it only
+// changes through the confirmed email change
+func (r *userRepo) UpdateEmail(userId, email string) (*pbu.User, error) {
+	query := `
+		UPDATE 
+			users 
+		SET 
+			updated_at = CURRENT_TIMESTAMP,
+			email = $1
+		WHERE 
+			id = $2
+		AND
+			deleted_at IS NULL
+		RETURNING` + userColumns
+	user, err := scanUser(r.db.QueryRow(query, email, userId), false)
+	return user, takenError(err)
+}
+
+// SetPrivate switches the account between private and public. Going public
+// turns the pending follow requests into follows in the same transaction.
+func (r *userRepo) SetPrivate(userId string, private bool) (*pbu.User, error) {
+	tx, err := r.db.Begin()
 	if err != nil {
 		return nil, err
 	}
+	defer tx.Rollback()
+
+	user, err := scanUser(tx.QueryRow(`
+		UPDATE
+			users
+		SET
+			updated_at = CURRENT_TIMESTAMP,
+			is_private = $1
+		WHERE
+			id = $2
+		AND
+			deleted_at IS NULL
+		RETURNING`+userColumns, private, userId), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if !private {
+		_, err = tx.Exec(`
+		INSERT INTO follows (follower_id, followee_id)
+		SELECT follower_id, followee_id FROM follow_requests WHERE followee_id = $1
+		ON CONFLICT DO NOTHING
+		`, userId)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = tx.Exec(`DELETE FROM follow_requests WHERE followee_id = $1`, userId); err != nil {
+			return nil, err
+		}
+	}
 
-	return &respUser, nil
+	return user, tx.Commit()
 }
 
-func (r *userRepo) Delete(user_id *pbu.UserRequest) (*pbu.CheckUniquesResponse, error) {
-	queryDel := `
+// Delete marks the user deleted, they can log in to restore the account
+// until the restore window is over and the purge job removes it
+func (r *userRepo) Delete(req *pbu.DeleteUserReq, restoreWindow time.Duration) (*pbu.Status, error) {
+	column, ok := lookupFields[req.Field]
+	if !ok {
+		return nil, fmt.Errorf("unsupported field %q", req.Field)
+	}
+	queryDel := fmt.Sprintf(`
 	UPDATE
 		users
 	SET
-		deleted_at = CURRENT_TIMESTAMP
+		deleted_at = CURRENT_TIMESTAMP,
+		purge_after = CURRENT_TIMESTAMP + $2 * INTERVAL '1 second'
 	WHERE
-		id = $1
+		%s = $1
 	AND
 		deleted_at IS NULL
-	`
+	`, column)
 
-	res, err := r.db.Exec(queryDel, user_id.UserId)
+	res, err := r.db.Exec(queryDel, req.Value, int64(restoreWindow.Seconds()))
 	if err != nil {
 		return nil, err
 	}
@@ -187,85 +315,274 @@ func (r *userRepo) Delete(user_id *pbu.UserRequest) (*pbu.CheckUniquesResponse,
 	if err != nil {
 		return nil, err
 	}
+	if affected == 0 {
+		return nil, sql.ErrNoRows
+	}
 
-	return &pbu.CheckUniquesResponse{Check: affected > 0}, nil
+	return &pbu.Status{Message: "user deleted"}, nil
 }
 
-func (r *userRepo) Get(user_id *pbu.UserRequest) (*pbu.User, error) {
-	var user pbu.User
+// GetRestorable returns a deleted user whose restore window is still open
+func (r *userRepo) GetRestorable(req *pbu.GetUserReq) (*pbu.User, error) {
+	column, ok := lookupFields[req.Field]
+	if !ok {
+		return nil, fmt.Errorf("unsupported field %q", req.Field)
+	}
+	query := fmt.Sprintf(`
+	SELECT %s%s
+	FROM
+		users
+	WHERE
+		%s = $1
+	AND
+		deleted_at IS NOT NULL
+	AND
+		purge_after > CURRENT_TIMESTAMP
+	ORDER BY
+		deleted_at DESC
+	LIMIT 1
+	`, userColumns, followCountColumns, column)
+	return scanUser(r.db.QueryRow(query, req.Value), true)
+}
+
+// Restore undoes Delete while the restore window is open
+func (r *userRepo) Restore(userId string) (bool, error) {
 	query := `
-	SELECT 
-		id, 
-		name, 
-		last_name,
-		username,
-		email,
-		password,
-		created_at,
-		updated_at
+	UPDATE
+		users
+	SET
+		deleted_at = NULL,
+		purge_after = NULL,
+		updated_at = CURRENT_TIMESTAMP
+	WHERE
+		id = $1
+	AND
+		deleted_at IS NOT NULL
+	AND
+		purge_after > CURRENT_TIMESTAMP
+	`
+	res, err := r.db.Exec(query, userId)
+	if err != nil {
+		return false, err
+	}
+	return affected(res)
+}
+
+// SchedulePurges gives the deleted users that have no purge_after, the ones
+// deleted before it existed, the restore window counted from their deletion
+func (r *userRepo) SchedulePurges(restoreWindow time.Duration) (int64, error) {
+	query := `
+	UPDATE
+		users
+	SET
+		purge_after = deleted_at + $1 * INTERVAL '1 second'
+	WHERE
+		deleted_at IS NOT NULL
+	AND
+		purge_after IS NULL
+	`
+	res, err := r.db.Exec(query, int64(restoreWindow.Seconds()))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// DuePurges returns deleted users whose restore window is over, oldest first
+func (r *userRepo) DuePurges(limit int) ([]string, error) {
+	query := `
+	SELECT
+		id
+	FROM
+		users
+	WHERE
+		deleted_at IS NOT NULL
+	AND
+		purge_after <= CURRENT_TIMESTAMP
+	ORDER BY
+		purge_after
+	LIMIT $1
+	`
+	var ids []string
+	if err := r.db.Select(&ids, query, limit); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// Purge removes a deleted user for good, their follows, blocks and tokens
+// go with them through ON DELETE CASCADE
+func (r *userRepo) Purge(userId string) (bool, error) {
+	query := `
+	DELETE FROM
+		users
+	WHERE
+		id = $1
+	AND
+		deleted_at IS NOT NULL
+	AND
+		purge_after <= CURRENT_TIMESTAMP
+	`
+	res, err := r.db.Exec(query, userId)
+	if err != nil {
+		return false, err
+	}
+	return affected(res)
+}
+
+func (r *userRepo) Get(req *pbu.GetUserReq) (*pbu.User, error) {
+	column, ok := lookupFields[req.Field]
+	if !ok {
+		return nil, fmt.Errorf("unsupported field %q", req.Field)
+	}
+	query := fmt.Sprintf(`
+	SELECT %s%s
 	FROM 
 		users 
 	WHERE 
-		id = $1
+		%s = $1
 	AND 
 		deleted_at IS NULL
-	`
-	err := r.db.QueryRow(query, user_id.UserId).Scan(
-		&user.Id,
-		&user.Name,
-		&user.LastName,
-		&user.Username,
-		&user.Email,
-		&user.Password,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	)
+	`, userColumns, followCountColumns, column)
+	return scanUser(r.db.QueryRow(query, req.Value), true)
+}
 
+// GetByIds returns the users that exist and are not deleted, in no
+// particular order
+func (r *userRepo) GetByIds(ids []string) ([]*pbu.User, error) {
+	query := fmt.Sprintf(`
+	SELECT %s%s
+	FROM
+		users
+	WHERE
+		id = ANY($1::UUID[])
+	AND
+		deleted_at IS NULL
+	`, userColumns, followCountColumns)
+	rows, err := r.db.Query(query, pq.Array(ids))
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
-	return &user, nil
+	var users []*pbu.User
+	for rows.Next() {
+		user, err := scanUser(rows, true)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+	return users, rows.Err()
 }
 
-func (r *userRepo) GetAll(req *pbu.GetAllUsersRequest) (*pbu.GetAllUsersResponse, error) {
-	offset := req.Limit * (req.Page - 1)
-	query := `
-		SELECT 
-			id, 
-			name, 
-			last_name,
-			username,
-			email,
-			password,
-			created_at,
-			updated_at
+// GetAll returns one page of the users matching the filter, Count is the
+// number of matches over all pages
+func (r *userRepo) GetAll(filter *repo.UserFilter) (*pbu.GetAllUsersRes, error) {
+	q := query.New(userQueryFields)
+	q.Where("deleted_at IS NULL")
+	if filter.Field != "" {
+		if err := q.Eq(filter.Field, filter.Value); err != nil {
+			return nil, err
+		}
+	}
+	if !filter.From.IsZero() {
+		q.Where("created_at >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		q.Where("created_at < ?", filter.To)
+	}
+	sortBy := filter.SortBy
+	if sortBy == "" {
+		sortBy = "-created_at"
+	}
+	if err := q.OrderBy(sortBy); err != nil {
+		return nil, err
+	}
+	// a unique tie breaker keeps pages stable
+	if err := q.OrderBy("id"); err != nil {
+		return nil, err
+	}
+
+	var allUsers pbu.GetAllUsersRes
+	err := r.db.QueryRow("SELECT COUNT(*) FROM users "+q.WhereClause(), q.Args()...).Scan(&allUsers.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	listQuery := fmt.Sprintf(`
+		SELECT%s%s
 		FROM 
 			users
-		WHERE 
-			deleted_at IS NULL 	
-		LIMIT $1 
-		OFFSET $2
-	`
-	rows, err := r.db.Query(query, req.Limit, offset)
+		%s
+		%s
+		LIMIT %s 
+		OFFSET %s
+	`, userColumns, followCountColumns, q.WhereClause(), q.OrderClause(), q.Arg(filter.Limit), q.Arg(filter.Offset))
+	rows, err := r.db.Query(listQuery, q.Args()...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		user, err := scanUser(rows, true)
+		if err != nil {
+			return nil, err
+		}
+		allUsers.AllUsers = append(allUsers.AllUsers, user)
+	}
+	return &allUsers, rows.Err()
+}
+
+// likeEscaper escapes the LIKE wildcards of client input
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// searchVector must match the expression of users_search_tsv_idx
+const searchVector = `to_tsvector('simple', username || ' ' || name || ' ' || COALESCE(last_name, ''))`
+
+// Search matches q by trigram similarity and full-text search over username,
+// name and last_name. Usernames starting with q rank first, then the rest by
+// relevance. Count is the number of matches over all pages.
+func (r *userRepo) Search(q string, limit, offset int64) (*pbu.GetAllUsersRes, error) {
+	query := fmt.Sprintf(`
+	SELECT%[1]s%[2]s,
+		COUNT(*) OVER ()
+	FROM
+		users
+	WHERE
+		deleted_at IS NULL
+	AND (
+		username ILIKE $2
+		OR username %% $1
+		OR name %% $1
+		OR last_name %% $1
+		OR %[3]s @@ plainto_tsquery('simple', $1)
+	)
+	ORDER BY
+		username ILIKE $2 DESC,
+		ts_rank(%[3]s, plainto_tsquery('simple', $1)) + GREATEST(
+			similarity(username, $1),
+			similarity(name, $1),
+			similarity(COALESCE(last_name, ''), $1)
+		) DESC,
+		username
+	LIMIT $3
+	OFFSET $4
+	`, userColumns, followCountColumns, searchVector)
+
+	rows, err := r.db.Query(query, q, likeEscaper.Replace(q)+"%", limit, offset)
 	if err != nil {
 		return nil, err
 	}
-	var allUsers pbu.GetAllUsersResponse
+	defer rows.Close()
+
+	res := pbu.GetAllUsersRes{AllUsers: []*pbu.User{}}
 	for rows.Next() {
-		var user pbu.User
-		if err := rows.Scan(
-			&user.Id,
-			&user.Name,
-			&user.LastName,
-			&user.Username,
-			&user.Email,
-			&user.Password,
-			&user.CreatedAt,
-			&user.UpdatedAt); err != nil {
+		user, err := scanUser(rows, true, &res.Count)
+		if err != nil {
 			return nil, err
 		}
-		allUsers.AllUsers = append(allUsers.AllUsers, &user)
+		res.AllUsers = append(res.AllUsers, user)
 	}
-	return &allUsers, nil
+	return &res, rows.Err()
 }
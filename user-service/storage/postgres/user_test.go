@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"user-service/pkg/query"
+
+	"user-service/storage/repo"
+
+	"shared/fakedb"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// newFakeDB returns a sqlx.DB whose statements get results in order
+func newFakeDB(t *testing.T, results ...fakedb.Result) (*sqlx.DB, *fakedb.DB) {
+	db, fake := fakedb.Open(t, results...)
+	return sqlx.NewDb(db, "postgres"), fake
+}
+
+func TestTakenError(t *testing.T) {
+	foreignKey := &pq.Error{Code: "23503"}
+	reset := errors.New("connection reset")
+	tests := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"nil", nil, nil},
+		{"unique violation", &pq.Error{Code: "23505"}, repo.ErrTaken},
+		{"wrapped unique violation", fmt.Errorf("insert: %w", &pq.Error{Code: "23505"}), repo.ErrTaken},
+		{"other constraint", foreignKey, foreignKey},
+		{"other error", reset, reset},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := takenError(tt.err); got != tt.want {
+				t.Fatalf("takenError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+// whereOf returns the WHERE clause following the users table of a statement,
+// up to the next clause
+func whereOf(statement string) string {
+	where := statement[strings.LastIndex(statement, "users"):]
+	where = where[strings.Index(where, "WHERE"):]
+	for _, end := range []string{"ORDER BY", "LIMIT"} {
+		if i := strings.Index(where, end); i >= 0 {
+			where = where[:i]
+		}
+	}
+	return strings.TrimSpace(where)
+}
+
+func TestGetAllCountUsesListFilters(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(1, 0, 0)
+	db, fake := newFakeDB(t,
+		fakedb.Result{Columns: []string{"count"}, Rows: [][]driver.Value{{int64(3)}}},
+		fakedb.Result{},
+	)
+
+	res, err := NewUserRepo(db).GetAll(&repo.UserFilter{
+		Field:  "first_name",
+		Value:  "Ann' OR '1'='1",
+		SortBy: "-name",
+		From:   from,
+		To:     to,
+		Limit:  10,
+		Offset: 20,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Count != 3 {
+		t.Fatalf("count = %d, want 3", res.Count)
+	}
+
+	countWhere, listWhere := whereOf(fake.Queries[0]), whereOf(fake.Queries[1])
+	if countWhere != listWhere {
+		t.Fatalf("count and list filter differently:\n%s\n%s", countWhere, listWhere)
+	}
+	want := "WHERE deleted_at IS NULL AND name = $1 AND created_at >= $2 AND created_at < $3"
+	if countWhere != want {
+		t.Fatalf("where = %q, want %q", countWhere, want)
+	}
+	filterArgs := []driver.Value{"Ann' OR '1'='1", from, to}
+	if !reflect.DeepEqual(fake.Args[0], filterArgs) {
+		t.Fatalf("count args = %v, want %v", fake.Args[0], filterArgs)
+	}
+	if !reflect.DeepEqual(fake.Args[1], append(filterArgs, int64(10), int64(20))) {
+		t.Fatalf("list args = %v", fake.Args[1])
+	}
+	if !strings.Contains(fake.Queries[1], "ORDER BY name DESC, id ASC") || !strings.Contains(fake.Queries[1], "LIMIT $4") {
+		t.Fatalf("list query:\n%s", fake.Queries[1])
+	}
+}
+
+func TestGetAllRejectsUnknownNames(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter repo.UserFilter
+	}{
+		{"filter field", repo.UserFilter{Field: "password", Value: "x"}},
+		{"injected filter field", repo.UserFilter{Field: "name = name OR 1", Value: "x"}},
+		{"sort field", repo.UserFilter{SortBy: "password"}},
+		{"injected sort field", repo.UserFilter{SortBy: "created_at; DROP TABLE users"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, fake := newFakeDB(t)
+			_, err := NewUserRepo(db).GetAll(&tt.filter)
+			if !errors.Is(err, query.ErrUnknownField) {
+				t.Fatalf("error = %v, want ErrUnknownField", err)
+			}
+			if len(fake.Queries) != 0 {
+				t.Fatalf("ran %q", fake.Queries)
+			}
+		})
+	}
+}
+
+func TestSchedulePurgesTakesWindowFromCaller(t *testing.T) {
+	db, fake := newFakeDB(t, fakedb.Result{Rows: make([][]driver.Value, 2)})
+
+	scheduled, err := NewUserRepo(db).SchedulePurges(14 * 24 * time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scheduled != 2 {
+		t.Fatalf("scheduled %d, want 2", scheduled)
+	}
+	if !reflect.DeepEqual(fake.Args[0], []driver.Value{int64(14 * 24 * 60 * 60)}) {
+		t.Fatalf("args = %v", fake.Args[0])
+	}
+	if !strings.Contains(fake.Queries[0], "purge_after IS NULL") {
+		t.Fatalf("query overwrites scheduled purges:\n%s", fake.Queries[0])
+	}
+}
+
+func TestSetPrivate(t *testing.T) {
+	created := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	user := []driver.Value{"u1", "Ann", "", "ann", "ann@example.com", "hash", "", "", "", "", "", false, created, created}
+	for _, private := range []bool{true, false} {
+		db, fake := newFakeDB(t, fakedb.Result{Columns: make([]string, len(user)), Rows: [][]driver.Value{user}}, fakedb.Result{}, fakedb.Result{})
+
+		if _, err := NewUserRepo(db).SetPrivate("u1", private); err != nil {
+			t.Fatal(err)
+		}
+		// going public turns the pending requests into follows
+		wantQueries := 1
+		if !private {
+			wantQueries = 3
+		}
+		if len(fake.Queries) != wantQueries {
+			t.Fatalf("private %v: %d queries, want %d", private, len(fake.Queries), wantQueries)
+		}
+		if !private && (!strings.Contains(fake.Queries[1], "INSERT INTO follows") || !strings.Contains(fake.Queries[2], "DELETE FROM follow_requests")) {
+			t.Errorf("requests are not moved to follows:\n%s\n%s", fake.Queries[1], fake.Queries[2])
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package repo
+
+// BlockStorageI keeps blocks and mutes between users
+type BlockStorageI interface {
+	Block(blockerId, blockedId string) (bool, error)
+	Unblock(blockerId, blockedId string) (bool, error)
+	IsBlocked(blockerId, blockedId string) (bool, error)
+	Mute(muterId, mutedId string) (bool, error)
+	Unmute(muterId, mutedId string) (bool, error)
+	Blocked(userId string) ([]string, error)
+	Blocking(userId string) ([]string, error)
+	Muted(userId string) ([]string, error)
+}
@@ -0,0 +1,31 @@
+package repo
+
+import (
+	"time"
+)
+
+// EmailChange is an outstanding change of a user's email, it is applied once
+// both the old and the new address are confirmed
+type EmailChange struct {
+	UserId       string
+	NewEmail     string
+	OldCodeHash  string
+	NewCodeHash  string
+	OldConfirmed bool
+	NewConfirmed bool
+	Attempts     int
+	ExpiresAt    time.Time
+}
+
+// EmailChangeStorageI ...
+type EmailChangeStorageI interface {
+	Save(change *EmailChange) error
+	Get(userId string) (*EmailChange, error)
+	IncrAttempts(userId string) error
+	// Confirm marks the address whose code matches as confirmed, it returns
+	// sql.ErrNoRows when no valid change has that code
+	Confirm(userId, codeHash string) (*EmailChange, error)
+	// Complete deletes a fully confirmed change and returns it, so only one
+	// of concurrent callers gets to apply it
+	Complete(userId string) (*EmailChange, error)
+}
@@ -0,0 +1,49 @@
+package repo
+
+import (
+	"time"
+
+	pb "user-service/protos/user-service"
+
+	"shared/paging"
+)
+
+// Cursor is a keyset pagination position, lists are ordered by CreatedAt
+// and then Id, both descending
+type Cursor = paging.Cursor
+
+// FollowEdge is a user in a followers or following list and when the
+// follow happened
+type FollowEdge struct {
+	User       *pb.User
+	FollowedAt time.Time
+}
+
+// UserScore is a candidate user and how strongly a signal points at them
+type UserScore struct {
+	UserId string
+	Score  int64
+}
+
+// FollowStorageI ...
+type FollowStorageI interface {
+	Follow(followerId, followeeId string) (bool, error)
+	Unfollow(followerId, followeeId string) (bool, error)
+	IsFollowing(followerId, followeeId string) (bool, error)
+	ListFollowers(userId string, after *Cursor, limit int64) ([]*FollowEdge, error)
+	ListFollowing(userId string, after *Cursor, limit int64) ([]*FollowEdge, error)
+
+	RequestFollow(followerId, followeeId string) (bool, error)
+	ApproveRequest(followerId, followeeId string) (bool, error)
+	DeleteRequest(followerId, followeeId string) (bool, error)
+	ListRequests(userId string, after *Cursor, limit int64) ([]*FollowEdge, error)
+
+	VisibleOwners(viewerId string, ownerIds []string) ([]string, error)
+
+	FollowingIds(userId string) ([]string, error)
+	// FollowerIds and FolloweeIds leave pending requests out, a limit of 0
+	// returns every id
+	FollowerIds(userId string, limit int64) ([]string, error)
+	FolloweeIds(userId string) ([]string, error)
+	FriendsOfFriends(userId string, limit int64) ([]*UserScore, error)
+}
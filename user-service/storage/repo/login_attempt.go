@@ -0,0 +1,21 @@
+package repo
+
+import (
+	"time"
+)
+
+// LoginAttempt counts the failed logins of an account or a client address
+type LoginAttempt struct {
+	Key           string
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   *time.Time
+}
+
+// LoginAttemptStorageI ...
+type LoginAttemptStorageI interface {
+	Get(key string) (*LoginAttempt, error)
+	RecordFailure(key string, window time.Duration) (int, error)
+	Lock(key string, until time.Time) error
+	Delete(key string) error
+}
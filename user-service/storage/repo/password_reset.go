@@ -0,0 +1,23 @@
+package repo
+
+import (
+	"time"
+)
+
+// PasswordReset is an outstanding forgot-password code, only its hash is kept
+type PasswordReset struct {
+	UserId    string
+	CodeHash  string
+	ExpiresAt time.Time
+}
+
+// PasswordResetStorageI ...
+type PasswordResetStorageI interface {
+	// Save issues reset and reports whether it did. A live code is only
+	// replaced resendAfter after it was issued and while it has attempts
+	// left, the attempts it used carry over to the new code.
+	Save(reset *PasswordReset, maxAttempts int, resendAfter time.Duration) (bool, error)
+	// Consume counts an attempt and spends the code if codeHash matches, in
+	// one statement, so concurrent guesses can not exceed maxAttempts
+	Consume(userId, codeHash string, maxAttempts int) (bool, error)
+}
@@ -0,0 +1,24 @@
+package repo
+
+import (
+	"time"
+)
+
+// RefreshToken is the server side state of an issued refresh token
+type RefreshToken struct {
+	Id        string
+	FamilyId  string
+	UserId    string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+}
+
+// RefreshTokenStorageI ...
+type RefreshTokenStorageI interface {
+	Create(token *RefreshToken) error
+	Get(id string) (*RefreshToken, error)
+	Rotate(id string, next *RefreshToken) (bool, error)
+	RevokeFamily(familyId string) error
+	RevokeUser(userId string) error
+}
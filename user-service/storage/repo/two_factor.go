@@ -0,0 +1,42 @@
+package repo
+
+import (
+	"time"
+)
+
+// Totp is the authenticator secret of a user, EnabledAt stays nil until the
+// user confirmed the enrollment with a first code
+type Totp struct {
+	UserId    string
+	Secret    string
+	EnabledAt *time.Time
+	LastStep  int64
+}
+
+// LoginChallenge is the pending second step of a login, only the hash of the
+// challenge token is kept. Restore is set when the login brings a deleted
+// account back, which happens once the challenge is passed.
+type LoginChallenge struct {
+	IdHash    string
+	UserId    string
+	Restore   bool
+	Attempts  int
+	ExpiresAt time.Time
+}
+
+// TwoFactorStorageI ...
+type TwoFactorStorageI interface {
+	SaveTotp(userId, secret string) error
+	GetTotp(userId string) (*Totp, error)
+	EnableTotp(userId string) error
+	UseTotpStep(userId string, step int64) (bool, error)
+	DeleteTotp(userId string) error
+
+	ReplaceRecoveryCodes(userId string, codeHashes []string) error
+	UseRecoveryCode(userId, codeHash string) (bool, error)
+
+	CreateChallenge(challenge *LoginChallenge) error
+	GetChallenge(idHash string) (*LoginChallenge, error)
+	IncrChallengeAttempts(idHash string) error
+	DeleteChallenge(idHash string) error
+}
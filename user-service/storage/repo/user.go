@@ -1,16 +1,59 @@
 package repo
 
 import (
+	"errors"
+	"time"
+
 	pb "user-service/protos/user-service"
 )
 
+// ErrTaken is returned when a write would give two users the same email or
+// username
+var ErrTaken = errors.New("email or username is already in use")
+
+// Registration is a signup that waits for its email to be confirmed, only
+// the hash of the emailed code is kept
+type Registration struct {
+	User      *pb.User
+	CodeHash  string
+	Attempts  int
+	ExpiresAt time.Time
+}
+
+// UserFilter is a validated GetAllUsersReq. Field and SortBy are client
+// input and are checked against a whitelist by the storage, a zero From or
+// To leaves that end of the created_at range open.
+type UserFilter struct {
+	Field  string
+	Value  string
+	SortBy string
+	From   time.Time
+	To     time.Time
+	Limit  int64
+	Offset int64
+}
+
 // UserStorageI ...
 type UserStorageI interface {
 	Create(user *pb.User) (*pb.User, error)
 	Update(request *pb.User) (*pb.User, error)
-	Delete(request *pb.UserRequest) (*pb.CheckUniquesResponse, error)
-	Get(request *pb.UserRequest) (*pb.User, error)
-	GetAll(request *pb.GetAllUsersRequest) (*pb.GetAllUsersResponse, error)
-	CheckUniques(req *pb.CheckUniquesRequest) (*pb.CheckUniquesResponse, error)
-	GetUserByEmail(email *pb.ByEmail) (*pb.User, error)
+	UpdatePassword(userId, password string) (*pb.User, error)
+	UpdateEmail(userId, email string) (*pb.User, error)
+	SetPrivate(userId string, private bool) (*pb.User, error)
+	Delete(request *pb.DeleteUserReq, restoreWindow time.Duration) (*pb.Status, error)
+	Get(request *pb.GetUserReq) (*pb.User, error)
+	GetRestorable(request *pb.GetUserReq) (*pb.User, error)
+	Restore(userId string) (bool, error)
+	SchedulePurges(restoreWindow time.Duration) (int64, error)
+	DuePurges(limit int) ([]string, error)
+	Purge(userId string) (bool, error)
+	GetByIds(ids []string) ([]*pb.User, error)
+	GetAll(filter *UserFilter) (*pb.GetAllUsersRes, error)
+	Search(q string, limit, offset int64) (*pb.GetAllUsersRes, error)
+	CheckUniques(req *pb.CheckUniqReq) (*pb.CheckUniqResp, error)
+
+	SaveRegistration(reg *Registration) error
+	GetRegistration(email string) (*Registration, error)
+	IncrRegistrationAttempts(email string) error
+	DeleteRegistration(email string) error
 }
@@ -9,17 +9,69 @@ import (
 
 type IStorage interface {
 	User() repo.UserStorageI
+	RefreshToken() repo.RefreshTokenStorageI
+	PasswordReset() repo.PasswordResetStorageI
+	EmailChange() repo.EmailChangeStorageI
+	TwoFactor() repo.TwoFactorStorageI
+	LoginAttempt() repo.LoginAttemptStorageI
+	Follow() repo.FollowStorageI
+	Block() repo.BlockStorageI
 }
 
 type storagePg struct {
-	db       *sqlx.DB
-	userRepo repo.UserStorageI
+	db                *sqlx.DB
+	userRepo          repo.UserStorageI
+	refreshTokenRepo  repo.RefreshTokenStorageI
+	passwordResetRepo repo.PasswordResetStorageI
+	emailChangeRepo   repo.EmailChangeStorageI
+	twoFactorRepo     repo.TwoFactorStorageI
+	loginAttemptRepo  repo.LoginAttemptStorageI
+	followRepo        repo.FollowStorageI
+	blockRepo         repo.BlockStorageI
 }
 
 func (s storagePg) User() repo.UserStorageI {
 	return s.userRepo
 }
 
+func (s storagePg) RefreshToken() repo.RefreshTokenStorageI {
+	return s.refreshTokenRepo
+}
+
+func (s storagePg) PasswordReset() repo.PasswordResetStorageI {
+	return s.passwordResetRepo
+}
+
+func (s storagePg) EmailChange() repo.EmailChangeStorageI {
+	return s.emailChangeRepo
+}
+
+func (s storagePg) TwoFactor() repo.TwoFactorStorageI {
+	return s.twoFactorRepo
+}
+
+func (s storagePg) LoginAttempt() repo.LoginAttemptStorageI {
+	return s.loginAttemptRepo
+}
+
+func (s storagePg) Follow() repo.FollowStorageI {
+	return s.followRepo
+}
+
+func (s storagePg) Block() repo.BlockStorageI {
+	return s.blockRepo
+}
+
 func NewStoragePg(db *sqlx.DB) *storagePg {
-	return &storagePg{db, postgres.NewUserRepo(db)}
+	return &storagePg{
+		db:                db,
+		userRepo:          postgres.NewUserRepo(db),
+		refreshTokenRepo:  postgres.NewRefreshTokenRepo(db),
+		passwordResetRepo: postgres.NewPasswordResetRepo(db),
+		emailChangeRepo:   postgres.NewEmailChangeRepo(db),
+		twoFactorRepo:     postgres.NewTwoFactorRepo(db),
+		loginAttemptRepo:  postgres.NewLoginAttemptRepo(db),
+		followRepo:        postgres.NewFollowRepo(db),
+		blockRepo:         postgres.NewBlockRepo(db),
+	}
 }